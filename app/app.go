@@ -90,6 +90,7 @@ import (
 
 	appparams "github.com/cosmicbet/ledger/app/params"
 	"github.com/cosmicbet/ledger/x/wta"
+	wtaclient "github.com/cosmicbet/ledger/x/wta/client"
 	wtatypes "github.com/cosmicbet/ledger/x/wta/types"
 )
 
@@ -103,6 +104,12 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		distrclient.ProposalHandler,
 		upgradeclient.ProposalHandler,
 		upgradeclient.CancelProposalHandler,
+		wtaclient.CreatePoolProposalHandler,
+		wtaclient.PauseSalesProposalHandler,
+		wtaclient.ResumeSalesProposalHandler,
+		wtaclient.CloseDrawProposalHandler,
+		wtaclient.CancelDrawProposalHandler,
+		wtaclient.SetSalesHaltedProposalHandler,
 	)
 
 	return govProposalHandlers
@@ -150,6 +157,8 @@ var (
 		// Custom modules
 		wtatypes.PrizeCollectorName: nil,
 		wtatypes.PrizeBurnerName:    {authtypes.Burner},
+		wtatypes.TreasuryName:       nil,
+		wtatypes.StakersFeesName:    nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -311,6 +320,7 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.DistrKeeper,
+		app.StakingKeeper,
 		authtypes.FeeCollectorName,
 	)
 
@@ -325,7 +335,8 @@ func New(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(wtatypes.RouterKey, wta.NewProposalHandler(app.WtaKeeper))
 
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -398,13 +409,19 @@ func New(
 		wtatypes.ModuleName,
 	)
 
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(
+		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
+
+		// Custom modules
+		wtatypes.ModuleName,
+	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
+	// NOTE: The crisis module must occur last so that the invariants are checked against the whole genesis state.
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -414,7 +431,6 @@ func New(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		ibchost.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -422,6 +438,8 @@ func New(
 
 		// Custom modules
 		wtatypes.ModuleName,
+
+		crisistypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -2,5 +2,19 @@ package params
 
 // Default simulation operation weights for messages
 const (
-	DefaultWeightMsgBuyTickets int = 100
+	DefaultWeightMsgBuyTickets       int = 100
+	DefaultWeightMsgSelfExclude      int = 5
+	DefaultWeightMsgSetSpendingLimit int = 10
+	DefaultWeightMsgPostPrice        int = 10
+	DefaultWeightMsgTransferTickets  int = 20
+)
+
+// Default simulation operation weights for governance proposals
+const (
+	DefaultWeightCreatePoolProposal     int = 5
+	DefaultWeightPauseSalesProposal     int = 5
+	DefaultWeightResumeSalesProposal    int = 5
+	DefaultWeightCloseDrawProposal      int = 5
+	DefaultWeightCancelDrawProposal     int = 5
+	DefaultWeightSetSalesHaltedProposal int = 5
 )
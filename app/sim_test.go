@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	wtakeeper "github.com/cosmicbet/ledger/x/wta/keeper"
 	wtatypes "github.com/cosmicbet/ledger/x/wta/types"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -84,6 +85,13 @@ func SetupSimulation(dirPrefix, dbName string) (simtypes.Config, dbm.DB, string,
 	return config, db, dir, logger, skip, err
 }
 
+// assertWtaInvariants makes sure that all the invariants of the wta module hold for the last committed state
+func assertWtaInvariants(t *testing.T, app *App) {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	msg, broken := wtakeeper.AllInvariants(app.WtaKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
 func TestFullAppSimulation(t *testing.T) {
 	config, db, dir, logger, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
 	if skip {
@@ -120,6 +128,8 @@ func TestFullAppSimulation(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, simErr)
 
+	assertWtaInvariants(t, app)
+
 	if config.Commit {
 		simapp.PrintStats(db)
 	}
@@ -161,6 +171,8 @@ func TestAppImportExport(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, simErr)
 
+	assertWtaInvariants(t, app)
+
 	if config.Commit {
 		simapp.PrintStats(db)
 	}
@@ -193,6 +205,12 @@ func TestAppImportExport(t *testing.T) {
 	ctxA := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
 	ctxB := newApp.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
 	newApp.mm.InitGenesis(ctxB, app.AppCodec(), genesisState)
+
+	// The tickets of past draws that are still waiting to be pruned are not exported,
+	// so they are removed from the original store before comparing it with the imported one
+	for len(app.WtaKeeper.GetPruningQueue(ctxA)) > 0 {
+		app.WtaKeeper.PruneTickets(ctxA)
+	}
 	newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
 
 	fmt.Printf("comparing stores...\n")
@@ -263,6 +281,8 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, simErr)
 
+	assertWtaInvariants(t, app)
+
 	if config.Commit {
 		simapp.PrintStats(db)
 	}
@@ -1,6 +1,8 @@
 package wta
 
 import (
+	"fmt"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,60 +11,226 @@ import (
 	"github.com/cosmicbet/ledger/x/wta/types"
 )
 
-// BeginBlocker will check if there is a current draw for which a winner should be drawn.
-// If there is, randomly gets the winner and rewards it with the prize of the draw itself.
-// Then, creates a new draw.
+// BeginBlocker will check if there are current draws for which a winner should be drawn.
+// For each pool having such a draw, once the entropy reveal window has ended, it randomly gets the winners using
+// the entropy revealed by validators and rewards them with the prize of the draw itself, split based on the
+// pool payout table. For lotto pools, the winners are instead the tickets matching the drawn numbers.
+// Draws that cannot be settled, either because they have a single participant or because no validator has
+// revealed its entropy, are rolled over to a new draw along with their tickets, or refunded once the
+// maximum number of consecutive rollovers has been reached.
+// Then, creates a new draw for the same pool.
+// It also reports, before handling the draws, any change to the sales halted flag that has not been made by a
+// SetSalesHaltedProposal, such as the ones made by a parameter change proposal, and removes the prices posted
+// by the oracles that are no longer whitelisted so that they do not affect the tickets prices anymore.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
-	draw := k.GetCurrentDraw(ctx)
+	k.TrackSalesHalted(ctx)
+	k.PruneRemovedOraclesPrices(ctx)
+
+	for _, pool := range k.GetPools(ctx) {
+		drawPoolWinner(ctx, k, pool)
+	}
+}
+
+// EndBlocker allocates to the stakers the fees collected for them during the block, moves a limited number
+// of tickets of rolled over draws to their new draw, and removes a limited number of tickets belonging to past draws,
+// so that the storage used by them is freed without slowing down the creation of new draws
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	err := k.AllocateStakersFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	k.MoveTickets(ctx)
+	k.PruneTickets(ctx)
+}
+
+// drawPoolWinner draws the winners of the current draw of the given pool, if its reveal window has ended
+func drawPoolWinner(ctx sdk.Context, k keeper.Keeper, pool types.Pool) {
+	draw := k.GetCurrentDraw(ctx, pool.Id)
 
 	// Check to make sure it's fine to draw the winner
-	if ctx.BlockTime().Before(draw.EndTime) {
+	revealEndTime := draw.EndTime.Add(pool.DrawParams.RevealDuration)
+	if ctx.BlockTime().Before(revealEndTime) {
 		return
 	}
 
-	participants, tickets := k.GetDrawParticipantsAndTickets(ctx)
+	// The draw cannot be held until all the tickets rolled over from the previous draws have been moved into it
+	if k.HasMovingTickets(ctx, draw.Id) {
+		return
+	}
 
-	// We need at least two participants to make it fair
-	if len(participants) > 1 {
+	// Close the commit-reveal round. If nobody revealed, there is no seed that the block proposer cannot bias
+	seed := k.CloseEntropyRound(ctx, pool.Id)
 
-		// Get a random winning ticket
-		r := types.NewRandFromCtx(ctx)
-		winningTicket := tickets[r.Intn(len(tickets))]
+	rollovers := k.GetCurrentDrawRollovers(ctx, pool.Id)
 
-		winner, err := sdk.AccAddressFromBech32(winningTicket.Owner)
-		if err != nil {
-			panic(err)
+	// We need at least two participants to make it fair, and the entropy revealed by at least one validator
+	if draw.Participants == 1 || (draw.Participants > 1 && seed == nil) {
+		rolloverDraw(ctx, k, pool, draw, rollovers+1)
+	} else if draw.Participants > 1 {
+		// The tickets root and the proofs needed to verify the winners later are read from the draw tickets tree,
+		// which is updated each time a ticket is bought
+		ticketsRoot := k.GetDrawTicketsRoot(ctx, draw.Id)
+
+		var data types.HistoricalDrawData
+		if pool.GameParams.GameType == types.GameTypeLotto {
+			// Draw the winning numbers, rewarding the tickets matching them.
+			// The prize of the tiers without any winner is kept for the next draw
+			winningNumbers := types.ComputeWinningNumbers(seed, pool.GameParams.Picks, pool.GameParams.MaxNumber)
+
+			// Only the tickets matching at least one tier are kept in memory
+			var tickets []types.Ticket
+			var indexes []uint32
+			var aunts [][][]byte
+			k.IterateDrawTickets(ctx, draw.Id, func(position uint32, ticket types.Ticket) (stop bool) {
+				if _, found := pool.GameParams.MatchTierIndex(types.CountMatches(ticket.Numbers, winningNumbers)); found {
+					tickets = append(tickets, ticket)
+					indexes = append(indexes, position)
+				}
+				return false
+			})
+			for _, index := range indexes {
+				aunts = append(aunts, k.GetDrawTicketProof(ctx, draw.Id, index))
+			}
+
+			winners := types.ComputeLottoWinners(pool.GameParams, draw.Prize, tickets, indexes, aunts, winningNumbers)
+			rewardWinners(ctx, k, pool, winners)
+
+			data = types.NewHistoricalDrawData(
+				draw, types.Ticket{}, seed, ticketsRoot, 0, nil, winners, winningNumbers, pool.GameParams.MaxNumber,
+				rollovers,
+			)
+		} else {
+			// Get distinct random winning tickets.
+			// If there are less tickets than winning positions, the prize of the missing positions is kept for the next draw
+			tiers, prizes := pool.PrizeParams.SplitPrize(draw.Prize)
+			indexes := types.ComputeWinningIndexes(seed, draw.TicketsSold, uint32(len(tiers)))
+
+			// Each winning ticket is looked up directly by its position
+			winners := make([]types.DrawWinner, len(indexes))
+			for i, index := range indexes {
+				ticket, found := k.GetDrawTicketAt(ctx, draw.Id, index)
+				if !found {
+					panic(fmt.Errorf("ticket at position %d of draw %d not found", index, draw.Id))
+				}
+
+				proof := k.GetDrawTicketProof(ctx, draw.Id, index)
+				winners[i] = types.NewDrawWinner(tiers[i], ticket, index, proof, prizes[i], 0)
+			}
+			rewardWinners(ctx, k, pool, winners)
+
+			data = types.NewHistoricalDrawData(
+				draw, winners[0].Ticket, seed, ticketsRoot, winners[0].Index, winners[0].Proof, winners, nil, 0,
+				rollovers,
+			)
 		}
 
-		// Send the prize to the winner
-		err = k.TransferDrawPrize(ctx, draw.Prize, winner)
+		// Save the past draw
+		k.SaveHistoricalDraw(ctx, data)
+
+		// Schedule the removal of all the tickets
+		k.EnqueueDrawPruning(ctx, draw.Id)
+
+		// Assign the next draw id and reset the rollovers
+		k.AssignNextDrawID(ctx, pool.Id)
+		k.SaveCurrentDrawRollovers(ctx, pool.Id, 0)
+	} else {
+		// Nobody entered the draw, so it is recorded as empty and its prize is kept for the next one.
+		// The tickets bought during the sales cutoff window, if any, take part in the next draw
+		k.SaveHistoricalDraw(ctx, types.NewUnsettledHistoricalDrawData(draw, types.DrawStatusEmpty, rollovers))
+		k.EnqueueDrawPruning(ctx, draw.Id)
+		k.AssignNextDrawID(ctx, pool.Id)
+	}
+
+	// Create a new draw
+	endTime := ctx.BlockTime().Add(pool.DrawParams.Duration)
+	k.SaveCurrentDrawEndTime(ctx, pool.Id, endTime)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNewDraw,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDrawClosing, endTime.Format(time.RFC3339)),
+		),
+	)
+}
+
+// rolloverDraw handles the given draw of the provided pool that cannot be settled.
+// If the pool allows the given number of consecutive rollovers, the draw is recorded as rolled over and its tickets
+// are scheduled to be moved to a new draw, whose prize is seeded with a part of the wta treasury.
+// Otherwise, the tickets are refunded
+func rolloverDraw(ctx sdk.Context, k keeper.Keeper, pool types.Pool, draw types.Draw, rollovers uint32) {
+	if !pool.RolloverParams.CanRollover(rollovers) {
+		refunded, err := k.CancelCurrentDraw(ctx, pool, types.DrawStatusRefunded, rollovers)
 		if err != nil {
 			panic(err)
 		}
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
-				types.EventTypeWinnerDrawn,
-				sdk.NewAttribute(types.AttributeKeyWinnerAddress, winningTicket.Owner),
-				sdk.NewAttribute(types.AttributeKeyWonAmount, draw.Prize.String()),
+				types.EventTypeDrawRefunded,
+				sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(draw.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyRefundedAmount, refunded.String()),
 			),
 		)
+		return
+	}
 
-		// Save the past draw
-		k.SaveHistoricalDraw(ctx, types.NewHistoricalDrawData(draw, winningTicket))
+	k.SaveHistoricalDraw(ctx, types.NewUnsettledHistoricalDrawData(draw, types.DrawStatusRolledOver, rollovers))
+	nextDrawID := k.AssignNextDrawID(ctx, pool.Id)
+	k.EnqueueDrawTicketsMove(ctx, draw.Id, nextDrawID)
+	k.SaveCurrentDrawRollovers(ctx, pool.Id, rollovers)
 
-		// Remove all the tickets
-		k.WipeCurrentTickets(ctx)
+	// Seed the prize of the next draw using the treasury funds having any of the denoms accepted by the pool
+	treasury := k.GetTreasury(ctx)
+	treasuryCoins := sdk.NewCoins()
+	for _, price := range pool.TicketParams.Prices() {
+		amount := treasury.AmountOf(price.Denom).ToDec().Mul(pool.RolloverParams.TreasuryPercentage).TruncateInt()
+		treasuryCoins = treasuryCoins.Add(sdk.NewCoin(price.Denom, amount))
+	}
+	if !treasuryCoins.IsZero() {
+		err := k.FundPrizeFromTreasury(ctx, pool.Id, treasuryCoins)
+		if err != nil {
+			panic(err)
+		}
 	}
-
-	// Create a new draw
-	endTime := ctx.BlockTime().Add(k.GetDrawParams(ctx).Duration)
-	k.SaveCurrentDrawEndTime(ctx, endTime)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeNewDraw,
-			sdk.NewAttribute(types.AttributeKeyDrawClosing, endTime.Format(time.RFC3339)),
+			types.EventTypeDrawRolledOver,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(draw.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRollovers, strconv.FormatUint(uint64(rollovers), 10)),
+			sdk.NewAttribute(types.AttributeKeyTreasuryAmount, treasuryCoins.String()),
 		),
 	)
 }
+
+// rewardWinners sends to each one of the given winners of a draw of the provided pool the prize they have won
+func rewardWinners(ctx sdk.Context, k keeper.Keeper, pool types.Pool, winners []types.DrawWinner) {
+	for _, winner := range winners {
+		winnerAddr, err := sdk.AccAddressFromBech32(winner.Ticket.Owner)
+		if err != nil {
+			panic(err)
+		}
+
+		// Send the prize to the winner
+		if !winner.Prize.IsZero() {
+			err = k.TransferDrawPrize(ctx, pool.Id, winner.Prize, winnerAddr)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWinnerDrawn,
+				sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyPrizeTier, strconv.FormatUint(uint64(winner.Tier), 10)),
+				sdk.NewAttribute(types.AttributeKeyWinnerAddress, winner.Ticket.Owner),
+				sdk.NewAttribute(types.AttributeKeyWonAmount, winner.Prize.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,550 @@
+package wta_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmicbet/ledger/x/wta"
+	"github.com/cosmicbet/ledger/x/wta/keeper"
+	"github.com/cosmicbet/ledger/x/wta/testutil"
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+func TestABCITestSuite(t *testing.T) {
+	suite.Run(t, new(ABCITestSuite))
+}
+
+type ABCITestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	bk     bankkeeper.Keeper
+}
+
+func (suite *ABCITestSuite) SetupTest() {
+	input := testutil.NewTestInput()
+	suite.ctx = input.Ctx
+	suite.keeper = input.Keeper
+	suite.bk = input.BK
+	suite.keeper.SetNextDrawID(suite.ctx, 2)
+}
+
+// revealEntropy stores a commitment of the given pool revealed by a validator using the provided entropy,
+// returning the seed the draw will be settled with
+func (suite *ABCITestSuite) revealEntropy(poolID uint64, entropy []byte) []byte {
+	valAddr := sdk.ValAddress("validator-address---")
+	commitment := types.NewEntropyCommitment(
+		poolID, valAddr.String(), types.ComputeEntropyCommitment(valAddr, entropy), entropy,
+	)
+	suite.keeper.SaveEntropyCommitment(suite.ctx, commitment)
+	return types.NewSeedFromEntropy([]types.EntropyCommitment{commitment})
+}
+
+// ownerAddress returns the address of the owner of the tickets having the given index
+func ownerAddress(i int) sdk.AccAddress {
+	return sdk.AccAddress(fmt.Sprintf("ticket-owner-%07d", i))
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_UpcomingDraw() {
+	price := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	timestamp := time.Date(2020, 12, 31, 23, 55, 00, 000, time.UTC)
+	upcomingPrize := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+
+	usecases := []struct {
+		name     string
+		stored   []types.Ticket
+		expDraw  uint64
+		expCount uint32
+	}{
+		{
+			name: "empty draw with tickets bought during the sales cutoff",
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 2, timestamp, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", price, nil),
+				types.NewTicket("ticket-2", 2, timestamp, "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu", "", price, nil),
+			},
+			expDraw:  2,
+			expCount: 2,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(-time.Hour))
+			suite.keeper.ReserveUpcomingDrawID(suite.ctx, types.DefaultPoolID)
+			suite.keeper.SaveTickets(suite.ctx, uc.stored)
+
+			upcomingCollector := types.PoolUpcomingPrizeCollectorAddress(types.DefaultPoolID)
+			suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(upcomingPrize))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, upcomingCollector, upcomingPrize))
+
+			wta.BeginBlocker(suite.ctx, suite.keeper)
+
+			// The upcoming draw becomes the current one along with its tickets and prize
+			suite.Require().Equal(uc.expDraw, suite.keeper.GetCurrentDrawID(suite.ctx, types.DefaultPoolID))
+			suite.Require().Equal(uc.expCount, suite.keeper.GetDrawTicketsCount(suite.ctx, uc.expDraw))
+
+			_, found := suite.keeper.GetUpcomingDrawID(suite.ctx, types.DefaultPoolID)
+			suite.Require().False(found)
+
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, upcomingCollector).IsZero())
+			draw := suite.keeper.GetCurrentDraw(suite.ctx, types.DefaultPoolID)
+			suite.Require().Equal(upcomingPrize, draw.Prize)
+
+			// The closed draw is recorded as empty
+			historical, found := suite.keeper.GetHistoricalDraw(suite.ctx, 1)
+			suite.Require().True(found)
+			suite.Require().Equal(types.DrawStatusEmpty, historical.Status)
+		})
+	}
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_EmptyDraw() {
+	prize := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	endTime := suite.ctx.BlockTime().Add(-time.Hour)
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, endTime)
+
+	collector := authtypes.NewModuleAddress(types.PrizeCollectorName)
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(prize))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, collector, prize))
+
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+
+	// The draw is recorded as empty, and a new draw with a new id starts keeping the same prize
+	historical, found := suite.keeper.GetHistoricalDraw(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.DrawStatusEmpty, historical.Status)
+	suite.Require().Equal(types.NewDraw(1, types.DefaultPoolID, 0, 0, prize, endTime), historical.Draw)
+	suite.Require().NoError(historical.Validate())
+
+	suite.Require().Equal(uint64(2), suite.keeper.GetCurrentDrawID(suite.ctx, types.DefaultPoolID))
+	suite.Require().Equal(uint64(3), suite.keeper.GetNextDrawID(suite.ctx))
+	suite.Require().Equal(prize, suite.keeper.GetCurrentDraw(suite.ctx, types.DefaultPoolID).Prize)
+	suite.Require().Empty(suite.keeper.GetPruningQueue(suite.ctx))
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_RevealedEntropy() {
+	price := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	timestamp := time.Date(2020, 12, 31, 22, 55, 00, 000, time.UTC)
+	prize := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(-time.Hour))
+
+	tickets := make([]types.Ticket, 5)
+	for i := range tickets {
+		tickets[i] = types.NewTicket(fmt.Sprintf("ticket-%d", i), 1, timestamp, ownerAddress(i).String(), "", price, nil)
+	}
+	suite.keeper.SaveTickets(suite.ctx, tickets)
+
+	collector := types.PoolPrizeCollectorAddress(types.DefaultPoolID)
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(prize))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, collector, prize))
+
+	// Two validators reveal their entropy, while a third one never reveals it
+	firstVal := sdk.ValAddress("first-validator-----")
+	secondVal := sdk.ValAddress("second-validator----")
+	missingVal := sdk.ValAddress("missing-validator---")
+	revealed := []types.EntropyCommitment{
+		types.NewEntropyCommitment(
+			types.DefaultPoolID, firstVal.String(), types.ComputeEntropyCommitment(firstVal, []byte("first")), []byte("first"),
+		),
+		types.NewEntropyCommitment(
+			types.DefaultPoolID, secondVal.String(), types.ComputeEntropyCommitment(secondVal, []byte("second")), []byte("second"),
+		),
+	}
+	for _, commitment := range revealed {
+		suite.keeper.SaveEntropyCommitment(suite.ctx, commitment)
+	}
+	suite.keeper.SaveEntropyCommitment(suite.ctx, types.NewEntropyCommitment(
+		types.DefaultPoolID, missingVal.String(), types.ComputeEntropyCommitment(missingVal, []byte("missing")), nil,
+	))
+
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+
+	// The seed is derived from the revealed entropy only, and it determines the winning ticket
+	seed := types.NewSeedFromEntropy(revealed)
+	index := types.ComputeWinningIndexes(seed, uint32(len(tickets)), 1)[0]
+
+	historical, found := suite.keeper.GetHistoricalDraw(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.DrawStatusSettled, historical.Status)
+	suite.Require().Equal(seed, historical.Seed)
+	suite.Require().Equal(index, historical.WinningIndex)
+	suite.Require().Equal(tickets[index], historical.WinningTicket)
+	suite.Require().Equal(prize, suite.bk.GetAllBalances(suite.ctx, ownerAddress(int(index))))
+
+	// The proof returned by the query allows anyone to verify the winner starting from the revealed entropy
+	res, err := keeper.NewQuerierImpl(suite.keeper).DrawProof(
+		sdk.WrapSDKContext(suite.ctx), &types.QueryDrawProofRequest{DrawId: 1},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(seed, res.Seed)
+	suite.Require().Equal(uint32(len(tickets)), res.TotalTickets)
+	suite.Require().NoError(res.Verify())
+	suite.Require().NoError(types.VerifyDrawProof(
+		seed, res.TicketsRoot, res.WinningIndex, res.TotalTickets, res.WinningTicket, res.Proof,
+	))
+
+	// The commitments are deleted once the draw is closed, and the missing reveal is tracked
+	suite.Require().Empty(suite.keeper.GetPoolEntropyCommitments(suite.ctx, types.DefaultPoolID))
+	suite.Require().Equal(uint64(1), suite.keeper.GetMissedReveals(suite.ctx, missingVal))
+	suite.Require().Zero(suite.keeper.GetMissedReveals(suite.ctx, firstVal))
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_PrizeTiers() {
+	price := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	timestamp := time.Date(2020, 12, 31, 22, 55, 00, 000, time.UTC)
+	prize := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001))
+
+	suite.keeper.SetPrizeParams(suite.ctx, types.NewPrizeParams([]types.PrizeTier{
+		types.NewPrizeTier(1, sdk.NewDecWithPrec(50, 2)),
+		types.NewPrizeTier(2, sdk.NewDecWithPrec(30, 2)),
+		types.NewPrizeTier(3, sdk.NewDecWithPrec(20, 2)),
+	}))
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(-time.Hour))
+
+	tickets := make([]types.Ticket, 8)
+	for i := range tickets {
+		tickets[i] = types.NewTicket(fmt.Sprintf("ticket-%d", i), 1, timestamp, ownerAddress(i).String(), "", price, nil)
+	}
+	suite.keeper.SaveTickets(suite.ctx, tickets)
+
+	collector := types.PoolPrizeCollectorAddress(types.DefaultPoolID)
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(prize))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, collector, prize))
+
+	seed := suite.revealEntropy(types.DefaultPoolID, []byte("entropy"))
+	indexes := types.ComputeWinningIndexes(seed, uint32(len(tickets)), 6)
+
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+
+	// Each tier amount is split evenly among its winners, truncating the remainder:
+	// 500.5 for the first tier, 300.3 for the second one and 200.2 for the third one
+	expTiers := []uint32{0, 1, 1, 2, 2, 2}
+	expPrizes := []int64{500, 150, 150, 66, 66, 66}
+
+	historical, found := suite.keeper.GetHistoricalDraw(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.DrawStatusSettled, historical.Status)
+	suite.Require().Equal(seed, historical.Seed)
+	suite.Require().Len(historical.Winners, len(expTiers))
+	suite.Require().Equal(tickets[indexes[0]], historical.WinningTicket)
+	suite.Require().NoError(historical.Validate())
+
+	for i, winner := range historical.Winners {
+		expPrize := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, expPrizes[i]))
+		suite.Require().Equal(expTiers[i], winner.Tier)
+		suite.Require().Equal(indexes[i], winner.Index)
+		suite.Require().Equal(tickets[indexes[i]], winner.Ticket)
+		suite.Require().Equal(expPrize, winner.Prize)
+
+		// Each ticket has a different owner, so the balance of the owner matches the won prize
+		suite.Require().Equal(expPrize, suite.bk.GetAllBalances(suite.ctx, ownerAddress(int(indexes[i]))))
+	}
+
+	// The truncated remainder is kept for the next draw
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)), suite.bk.GetAllBalances(suite.ctx, collector))
+	suite.Require().Equal(uint64(2), suite.keeper.GetCurrentDrawID(suite.ctx, types.DefaultPoolID))
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_Lotto() {
+	price := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	timestamp := time.Date(2020, 12, 31, 22, 55, 00, 000, time.UTC)
+	prize := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001))
+
+	pool := types.NewPool(
+		1,
+		types.DefaultDistributionParams(),
+		types.NewDrawParams(time.Hour, time.Minute, 0, false),
+		types.NewTicketParams(price, nil, sdk.ZeroDec(), 0, 0),
+		types.DefaultPrizeParams(),
+		types.NewGameParams(types.GameTypeLotto, 3, 10, []types.MatchTier{
+			types.NewMatchTier(3, sdk.NewDecWithPrec(80, 2)),
+			types.NewMatchTier(2, sdk.NewDecWithPrec(20, 2)),
+		}),
+		types.DefaultRolloverParams(),
+	)
+	suite.keeper.SavePool(suite.ctx, pool)
+	suite.keeper.SaveCurrentDrawID(suite.ctx, pool.Id, 2)
+	suite.keeper.SetNextDrawID(suite.ctx, 3)
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, pool.Id, suite.ctx.BlockTime().Add(-time.Hour))
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+
+	// Build the picks based on the numbers that will be drawn using the revealed entropy
+	seed := suite.revealEntropy(pool.Id, []byte("entropy"))
+	winning := types.ComputeWinningNumbers(seed, pool.GameParams.Picks, pool.GameParams.MaxNumber)
+
+	var losing []uint32
+	for number := uint32(1); number <= pool.GameParams.MaxNumber; number++ {
+		if types.CountMatches([]uint32{number}, winning) == 0 {
+			losing = append(losing, number)
+		}
+	}
+
+	picks := [][]uint32{
+		{winning[0], winning[1], winning[2]}, // 3 matches
+		{winning[0], winning[1], losing[0]},  // 2 matches
+		{losing[0], losing[1], losing[2]},    // no matches
+		{winning[0], winning[2], losing[1]},  // 2 matches
+		{winning[1], losing[1], losing[2]},   // 1 match
+	}
+	tickets := make([]types.Ticket, len(picks))
+	for i, numbers := range picks {
+		tickets[i] = types.NewTicket(
+			fmt.Sprintf("ticket-%d", i), 2, timestamp, ownerAddress(i).String(), "", price, types.SortNumbers(numbers),
+		)
+	}
+	suite.keeper.SaveTickets(suite.ctx, tickets)
+
+	collector := types.PoolPrizeCollectorAddress(pool.Id)
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(prize))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, collector, prize))
+
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+
+	// The first tier gets 800.8, while the 200.2 of the second one are split between its two winners
+	expWinners := []struct {
+		index   uint32
+		tier    uint32
+		matches uint32
+		prize   int64
+	}{
+		{index: 0, tier: 0, matches: 3, prize: 800},
+		{index: 1, tier: 1, matches: 2, prize: 100},
+		{index: 3, tier: 1, matches: 2, prize: 100},
+	}
+
+	historical, found := suite.keeper.GetHistoricalDraw(suite.ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(types.DrawStatusSettled, historical.Status)
+	suite.Require().NoError(historical.Validate())
+	suite.Require().Equal(winning, historical.WinningNumbers)
+	suite.Require().Len(historical.Winners, len(expWinners))
+
+	for i, exp := range expWinners {
+		expPrize := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, exp.prize))
+		winner := historical.Winners[i]
+		suite.Require().Equal(exp.index, winner.Index)
+		suite.Require().Equal(exp.tier, winner.Tier)
+		suite.Require().Equal(exp.matches, winner.Matches)
+		suite.Require().Equal(tickets[exp.index], winner.Ticket)
+		suite.Require().Equal(expPrize, winner.Prize)
+		suite.Require().Equal(expPrize, suite.bk.GetAllBalances(suite.ctx, ownerAddress(int(exp.index))))
+	}
+
+	// The tickets not matching any tier do not win anything, and the truncated remainder is kept for the next draw
+	for _, index := range []int{2, 4} {
+		suite.Require().True(suite.bk.GetAllBalances(suite.ctx, ownerAddress(index)).IsZero())
+	}
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), suite.bk.GetAllBalances(suite.ctx, collector))
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_NoRevealedEntropy() {
+	price := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	timestamp := time.Date(2020, 12, 31, 22, 55, 00, 000, time.UTC)
+
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(-time.Hour))
+	suite.keeper.SaveTickets(suite.ctx, []types.Ticket{
+		types.NewTicket("ticket-1", 1, timestamp, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", price, nil),
+		types.NewTicket("ticket-2", 1, timestamp, "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu", "", price, nil),
+	})
+
+	suite.keeper.SetRolloverParams(suite.ctx, types.NewRolloverParams(0, sdk.NewDecWithPrec(5, 2)))
+	treasury := authtypes.NewModuleAddress(types.TreasuryName)
+	treasuryBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("uatom", 1000))
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(treasuryBalance))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, treasury, treasuryBalance))
+
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+
+	// Without any revealed entropy the draw cannot be settled, so it is rolled over along with its tickets
+	historical, found := suite.keeper.GetHistoricalDraw(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.DrawStatusRolledOver, historical.Status)
+	suite.Require().Empty(historical.Winners)
+
+	suite.Require().Equal(uint64(2), suite.keeper.GetCurrentDrawID(suite.ctx, types.DefaultPoolID))
+	suite.Require().Equal(uint32(1), suite.keeper.GetCurrentDrawRollovers(suite.ctx, types.DefaultPoolID))
+	suite.Require().Equal([]types.TicketsMove{types.NewTicketsMove(1, 2)}, suite.keeper.GetTicketsMoveQueue(suite.ctx))
+
+	// The prize of the new draw is seeded with a part of the treasury funds having the denoms accepted by the pool
+	seed := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	collector := types.PoolPrizeCollectorAddress(types.DefaultPoolID)
+	suite.Require().Equal(seed, suite.bk.GetAllBalances(suite.ctx, collector))
+	suite.Require().Equal(treasuryBalance.Sub(seed), suite.bk.GetAllBalances(suite.ctx, treasury))
+
+	wta.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().Empty(suite.keeper.GetTicketsMoveQueue(suite.ctx))
+	suite.Require().Equal(uint32(2), suite.keeper.GetDrawTicketsCount(suite.ctx, 2))
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_MaxRollovers() {
+	price := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	share := sdk.NewInt64Coin(sdk.DefaultBondDenom, 8)
+	timestamp := time.Date(2020, 12, 31, 22, 55, 00, 000, time.UTC)
+
+	// The draw has already been rolled over once, which is the maximum allowed
+	suite.keeper.SetRolloverParams(suite.ctx, types.NewRolloverParams(1, sdk.NewDecWithPrec(5, 2)))
+	suite.keeper.SaveCurrentDrawRollovers(suite.ctx, types.DefaultPoolID, 1)
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(-time.Hour))
+
+	tickets := []types.Ticket{
+		types.NewTicket("ticket-1", 1, timestamp, ownerAddress(0).String(), "", price, nil),
+		types.NewTicket("ticket-2", 1, timestamp, ownerAddress(0).String(), "", price, nil),
+		types.NewTicket("ticket-3", 1, timestamp, ownerAddress(1).String(), ownerAddress(2).String(), price, nil),
+	}
+	for i, ticket := range tickets {
+		ticket.PrizeShare = sdk.NewCoins(share)
+		tickets[i] = ticket
+	}
+	suite.keeper.SaveTickets(suite.ctx, tickets)
+
+	// The prize also contains the funds seeded from the treasury during the previous rollover
+	collector := types.PoolPrizeCollectorAddress(types.DefaultPoolID)
+	prize := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 34))
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(prize))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, collector, prize))
+
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+
+	// The draw cannot be rolled over again, so it is recorded as refunded
+	historical, found := suite.keeper.GetHistoricalDraw(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.DrawStatusRefunded, historical.Status)
+	suite.Require().Equal(uint32(2), historical.Rollovers)
+	suite.Require().Empty(historical.Winners)
+	suite.Require().NoError(historical.Validate())
+
+	// Each payer gets back the prize share of the tickets they paid for, while the seeded funds stay in the collector
+	expRefund := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 16))
+	suite.Require().Equal(expRefund, suite.bk.GetAllBalances(suite.ctx, ownerAddress(0)))
+	suite.Require().True(suite.bk.GetAllBalances(suite.ctx, ownerAddress(1)).IsZero())
+	suite.Require().Equal(sdk.NewCoins(share), suite.bk.GetAllBalances(suite.ctx, ownerAddress(2)))
+
+	expRemaining := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	suite.Require().Equal(expRemaining, suite.bk.GetAllBalances(suite.ctx, collector))
+
+	// A new draw starts without any rollover, and the refunded tickets are pruned instead of being moved
+	suite.Require().Equal(uint64(2), suite.keeper.GetCurrentDrawID(suite.ctx, types.DefaultPoolID))
+	suite.Require().Zero(suite.keeper.GetCurrentDrawRollovers(suite.ctx, types.DefaultPoolID))
+	suite.Require().Empty(suite.keeper.GetTicketsMoveQueue(suite.ctx))
+	suite.Require().Equal([]types.PruningDraw{types.NewPruningDraw(1, 3)}, suite.keeper.GetPruningQueue(suite.ctx))
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_MovingTickets() {
+	price := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	timestamp := time.Date(2020, 12, 31, 22, 55, 00, 000, time.UTC)
+
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(-time.Hour))
+	suite.keeper.SaveCurrentDrawID(suite.ctx, types.DefaultPoolID, 2)
+	suite.keeper.SetNextDrawID(suite.ctx, 3)
+	suite.keeper.SaveHistoricalDraw(suite.ctx, types.NewUnsettledHistoricalDrawData(
+		types.NewDraw(1, types.DefaultPoolID, 2, 2, sdk.NewCoins(), suite.ctx.BlockTime().Add(-2*time.Hour)),
+		types.DrawStatusRolledOver, 1,
+	))
+	suite.keeper.SaveTickets(suite.ctx, []types.Ticket{
+		types.NewTicket("ticket-1", 1, timestamp, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", price, nil),
+		types.NewTicket("ticket-2", 1, timestamp, "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu", "", price, nil),
+	})
+	suite.keeper.EnqueueDrawTicketsMove(suite.ctx, 1, 2)
+
+	// The draw is not held while the tickets of the rolled over draw are still being moved into it
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal(uint64(2), suite.keeper.GetCurrentDrawID(suite.ctx, types.DefaultPoolID))
+	_, found := suite.keeper.GetHistoricalDraw(suite.ctx, 2)
+	suite.Require().False(found)
+
+	wta.EndBlocker(suite.ctx, suite.keeper)
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+	_, found = suite.keeper.GetHistoricalDraw(suite.ctx, 2)
+	suite.Require().True(found)
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_SalesHaltedChange() {
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+
+	eventTypes := func() []string {
+		var names []string
+		for _, event := range suite.ctx.EventManager().Events() {
+			names = append(names, event.Type)
+		}
+		return names
+	}
+
+	// Halting the sales by changing the params directly emits the event once
+	suite.keeper.SetSalesParams(suite.ctx, types.NewSalesParams(true, "Wrong ticket price"))
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal([]string{types.EventTypeSalesHalted}, eventTypes())
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Empty(eventTypes())
+
+	// Restarting them emits the opposite event
+	suite.keeper.SetSalesParams(suite.ctx, types.DefaultSalesParams())
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal([]string{types.EventTypeSalesUnhalted}, eventTypes())
+
+	// Changes made through a proposal are not reported again
+	err := suite.keeper.HandleSetSalesHaltedProposal(suite.ctx, types.NewSetSalesHaltedProposal("Title", "Description", true, "Halt"))
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Empty(eventTypes())
+}
+
+func (suite *ABCITestSuite) TestBeginBlocker_OraclesChange() {
+	firstOracle := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	secondOracle := "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"
+	thirdOracle := "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"
+
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+	suite.keeper.SetTicketParams(suite.ctx, types.NewTicketParams(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), nil, sdk.NewDec(10), 0, 0,
+	))
+	suite.keeper.SetOracleParams(suite.ctx, types.NewOracleParams([]string{firstOracle, secondOracle, thirdOracle}, time.Hour))
+
+	server := keeper.NewMsgServerImpl(suite.keeper)
+	for _, msg := range []*types.MsgPostPrice{
+		types.NewMsgPostPrice(firstOracle, sdk.DefaultBondDenom, sdk.NewDec(1)),
+		types.NewMsgPostPrice(secondOracle, sdk.DefaultBondDenom, sdk.NewDec(2)),
+		types.NewMsgPostPrice(thirdOracle, sdk.DefaultBondDenom, sdk.NewDec(5)),
+	} {
+		_, err := server.PostPrice(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().NoError(err)
+	}
+
+	pool, found := suite.keeper.GetPool(suite.ctx, types.DefaultPoolID)
+	suite.Require().True(found)
+	price, err := suite.keeper.GetTicketPrice(suite.ctx, pool, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), price)
+
+	// Removing an oracle through a parameter change updates the ticket price starting from the next block
+	suite.keeper.SetOracleParams(suite.ctx, types.NewOracleParams([]string{firstOracle, thirdOracle}, time.Hour))
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+
+	price, err = suite.keeper.GetTicketPrice(suite.ctx, pool, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4), price)
+	suite.Require().Len(suite.keeper.GetPricePosts(suite.ctx, sdk.DefaultBondDenom), 2)
+
+	// Removing all the oracles that posted the price prevents the tickets from being bought until a new one is posted
+	suite.keeper.SetOracleParams(suite.ctx, types.NewOracleParams([]string{secondOracle}, time.Hour))
+	wta.BeginBlocker(suite.ctx, suite.keeper)
+
+	_, err = suite.keeper.GetTicketPrice(suite.ctx, pool, "")
+	suite.Require().ErrorIs(err, types.ErrStalePrice)
+	suite.Require().Empty(suite.keeper.GetPrices(suite.ctx))
+}
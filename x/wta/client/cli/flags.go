@@ -0,0 +1,10 @@
+package cli
+
+const (
+	FlagPoolID    = "pool-id"
+	FlagPick      = "pick"
+	FlagMatches   = "matches"
+	FlagOwner     = "owner"
+	FlagDenom     = "denom"
+	FlagRecipient = "recipient"
+)
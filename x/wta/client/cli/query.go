@@ -2,9 +2,14 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmicbet/ledger/x/wta/types"
@@ -23,29 +28,70 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetPoolsCmd(),
 		GetNextDrawCmd(),
+		GetDrawCmd(),
+		GetDrawWinnersCmd(),
+		GetDrawFeesCmd(),
 		GetPastDrawsCmd(),
+		GetVerifyDrawCmd(),
 		GetTicketsCmd(),
+		GetPruningQueueCmd(),
+		GetExclusionCmd(),
+		GetSpendingLimitCmd(),
+		GetPriceCmd(),
 		GetParamsCmd(),
 	)
 
 	return cmd
 }
 
-// GetNextDrawCmd allows to query the details of the next draw
+// GetPoolsCmd allows to query all the existing pools
+func GetPoolsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pools",
+		Short: "Get the details of all the existing pools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pools(context.Background(), &types.QueryPoolsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetNextDrawCmd allows to query the details of the next draw of a pool
 func GetNextDrawCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "next-draw",
-		Short: "Get the details of the next draw to be held",
+		Short: "Get the details of the next draw to be held in a pool",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			poolID, err := cmd.Flags().GetUint64(FlagPoolID)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.NextDraw(context.Background(), &types.QueryNextDrawRequest{})
+			res, err := queryClient.NextDraw(context.Background(), types.NewNextDrawRequest(poolID))
 			if err != nil {
 				return err
 			}
@@ -54,6 +100,115 @@ func GetNextDrawCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagPoolID, types.DefaultPoolID, "Id of the pool to which the command refers")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDrawCmd allows to query the details of the draw having the given id
+func GetDrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "draw [draw-id]",
+		Short:   "Get the details of the draw having the given id",
+		Example: fmt.Sprintf("%s query %s draw 1", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			drawID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid draw id: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Draw(context.Background(), types.NewDrawRequest(drawID))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDrawWinnersCmd allows to query the winners of a past draw, optionally filtering them by matched numbers
+func GetDrawWinnersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "draw-winners [draw-id]",
+		Short:   "Get the winners of the past draw having the given id",
+		Example: fmt.Sprintf("%s query %s draw-winners 1 --matches 6", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			drawID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid draw id: %s", args[0])
+			}
+
+			matches, err := cmd.Flags().GetUint32(FlagMatches)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DrawWinners(context.Background(), types.NewDrawWinnersRequest(drawID, matches))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagMatches, 0, "Amount of matched numbers of the winners to return, or 0 to return all of them")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDrawFeesCmd allows to query the fees of a draw that have been sent to each destination
+func GetDrawFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "draw-fees [draw-id]",
+		Short:   "Get the fees of the draw having the given id that have been sent to each destination",
+		Example: fmt.Sprintf("%s query %s draw-fees 1", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			drawID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid draw id: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DrawFees(context.Background(), types.NewDrawFeesRequest(drawID))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -92,16 +247,119 @@ func GetPastDrawsCmd() *cobra.Command {
 	return cmd
 }
 
-// GetTicketsCmd returns the Cobra command allowing to query all the sold tickets for the next draw
+// GetVerifyDrawCmd allows to verify the winners of a past draw by replaying its selection locally
+func GetVerifyDrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-draw [draw-id]",
+		Short: "Verify the winners of the past draw having the given id",
+		Long: `Fetch the seed and the winner selection proof of a past draw, and replay the selection locally.
+The winning indexes are recomputed from the seed, and each winning ticket is checked to be included inside
+the Merkle root of all the draw tickets at its index. For lotto draws, the winning numbers are recomputed
+from the seed instead, and each winner is checked to match the amount of numbers it declares.`,
+		Example: fmt.Sprintf("%s query %s verify-draw 1", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			drawID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid draw id: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DrawProof(context.Background(), types.NewDrawProofRequest(drawID))
+			if err != nil {
+				return err
+			}
+
+			if res.TicketsRoot == nil {
+				return fmt.Errorf("draw %s does not contain any winner selection proof", args[0])
+			}
+
+			result := "MATCH: the stored winner is the result of the replayed selection"
+			if err := res.Verify(); err != nil {
+				result = fmt.Sprintf("MISMATCH: %s", err)
+			}
+
+			if len(res.WinningNumbers) != 0 {
+				var winners strings.Builder
+				for _, winner := range res.Winners {
+					winners.WriteString(fmt.Sprintf("  - tier %d, %d matches: %s %v (owner %s) won %s\n",
+						winner.Tier, winner.Matches, winner.Ticket.Id, winner.Ticket.Numbers, winner.Ticket.Owner, winner.Prize))
+				}
+
+				return clientCtx.PrintString(fmt.Sprintf(`Draw id:                  %d
+Seed:                     %X
+Tickets root:             %X
+Total tickets:            %d
+Stored winning numbers:   %v
+Recomputed numbers:       %v
+Winners:
+%sResult:                   %s
+`, drawID, res.Seed, res.TicketsRoot, res.TotalTickets, res.WinningNumbers,
+					types.ComputeWinningNumbers(res.Seed, uint32(len(res.WinningNumbers)), res.MaxNumber),
+					winners.String(), result))
+			}
+
+			var recomputedIndex uint32
+			if res.TotalTickets > 0 {
+				recomputedIndex = types.ComputeWinningIndex(res.Seed, res.TotalTickets)
+			}
+
+			var winners strings.Builder
+			for _, winner := range res.Winners {
+				winners.WriteString(fmt.Sprintf("  - tier %d, index %d: %s (owner %s) won %s\n",
+					winner.Tier, winner.Index, winner.Ticket.Id, winner.Ticket.Owner, winner.Prize))
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf(`Draw id:                  %d
+Seed:                     %X
+Tickets root:             %X
+Total tickets:            %d
+Stored winning index:     %d
+Recomputed winning index: %d
+Winning ticket:           %s (owner %s)
+Winners:
+%sResult:                   %s
+`, drawID, res.Seed, res.TicketsRoot, res.TotalTickets, res.WinningIndex, recomputedIndex,
+				res.WinningTicket.Id, res.WinningTicket.Owner, winners.String(), result))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetTicketsCmd returns the Cobra command allowing to query all the sold tickets for the next draw of a pool,
+// or all the tickets owned by an address
 func GetTicketsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use: "tickets",
+		Use:   "tickets",
+		Short: "Get the tickets sold for the next draw of a pool, or the ones owned by an address",
+		Long: `Get the tickets sold for the next draw of the pool specified with --pool-id.
+When --owner is set, the tickets owned by the given address among all the pools are returned instead,
+and --pool-id is ignored.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			poolID, err := cmd.Flags().GetUint64(FlagPoolID)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
@@ -109,7 +367,20 @@ func GetTicketsCmd() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.Tickets(cmd.Context(), types.NewTicketsRequest(pageReq))
+			if owner != "" {
+				if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+					return err
+				}
+
+				res, err := queryClient.TicketsByOwner(cmd.Context(), types.NewTicketsByOwnerRequest(owner, pageReq))
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.Tickets(cmd.Context(), types.NewTicketsRequest(poolID, pageReq))
 			if err != nil {
 				return err
 			}
@@ -118,12 +389,130 @@ func GetTicketsCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagPoolID, types.DefaultPoolID, "Id of the pool to which the command refers")
+	cmd.Flags().String(FlagOwner, "", "Address of the owner of the tickets to query")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all tickets")
 
 	return cmd
 }
 
+// GetPruningQueueCmd allows to query the past draws whose tickets are still being removed
+func GetPruningQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pruning-queue",
+		Short: "Get the past draws whose tickets are still being removed, along with the number of remaining tickets",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PruningQueue(context.Background(), &types.QueryPruningQueueRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetExclusionCmd allows to query the self exclusion of an address
+func GetExclusionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exclusion [address]",
+		Short: "Get the self exclusion of the given address",
+		Example: fmt.Sprintf("%s query %s exclusion cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Exclusion(context.Background(), types.NewExclusionRequest(args[0]))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSpendingLimitCmd allows to query the spending limit of an address
+func GetSpendingLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spending-limit [address]",
+		Short: "Get the spending limit of the given address, along with the amount spent within its window",
+		Example: fmt.Sprintf("%s query %s spending-limit cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SpendingLimit(context.Background(), types.NewSpendingLimitRequest(args[0]))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetPriceCmd allows to query the median price of a denom
+func GetPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price [denom]",
+		Short:   "Get the median price of the given denom, along with the prices posted by the oracles",
+		Example: fmt.Sprintf("%s query %s price stake", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Price(context.Background(), types.NewPriceRequest(args[0]))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetParamsCmd allows to query the current parameters
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
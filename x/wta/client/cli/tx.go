@@ -1,11 +1,20 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmicbet/ledger/x/wta/types"
@@ -23,6 +32,13 @@ func NewTxCmd() *cobra.Command {
 
 	stakingTxCmd.AddCommand(
 		NewBuyTicketsCmd(),
+		NewCommitEntropyCmd(),
+		NewRevealEntropyCmd(),
+		NewSelfExcludeCmd(),
+		NewSetSpendingLimitCmd(),
+		NewRemoveSpendingLimitCmd(),
+		NewPostPriceCmd(),
+		NewTransferTicketsCmd(),
 	)
 
 	return stakingTxCmd
@@ -32,8 +48,14 @@ func NewTxCmd() *cobra.Command {
 func NewBuyTicketsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "buy-tickets [quantity]",
-		Short: "Buy the specified amount of tickets for the next draw",
-		Args:  cobra.ExactArgs(1),
+		Short: "Buy the specified amount of tickets for the next draw of a pool",
+		Long: `Buy the specified amount of tickets for the next draw of a pool.
+When buying the tickets of a lotto pool, the numbers picked for each ticket must be specified using a --pick flag.
+The tickets are paid using the main price of the pool, unless the denomination of one of its alternative prices is specified using the --denom flag.
+The tickets are owned by the sender, unless the address that should own them is specified using the --recipient flag.`,
+		Example: fmt.Sprintf("%s tx %s buy-tickets 2 --pool-id 1 --pick 1,2,3,4,5,6 --pick 7,8,9,10,11,12",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -45,7 +67,245 @@ func NewBuyTicketsCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgBuyTickets(uint32(quantity), clientCtx.GetFromAddress().String())
+			poolID, err := cmd.Flags().GetUint64(FlagPoolID)
+			if err != nil {
+				return err
+			}
+
+			pickValues, err := cmd.Flags().GetStringArray(FlagPick)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			picks := make([]types.NumbersPick, len(pickValues))
+			for i, value := range pickValues {
+				picks[i], err = parseNumbersPick(value)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgBuyTickets(
+				poolID, uint32(quantity), denom, clientCtx.GetFromAddress().String(), recipient, picks,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagPoolID, types.DefaultPoolID, "Id of the pool to which the command refers")
+	cmd.Flags().StringArray(FlagPick, nil, "Comma-separated numbers picked for a ticket of a lotto pool, to be repeated for each ticket")
+	cmd.Flags().String(FlagDenom, "", "Denomination used to pay for the tickets, or empty to use the main price of the pool")
+	cmd.Flags().String(FlagRecipient, "", "Address that will own the tickets, or empty to buy them for the sender")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseNumbersPick parses the given comma-separated list of numbers as a types.NumbersPick
+func parseNumbersPick(value string) (types.NumbersPick, error) {
+	values := strings.Split(value, ",")
+	numbers := make([]uint32, len(values))
+	for i, v := range values {
+		number, err := strconv.ParseUint(strings.TrimSpace(v), 10, 32)
+		if err != nil {
+			return types.NumbersPick{}, fmt.Errorf("invalid picked number: %s", v)
+		}
+		numbers[i] = uint32(number)
+	}
+	return types.NewNumbersPick(numbers...), nil
+}
+
+// NewCommitEntropyCmd returns the Cobra command allowing a validator to commit to an entropy value for the current draw
+func NewCommitEntropyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-entropy [entropy]",
+		Short: "Commit to the given hex-encoded entropy that will be revealed once the current draw ends",
+		Long: `Commit to the given hex-encoded entropy that will be used to seed the current draw.
+Only the hash of the entropy is sent to the chain. The same entropy will have to be revealed
+using the reveal-entropy command after the draw end time, before the reveal window closes.
+The entropy must be at most 64 bytes long, as longer values cannot be revealed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entropy, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Only the commitment reaches the chain, so an entropy that could never be revealed must be rejected here
+			if err := types.ValidateEntropy(entropy); err != nil {
+				return err
+			}
+
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			commitment := types.ComputeEntropyCommitment(valAddr, entropy)
+
+			poolID, err := cmd.Flags().GetUint64(FlagPoolID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCommitEntropy(poolID, commitment, valAddr.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagPoolID, types.DefaultPoolID, "Id of the pool to which the command refers")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRevealEntropyCmd returns the Cobra command allowing a validator to reveal the entropy previously committed
+func NewRevealEntropyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-entropy [entropy]",
+		Short: "Reveal the hex-encoded entropy previously committed for the current draw",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entropy, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			poolID, err := cmd.Flags().GetUint64(FlagPoolID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealEntropy(poolID, entropy, valAddr.String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagPoolID, types.DefaultPoolID, "Id of the pool to which the command refers")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSelfExcludeCmd returns the Cobra command allowing an address to exclude itself from buying tickets
+// until the given time
+func NewSelfExcludeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "self-exclude [until]",
+		Short: "Prevent the sender from buying any ticket until the given RFC3339 time",
+		Long: `Prevent the sender from buying any ticket until the given RFC3339 time.
+An existing exclusion can be extended, but cannot be shortened until it expires.`,
+		Example: fmt.Sprintf("%s tx %s self-exclude 2022-01-01T00:00:00Z", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			until, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid exclusion end time: %s", args[0])
+			}
+
+			msg := types.NewMsgSelfExclude(clientCtx.GetFromAddress().String(), until.UTC())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetSpendingLimitCmd returns the Cobra command allowing an address to limit the amount it can spend
+// buying tickets within a rolling window
+func NewSetSpendingLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-spending-limit [amount] [window]",
+		Short: "Limit the amount that the sender can spend buying tickets within the given rolling window",
+		Long: `Limit the amount that the sender can spend buying tickets within the given rolling window.
+Lowering the current limit applies immediately, while raising it only takes effect after a cooling-off delay.`,
+		Example: fmt.Sprintf("%s tx %s set-spending-limit 500000000stake 168h", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid spending limit amount: %s", args[0])
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid spending limit window: %s", args[1])
+			}
+
+			msg := types.NewMsgSetSpendingLimit(clientCtx.GetFromAddress().String(), amount, window)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveSpendingLimitCmd returns the Cobra command allowing an address to remove its spending limit
+func NewRemoveSpendingLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-spending-limit",
+		Short:   "Remove the spending limit of the sender once the cooling-off delay has passed",
+		Example: fmt.Sprintf("%s tx %s remove-spending-limit", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSpendingLimit(clientCtx.GetFromAddress().String(), sdk.NewCoins(), 0)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -58,3 +318,292 @@ func NewBuyTicketsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewPostPriceCmd returns the Cobra command allowing a whitelisted oracle to post the price of a denom
+func NewPostPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "post-price [denom] [price]",
+		Short: "Post the price of a denom expressed in the reference unit of the tickets prices",
+		Long: `Post the price of one unit of the given denom, expressed in the reference unit of the tickets prices.
+Only the whitelisted oracles are allowed to post prices, and the median of their recent prices is used.`,
+		Example: fmt.Sprintf("%s tx %s post-price stake 0.25", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid price: %s", args[1])
+			}
+
+			msg := types.NewMsgPostPrice(clientCtx.GetFromAddress().String(), args[0], price)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTransferTicketsCmd returns the Cobra command allowing to transfer some tickets of the current draw
+// to another address
+func NewTransferTicketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-tickets [recipient] [ticket-id]...",
+		Short: "Transfer one or more tickets of the current draw of a pool to another address",
+		Long: `Transfer one or more tickets of the current draw of a pool to the given recipient.
+The tickets must be owned by the sender, and can only be transferred before the sales cutoff of their draw.`,
+		Example: fmt.Sprintf("%s tx %s transfer-tickets cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu 0a1b2c3d4e5f --pool-id 1",
+			version.AppName, types.ModuleName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := cmd.Flags().GetUint64(FlagPoolID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTickets(poolID, args[1:], clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagPoolID, types.DefaultPoolID, "Id of the pool to which the command refers")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitCreatePoolProposal returns the Cobra command allowing to submit a governance proposal
+// to create a new pool
+func NewCmdSubmitCreatePoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wta-create-pool [pool-file]",
+		Short: "Submit a proposal to create a new pool",
+		Long: `Submit a proposal to create a new pool along with an initial deposit.
+The pool must be specified inside a JSON file containing its id along with its draw, ticket, prize,
+distribution and game params. If the proposal passes, the first draw of the pool starts right away.`,
+		Example: fmt.Sprintf(`%s tx gov submit-proposal wta-create-pool pool.json --title "Weekly lottery" --description "Create pool 2" --deposit 1000stake`,
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var pool types.Pool
+			err = clientCtx.JSONMarshaler.UnmarshalJSON(bz, &pool)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return types.NewCreatePoolProposal(title, description, pool)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitPauseSalesProposal returns the Cobra command allowing to submit a governance proposal
+// to pause the ticket sales of a pool
+func NewCmdSubmitPauseSalesProposal() *cobra.Command {
+	return newCmdSubmitPoolProposal(
+		"wta-pause-sales",
+		"Submit a proposal to pause the ticket sales of a pool",
+		`Submit a proposal to pause the ticket sales of a pool along with an initial deposit.
+If the proposal passes, no ticket of the pool can be bought until a proposal to resume its sales passes.
+The draws of the pool keep running as usual.`,
+		func(title, description string, poolID uint64) govtypes.Content {
+			return types.NewPauseSalesProposal(title, description, poolID)
+		},
+	)
+}
+
+// NewCmdSubmitResumeSalesProposal returns the Cobra command allowing to submit a governance proposal
+// to resume the ticket sales of a pool
+func NewCmdSubmitResumeSalesProposal() *cobra.Command {
+	return newCmdSubmitPoolProposal(
+		"wta-resume-sales",
+		"Submit a proposal to resume the ticket sales of a pool",
+		`Submit a proposal to resume the ticket sales of a pool along with an initial deposit.
+If the proposal passes, the tickets of the pool can be bought again.`,
+		func(title, description string, poolID uint64) govtypes.Content {
+			return types.NewResumeSalesProposal(title, description, poolID)
+		},
+	)
+}
+
+// NewCmdSubmitCloseDrawProposal returns the Cobra command allowing to submit a governance proposal
+// to close the current draw of a pool early
+func NewCmdSubmitCloseDrawProposal() *cobra.Command {
+	return newCmdSubmitPoolProposal(
+		"wta-close-draw",
+		"Submit a proposal to close the current draw of a pool early",
+		`Submit a proposal to close the current draw of a pool early along with an initial deposit.
+If the proposal passes, the current draw ends right away and its winners are drawn once
+the entropy reveal window is over.`,
+		func(title, description string, poolID uint64) govtypes.Content {
+			return types.NewCloseDrawProposal(title, description, poolID)
+		},
+	)
+}
+
+// NewCmdSubmitCancelDrawProposal returns the Cobra command allowing to submit a governance proposal
+// to cancel the current draw of a pool
+func NewCmdSubmitCancelDrawProposal() *cobra.Command {
+	return newCmdSubmitPoolProposal(
+		"wta-cancel-draw",
+		"Submit a proposal to cancel the current draw of a pool",
+		`Submit a proposal to cancel the current draw of a pool along with an initial deposit.
+If the proposal passes, the tickets of the draw are deleted and their owners get back the part of their cost
+that was added to the prize.`,
+		func(title, description string, poolID uint64) govtypes.Content {
+			return types.NewCancelDrawProposal(title, description, poolID)
+		},
+	)
+}
+
+// NewCmdSubmitSetSalesHaltedProposal returns the Cobra command allowing to submit a governance proposal
+// to halt or restart the ticket sales of all the pools
+func NewCmdSubmitSetSalesHaltedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wta-set-sales-halted [halted] [halt-reason]",
+		Short: "Submit a proposal to halt or restart the ticket sales of all the pools",
+		Long: `Submit a proposal to halt or restart the ticket sales of all the pools along with an initial deposit.
+When halting the sales a reason must be provided, while it must be omitted when restarting them.
+If the proposal passes, no ticket can be bought until a proposal restarting the sales passes.`,
+		Example: fmt.Sprintf(`%s tx gov submit-proposal wta-set-sales-halted true "Wrong ticket price" --title "Halt sales" --description "Halt the sales" --deposit 1000stake`,
+			version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			halted, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			var haltReason string
+			if len(args) > 1 {
+				haltReason = args[1]
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return types.NewSetSalesHaltedProposal(title, description, halted, haltReason)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// newCmdSubmitPoolProposal returns a Cobra command allowing to submit a governance proposal
+// whose content only refers to the pool having the id given as argument
+func newCmdSubmitPoolProposal(
+	name, short, long string, newContent func(title, description string, poolID uint64) govtypes.Content,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s [pool-id]", name),
+		Short: short,
+		Long:  long,
+		Example: fmt.Sprintf(`%s tx gov submit-proposal %s 1 --title "Title" --description "Description" --deposit 1000stake`,
+			version.AppName, name),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, func(title, description string) govtypes.Content {
+				return newContent(title, description, poolID)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// submitProposal reads the proposal flags of the given command, and generates or broadcasts
+// a transaction submitting a proposal with the content built by the given function
+func submitProposal(
+	clientCtx client.Context, cmd *cobra.Command, buildContent func(title, description string) govtypes.Content,
+) error {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(buildContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// addProposalFlags adds the flags required to submit a governance proposal to the given command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmicbet/ledger/x/wta/client/cli"
+	"github.com/cosmicbet/ledger/x/wta/client/rest"
+)
+
+var (
+	// CreatePoolProposalHandler is the proposal handler allowing to create a new pool
+	CreatePoolProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitCreatePoolProposal, rest.CreatePoolProposalRESTHandler,
+	)
+
+	// PauseSalesProposalHandler is the proposal handler allowing to pause the ticket sales of a pool
+	PauseSalesProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitPauseSalesProposal, rest.PauseSalesProposalRESTHandler,
+	)
+
+	// ResumeSalesProposalHandler is the proposal handler allowing to resume the ticket sales of a pool
+	ResumeSalesProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitResumeSalesProposal, rest.ResumeSalesProposalRESTHandler,
+	)
+
+	// CloseDrawProposalHandler is the proposal handler allowing to close the current draw of a pool early
+	CloseDrawProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitCloseDrawProposal, rest.CloseDrawProposalRESTHandler,
+	)
+
+	// SetSalesHaltedProposalHandler is the proposal handler allowing to halt or restart the ticket sales of all the pools
+	SetSalesHaltedProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitSetSalesHaltedProposal, rest.SetSalesHaltedProposalRESTHandler,
+	)
+
+	// CancelDrawProposalHandler is the proposal handler allowing to cancel the current draw of a pool
+	CancelDrawProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitCancelDrawProposal, rest.CancelDrawProposalRESTHandler,
+	)
+)
@@ -0,0 +1,168 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+// CreatePoolProposalReq defines the request body used to submit a proposal to create a new pool
+type CreatePoolProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Pool        types.Pool   `json:"pool" yaml:"pool"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+}
+
+// PoolProposalReq defines the request body used to submit a proposal referring to a single pool
+type PoolProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	PoolID      uint64       `json:"pool_id" yaml:"pool_id"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+}
+
+// SetSalesHaltedProposalReq defines the request body used to submit a proposal to halt or restart
+// the ticket sales of all the pools
+type SetSalesHaltedProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Halted      bool         `json:"halted" yaml:"halted"`
+	HaltReason  string       `json:"halt_reason" yaml:"halt_reason"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+}
+
+// CreatePoolProposalRESTHandler returns the ProposalRESTHandler allowing to submit a proposal to create a new pool
+func CreatePoolProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "create_pool",
+		Handler:  postCreatePoolProposalHandlerFn(clientCtx),
+	}
+}
+
+// PauseSalesProposalRESTHandler returns the ProposalRESTHandler allowing to submit a proposal to pause
+// the ticket sales of a pool
+func PauseSalesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pause_sales",
+		Handler: postPoolProposalHandlerFn(clientCtx, func(title, description string, poolID uint64) govtypes.Content {
+			return types.NewPauseSalesProposal(title, description, poolID)
+		}),
+	}
+}
+
+// ResumeSalesProposalRESTHandler returns the ProposalRESTHandler allowing to submit a proposal to resume
+// the ticket sales of a pool
+func ResumeSalesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "resume_sales",
+		Handler: postPoolProposalHandlerFn(clientCtx, func(title, description string, poolID uint64) govtypes.Content {
+			return types.NewResumeSalesProposal(title, description, poolID)
+		}),
+	}
+}
+
+// CloseDrawProposalRESTHandler returns the ProposalRESTHandler allowing to submit a proposal to close
+// the current draw of a pool early
+func CloseDrawProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "close_draw",
+		Handler: postPoolProposalHandlerFn(clientCtx, func(title, description string, poolID uint64) govtypes.Content {
+			return types.NewCloseDrawProposal(title, description, poolID)
+		}),
+	}
+}
+
+// CancelDrawProposalRESTHandler returns the ProposalRESTHandler allowing to submit a proposal to cancel
+// the current draw of a pool
+func CancelDrawProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_draw",
+		Handler: postPoolProposalHandlerFn(clientCtx, func(title, description string, poolID uint64) govtypes.Content {
+			return types.NewCancelDrawProposal(title, description, poolID)
+		}),
+	}
+}
+
+// SetSalesHaltedProposalRESTHandler returns the ProposalRESTHandler allowing to submit a proposal to halt
+// or restart the ticket sales of all the pools
+func SetSalesHaltedProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_sales_halted",
+		Handler:  postSetSalesHaltedProposalHandlerFn(clientCtx),
+	}
+}
+
+func postCreatePoolProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreatePoolProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewCreatePoolProposal(req.Title, req.Description, req.Pool)
+		writeProposalTxResponse(clientCtx, w, req.BaseReq, content, req.Deposit)
+	}
+}
+
+func postSetSalesHaltedProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetSalesHaltedProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewSetSalesHaltedProposal(req.Title, req.Description, req.Halted, req.HaltReason)
+		writeProposalTxResponse(clientCtx, w, req.BaseReq, content, req.Deposit)
+	}
+}
+
+func postPoolProposalHandlerFn(
+	clientCtx client.Context, newContent func(title, description string, poolID uint64) govtypes.Content,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PoolProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := newContent(req.Title, req.Description, req.PoolID)
+		writeProposalTxResponse(clientCtx, w, req.BaseReq, content, req.Deposit)
+	}
+}
+
+// writeProposalTxResponse writes the transaction submitting a proposal with the given content and deposit
+func writeProposalTxResponse(
+	clientCtx client.Context, w http.ResponseWriter, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins,
+) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
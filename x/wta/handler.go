@@ -3,6 +3,7 @@ package wta
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmicbet/ledger/x/wta/keeper"
 	"github.com/cosmicbet/ledger/x/wta/types"
@@ -20,9 +21,57 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.BuyTickets(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCommitEntropy:
+			res, err := msgServer.CommitEntropy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevealEntropy:
+			res, err := msgServer.RevealEntropy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSelfExclude:
+			res, err := msgServer.SelfExclude(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetSpendingLimit:
+			res, err := msgServer.SetSpendingLimit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgPostPrice:
+			res, err := msgServer.PostPrice(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgTransferTickets:
+			res, err := msgServer.TransferTickets(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest,
 				"unrecognized %s message type: %v", types.ModuleName, msg.Type())
 		}
 	}
 }
+
+// NewProposalHandler returns a handler for "wta" type governance proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.CancelDrawProposal:
+			return k.HandleCancelDrawProposal(ctx, c)
+		case *types.CreatePoolProposal:
+			return k.HandleCreatePoolProposal(ctx, c)
+		case *types.PauseSalesProposal:
+			return k.HandlePauseSalesProposal(ctx, c)
+		case *types.ResumeSalesProposal:
+			return k.HandleResumeSalesProposal(ctx, c)
+		case *types.CloseDrawProposal:
+			return k.HandleCloseDrawProposal(ctx, c)
+		case *types.SetSalesHaltedProposal:
+			return k.HandleSetSalesHaltedProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest,
+				"unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -35,11 +35,86 @@ func (k Keeper) GetTickets(ctx sdk.Context) []types.Ticket {
 	return tickets
 }
 
-// GetDrawParticipantsAndTickets returns the list of participants that have entered the draw,
-// and the list of all tickets sold for such draw
-func (k Keeper) GetDrawParticipantsAndTickets(ctx sdk.Context) (participants []string, ticketsSold []types.Ticket) {
+// IterateDrawTickets iterates through the tickets of the draw having the given id sorted by their position,
+// and performs the provided function
+func (k Keeper) IterateDrawTickets(
+	ctx sdk.Context, drawID uint64, fn func(position uint32, ticket types.Ticket) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DrawTicketsPrefix(drawID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		position := types.MustUnmarshalTicketPosition(iterator.Key()[len(types.DrawTicketsPrefix(drawID)):])
+		ticket := types.MustUnmarshalTicket(k.cdc, store.Get(types.TicketsStoreKey(drawID, string(iterator.Value()))))
+
+		stop := fn(position, ticket)
+		if stop {
+			break
+		}
+	}
+}
+
+// IterateDrawOwnersTicketsCount iterates through the participants of the draw having the given id along with
+// the number of tickets each one of them has bought, and performs the provided function
+func (k Keeper) IterateDrawOwnersTicketsCount(
+	ctx sdk.Context, drawID uint64, fn func(owner string, count uint32) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.DrawOwnersTicketsPrefix(drawID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		stop := fn(string(iterator.Key()[len(prefix):]), types.MustUnmarshalTicketsCount(iterator.Value()))
+		if stop {
+			break
+		}
+	}
+}
+
+// GetPruningQueue returns the past draws whose tickets still need to be removed, sorted by their id
+func (k Keeper) GetPruningQueue(ctx sdk.Context) []types.PruningDraw {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PruningQueueStorePrefix)
+	defer iterator.Close()
+
+	var draws []types.PruningDraw
+	for ; iterator.Valid(); iterator.Next() {
+		drawID := sdk.BigEndianToUint64(iterator.Key()[len(types.PruningQueueStorePrefix):])
+		draws = append(draws, types.NewPruningDraw(drawID, types.MustUnmarshalTicketsCount(iterator.Value())))
+	}
+
+	return draws
+}
+
+// GetTicketsMoveQueue returns the rolled over draws whose tickets are still being moved to a new draw,
+// sorted by their id
+func (k Keeper) GetTicketsMoveQueue(ctx sdk.Context) []types.TicketsMove {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TicketsMoveQueueStorePrefix)
+	defer iterator.Close()
+
+	var moves []types.TicketsMove
+	for ; iterator.Valid(); iterator.Next() {
+		drawID := sdk.BigEndianToUint64(iterator.Key()[len(types.TicketsMoveQueueStorePrefix):])
+		moves = append(moves, types.NewTicketsMove(drawID, types.MustUnmarshalDrawID(iterator.Value())))
+	}
+
+	return moves
+}
+
+// GetDrawParticipantsAndTickets returns the list of participants that have entered the draw having the given id,
+// and the list of all tickets sold for such draw sorted by their position
+func (k Keeper) GetDrawParticipantsAndTickets(
+	ctx sdk.Context, drawID uint64,
+) (participants []string, ticketsSold []types.Ticket) {
 	participantsAddresses := map[string]bool{}
-	k.IterateTickets(ctx, func(index int64, ticket types.Ticket) (stop bool) {
+	k.IterateDrawTickets(ctx, drawID, func(_ uint32, ticket types.Ticket) (stop bool) {
 		if !participantsAddresses[ticket.Owner] {
 			participants = append(participants, ticket.Owner)
 			participantsAddresses[ticket.Owner] = true
@@ -80,3 +155,212 @@ func (k Keeper) GetHistoricalDrawsData(ctx sdk.Context) []types.HistoricalDrawDa
 	})
 	return historicalDraws
 }
+
+// IteratePools iterates through the pools, starting from the default one, and performs the provided function
+func (k Keeper) IteratePools(ctx sdk.Context, fn func(index int64, pool types.Pool) (stop bool)) {
+	stop := fn(0, k.getDefaultPool(ctx))
+	if stop {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolsStorePrefix)
+	defer iterator.Close()
+
+	i := int64(1)
+	for ; iterator.Valid(); iterator.Next() {
+		pool := types.MustUnmarshalPool(k.cdc, iterator.Value())
+
+		stop := fn(i, pool)
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetPools returns the list of all the pools, including the default one
+func (k Keeper) GetPools(ctx sdk.Context) []types.Pool {
+	var pools []types.Pool
+	k.IteratePools(ctx, func(_ int64, pool types.Pool) (stop bool) {
+		pools = append(pools, pool)
+		return false
+	})
+	return pools
+}
+
+// IterateEntropyCommitments iterates through the entropy commitments and performs the provided function
+func (k Keeper) IterateEntropyCommitments(ctx sdk.Context, fn func(index int64, commitment types.EntropyCommitment) (stop bool)) {
+	k.iterateEntropyCommitments(ctx, types.EntropyCommitmentsStorePrefix, fn)
+}
+
+// iterateEntropyCommitments iterates through the entropy commitments stored under the given prefix
+// and performs the provided function
+func (k Keeper) iterateEntropyCommitments(
+	ctx sdk.Context, prefix []byte, fn func(index int64, commitment types.EntropyCommitment) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		commitment := types.MustUnmarshalEntropyCommitment(k.cdc, iterator.Value())
+
+		stop := fn(i, commitment)
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetEntropyCommitments returns the list of entropy commitments currently stored
+func (k Keeper) GetEntropyCommitments(ctx sdk.Context) []types.EntropyCommitment {
+	var commitments []types.EntropyCommitment
+	k.IterateEntropyCommitments(ctx, func(_ int64, commitment types.EntropyCommitment) (stop bool) {
+		commitments = append(commitments, commitment)
+		return false
+	})
+	return commitments
+}
+
+// GetPoolEntropyCommitments returns the list of entropy commitments stored for the current draw of the given pool
+func (k Keeper) GetPoolEntropyCommitments(ctx sdk.Context, poolID uint64) []types.EntropyCommitment {
+	var commitments []types.EntropyCommitment
+	prefix := types.PoolEntropyCommitmentsPrefix(poolID)
+	k.iterateEntropyCommitments(ctx, prefix, func(_ int64, commitment types.EntropyCommitment) (stop bool) {
+		commitments = append(commitments, commitment)
+		return false
+	})
+	return commitments
+}
+
+// GetAllMissedReveals returns the missed reveals count of all the validators that have missed at least one reveal
+func (k Keeper) GetAllMissedReveals(ctx sdk.Context) []types.MissedReveals {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.MissedRevealsStorePrefix)
+	defer iterator.Close()
+
+	var missedReveals []types.MissedReveals
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.MissedRevealsStorePrefix):])
+		count := types.MustUnmarshalMissedRevealsCount(iterator.Value())
+		missedReveals = append(missedReveals, types.NewMissedReveals(valAddr.String(), count))
+	}
+
+	return missedReveals
+}
+
+// GetExclusions returns all the stored self exclusions, including the expired ones
+func (k Keeper) GetExclusions(ctx sdk.Context) []types.Exclusion {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ExclusionsStorePrefix)
+	defer iterator.Close()
+
+	var exclusions []types.Exclusion
+	for ; iterator.Valid(); iterator.Next() {
+		exclusions = append(exclusions, types.MustUnmarshalExclusion(k.cdc, iterator.Value()))
+	}
+
+	return exclusions
+}
+
+// GetSpendingLimits returns all the stored spending limits, including their pending limits
+func (k Keeper) GetSpendingLimits(ctx sdk.Context) []types.SpendingLimit {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SpendingLimitsStorePrefix)
+	defer iterator.Close()
+
+	var limits []types.SpendingLimit
+	for ; iterator.Valid(); iterator.Next() {
+		limits = append(limits, types.MustUnmarshalSpendingLimit(k.cdc, iterator.Value()))
+	}
+
+	return limits
+}
+
+// GetSpendRecords returns all the stored spend records
+func (k Keeper) GetSpendRecords(ctx sdk.Context) []types.SpendRecord {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SpendRecordsStorePrefix)
+	defer iterator.Close()
+
+	var records []types.SpendRecord
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, types.MustUnmarshalSpendRecord(k.cdc, iterator.Value()))
+	}
+
+	return records
+}
+
+// GetDrawFeesByDraw returns the fees of the draw having the given id that have been sent to each destination
+func (k Keeper) GetDrawFeesByDraw(ctx sdk.Context, drawID uint64) []types.DrawFees {
+	return k.getDrawFeesWithPrefix(ctx, types.DrawFeesPrefix(drawID))
+}
+
+// GetAllDrawFees returns the fees of all the draws that have been sent to each destination
+func (k Keeper) GetAllDrawFees(ctx sdk.Context) []types.DrawFees {
+	return k.getDrawFeesWithPrefix(ctx, types.DrawFeesStorePrefix)
+}
+
+// getDrawFeesWithPrefix returns all the draw fees stored using the given prefix
+func (k Keeper) getDrawFeesWithPrefix(ctx sdk.Context, prefix []byte) []types.DrawFees {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var fees []types.DrawFees
+	for ; iterator.Valid(); iterator.Next() {
+		fees = append(fees, types.MustUnmarshalDrawFees(k.cdc, iterator.Value()))
+	}
+
+	return fees
+}
+
+// GetPricePosts returns the prices of the given denom posted by each oracle
+func (k Keeper) GetPricePosts(ctx sdk.Context, denom string) []types.PricePost {
+	return k.getPricePostsWithPrefix(ctx, types.PricePostsPrefix(denom))
+}
+
+// GetAllPricePosts returns the prices of all the denoms posted by each oracle
+func (k Keeper) GetAllPricePosts(ctx sdk.Context) []types.PricePost {
+	return k.getPricePostsWithPrefix(ctx, types.PricePostsStorePrefix)
+}
+
+// getPricePostsWithPrefix returns all the price posts stored using the given prefix
+func (k Keeper) getPricePostsWithPrefix(ctx sdk.Context, prefix []byte) []types.PricePost {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var posts []types.PricePost
+	for ; iterator.Valid(); iterator.Next() {
+		posts = append(posts, types.MustUnmarshalPricePost(k.cdc, iterator.Value()))
+	}
+
+	return posts
+}
+
+// GetPrices returns the median prices of all the denoms
+func (k Keeper) GetPrices(ctx sdk.Context) []types.Price {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PricesStorePrefix)
+	defer iterator.Close()
+
+	var prices []types.Price
+	for ; iterator.Valid(); iterator.Next() {
+		prices = append(prices, types.MustUnmarshalPrice(k.cdc, iterator.Value()))
+	}
+
+	return prices
+}
@@ -12,18 +12,30 @@ func (suite *KeeperTestSuite) Test_IterateTickets() {
 	tickets := []types.Ticket{
 		types.NewTicket(
 			"1",
+			1,
 			time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 			"owner-1",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
 		types.NewTicket(
 			"2",
+			1,
 			time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
 			"owner-2",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
 		types.NewTicket(
 			"3",
+			1,
 			time.Date(2020, 1, 3, 00, 00, 00, 000, time.UTC),
 			"owner-3",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
 	}
 	suite.keeper.SaveTickets(suite.ctx, tickets)
@@ -40,18 +52,30 @@ func (suite *KeeperTestSuite) Test_GetTickets() {
 	tickets := []types.Ticket{
 		types.NewTicket(
 			"1",
+			1,
 			time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 			"owner-1",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
 		types.NewTicket(
 			"2",
+			1,
 			time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
 			"owner-2",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
 		types.NewTicket(
 			"3",
+			1,
 			time.Date(2020, 1, 3, 00, 00, 00, 000, time.UTC),
 			"owner-3",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
 	}
 	suite.keeper.SaveTickets(suite.ctx, tickets)
@@ -72,18 +96,30 @@ func (suite *KeeperTestSuite) Test_GetDrawParticipantsAndTicketsSold() {
 			tickets: []types.Ticket{
 				types.NewTicket(
 					"ticket-1",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-2",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-3",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 			},
 			expParticipants: []string{
@@ -96,33 +132,57 @@ func (suite *KeeperTestSuite) Test_GetDrawParticipantsAndTicketsSold() {
 			tickets: []types.Ticket{
 				types.NewTicket(
 					"ticket-1",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-2",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-3",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-20",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-2",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-21",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-2",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-30",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-3",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 			},
 			expParticipants: []string{
@@ -139,7 +199,7 @@ func (suite *KeeperTestSuite) Test_GetDrawParticipantsAndTicketsSold() {
 		suite.Run(uc.name, func() {
 			suite.keeper.SaveTickets(suite.ctx, uc.tickets)
 
-			participants, ticketsSold := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx)
+			participants, ticketsSold := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx, 1)
 			suite.Require().Equal(uc.expParticipants, participants)
 			suite.Require().Len(ticketsSold, uc.exptTicketsLen)
 		})
@@ -150,6 +210,8 @@ func (suite *KeeperTestSuite) Test_IterateHistoricalDrawsData() {
 	data := []types.HistoricalDrawData{
 		types.NewHistoricalDrawData(
 			types.NewDraw(
+				1,
+				types.DefaultPoolID,
 				1,
 				1,
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -157,12 +219,26 @@ func (suite *KeeperTestSuite) Test_IterateHistoricalDrawsData() {
 			),
 			types.NewTicket(
 				"ticket-1",
+				1,
 				time.Date(2019, 12, 31, 23, 59, 59, 999, time.UTC),
 				"winner-1",
+				"",
+				sdk.NewInt64Coin("stake", 10),
+				nil,
 			),
+			nil,
+			nil,
+			0,
+			nil,
+			nil,
+			nil,
+			0,
+			0,
 		),
 		types.NewHistoricalDrawData(
 			types.NewDraw(
+				2,
+				types.DefaultPoolID,
 				10,
 				100,
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
@@ -170,9 +246,21 @@ func (suite *KeeperTestSuite) Test_IterateHistoricalDrawsData() {
 			),
 			types.NewTicket(
 				"ticket-2",
+				2,
 				time.Date(2020, 1, 1, 23, 59, 59, 999, time.UTC),
 				"winner-3",
+				"",
+				sdk.NewInt64Coin("stake", 10),
+				nil,
 			),
+			nil,
+			nil,
+			0,
+			nil,
+			nil,
+			nil,
+			0,
+			0,
 		),
 	}
 	for _, data := range data {
@@ -191,6 +279,8 @@ func (suite *KeeperTestSuite) Test_GetHistoricalDrawsData() {
 	data := []types.HistoricalDrawData{
 		types.NewHistoricalDrawData(
 			types.NewDraw(
+				1,
+				types.DefaultPoolID,
 				1,
 				1,
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -198,12 +288,26 @@ func (suite *KeeperTestSuite) Test_GetHistoricalDrawsData() {
 			),
 			types.NewTicket(
 				"ticket-1",
+				1,
 				time.Date(2019, 12, 31, 23, 59, 59, 999, time.UTC),
 				"winner-1",
+				"",
+				sdk.NewInt64Coin("stake", 10),
+				nil,
 			),
+			nil,
+			nil,
+			0,
+			nil,
+			nil,
+			nil,
+			0,
+			0,
 		),
 		types.NewHistoricalDrawData(
 			types.NewDraw(
+				2,
+				types.DefaultPoolID,
 				10,
 				100,
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
@@ -211,9 +315,21 @@ func (suite *KeeperTestSuite) Test_GetHistoricalDrawsData() {
 			),
 			types.NewTicket(
 				"ticket-2",
+				2,
 				time.Date(2020, 1, 1, 23, 59, 59, 999, time.UTC),
 				"winner-3",
+				"",
+				sdk.NewInt64Coin("stake", 10),
+				nil,
 			),
+			nil,
+			nil,
+			0,
+			nil,
+			nil,
+			nil,
+			0,
+			0,
 		),
 	}
 	for _, data := range data {
@@ -223,3 +339,42 @@ func (suite *KeeperTestSuite) Test_GetHistoricalDrawsData() {
 	stored := suite.keeper.GetHistoricalDrawsData(suite.ctx)
 	suite.Require().Equal(data, stored)
 }
+
+func (suite *KeeperTestSuite) Test_GetPools() {
+	pools := []types.Pool{
+		types.NewPool(
+			1,
+			types.NewDistributionParams(sdk.NewDecWithPrec(50, 2), sdk.NewDecWithPrec(30, 2), sdk.NewDecWithPrec(20, 2), types.FeeDestinationFeeCollector),
+			types.NewDrawParams(time.Hour, time.Minute, 0, false),
+			types.NewTicketParams(sdk.NewInt64Coin("stake", 5), nil, sdk.ZeroDec(), 0, 0),
+			types.DefaultPrizeParams(),
+			types.DefaultGameParams(),
+			types.DefaultRolloverParams(),
+		),
+		types.NewPool(
+			2,
+			types.NewDistributionParams(sdk.NewDecWithPrec(90, 2), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2), types.FeeDestinationFeeCollector),
+			types.NewDrawParams(time.Hour*24, time.Minute*10, 0, false),
+			types.NewTicketParams(sdk.NewInt64Coin("stake", 100), nil, sdk.ZeroDec(), 0, 0),
+			types.DefaultPrizeParams(),
+			types.DefaultGameParams(),
+			types.DefaultRolloverParams(),
+		),
+	}
+	for _, pool := range pools {
+		suite.keeper.SavePool(suite.ctx, pool)
+	}
+
+	defaultPool := types.NewPool(
+		types.DefaultPoolID,
+		types.DefaultDistributionParams(),
+		types.DefaultDrawParams(),
+		types.DefaultTicketParams(),
+		types.DefaultPrizeParams(),
+		types.DefaultGameParams(),
+		types.DefaultRolloverParams(),
+	)
+
+	stored := suite.keeper.GetPools(suite.ctx)
+	suite.Require().Equal(append([]types.Pool{defaultPool}, pools...), stored)
+}
@@ -9,25 +9,182 @@ import (
 // ExportGenesis exports the current state of the chain
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return types.NewGenesisState(
-		k.GetCurrentDraw(ctx).EndTime,
-		k.GetTickets(ctx),
+		k.GetCurrentDrawID(ctx, types.DefaultPoolID),
+		k.GetCurrentDrawEndTime(ctx, types.DefaultPoolID),
+		k.GetCurrentDrawRollovers(ctx, types.DefaultPoolID),
+		k.getCurrentDrawsTickets(ctx),
 		k.GetHistoricalDrawsData(ctx),
 		k.GetDistributionParams(ctx),
 		k.GetDrawParams(ctx),
 		k.GetTicketParams(ctx),
+		k.GetPrizeParams(ctx),
+		k.GetRolloverParams(ctx),
+		k.GetPruningParams(ctx),
+		k.GetEntropyCommitments(ctx),
+		k.GetAllMissedReveals(ctx),
+		k.getPoolsStates(ctx),
+		k.GetPausedPools(ctx),
+		k.GetSalesParams(ctx),
+		k.getUpcomingDrawID(ctx, types.DefaultPoolID),
+		k.GetExclusions(ctx),
+		k.GetSpendingLimitParams(ctx),
+		k.GetSpendingLimits(ctx),
+		k.GetSpendRecords(ctx),
+		k.GetAllDrawFees(ctx),
+		k.GetOracleParams(ctx),
+		k.GetAllPricePosts(ctx),
+		k.GetPrices(ctx),
 	)
 }
 
+// getUpcomingDrawID returns the id reserved to the upcoming draw of the given pool, or 0 if no id has been reserved
+func (k Keeper) getUpcomingDrawID(ctx sdk.Context, poolID uint64) uint64 {
+	id, _ := k.GetUpcomingDrawID(ctx, poolID)
+	return id
+}
+
+// getCurrentDrawsTickets returns the tickets of the current and upcoming draws of all the pools,
+// sorted by their position. Tickets of rolled over draws that are still being moved are exported as part of
+// their new draw, after the ones already moved. Tickets of past draws that are still waiting to be pruned
+// are not exported
+func (k Keeper) getCurrentDrawsTickets(ctx sdk.Context) []types.Ticket {
+	var tickets []types.Ticket
+	appendTicket := func(_ uint32, ticket types.Ticket) (stop bool) {
+		tickets = append(tickets, ticket)
+		return false
+	}
+
+	for _, pool := range k.GetPools(ctx) {
+		currentDrawID := k.GetCurrentDrawID(ctx, pool.Id)
+		k.IterateDrawTickets(ctx, currentDrawID, appendTicket)
+		for _, drawID := range k.getMovingDrawsIDs(ctx, currentDrawID) {
+			k.IterateDrawTickets(ctx, drawID, func(_ uint32, ticket types.Ticket) (stop bool) {
+				ticket.DrawId = currentDrawID
+				tickets = append(tickets, ticket)
+				return false
+			})
+		}
+
+		if upcomingDrawID, found := k.GetUpcomingDrawID(ctx, pool.Id); found {
+			k.IterateDrawTickets(ctx, upcomingDrawID, appendTicket)
+		}
+	}
+	return tickets
+}
+
+// getPoolsStates returns the state of all the pools except the default one
+func (k Keeper) getPoolsStates(ctx sdk.Context) []types.PoolState {
+	var states []types.PoolState
+	k.IteratePools(ctx, func(_ int64, pool types.Pool) (stop bool) {
+		if pool.Id != types.DefaultPoolID {
+			states = append(states, types.NewPoolState(
+				pool,
+				k.GetCurrentDrawID(ctx, pool.Id),
+				k.GetCurrentDrawEndTime(ctx, pool.Id),
+				k.GetCurrentDrawRollovers(ctx, pool.Id),
+				k.getUpcomingDrawID(ctx, pool.Id),
+			))
+		}
+		return false
+	})
+	return states
+}
+
 // InitGenesis initializes the given state
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
-	k.SaveCurrentDrawEndTime(ctx, state.DrawEndTime)
+	k.SaveCurrentDrawID(ctx, types.DefaultPoolID, state.DrawId)
+	k.SaveCurrentDrawEndTime(ctx, types.DefaultPoolID, state.DrawEndTime)
+	k.SaveCurrentDrawRollovers(ctx, types.DefaultPoolID, state.DrawRollovers)
+
+	// The next draw id must be greater than the id of any current, upcoming or past draw
+	nextDrawID := state.DrawId + 1
+	if state.UpcomingDrawId != 0 {
+		k.SaveUpcomingDrawID(ctx, types.DefaultPoolID, state.UpcomingDrawId)
+
+		if state.UpcomingDrawId >= nextDrawID {
+			nextDrawID = state.UpcomingDrawId + 1
+		}
+	}
+
+	for _, p := range state.Pools {
+		k.SavePool(ctx, p.Pool)
+		k.SaveCurrentDrawID(ctx, p.Pool.Id, p.DrawId)
+		k.SaveCurrentDrawEndTime(ctx, p.Pool.Id, p.DrawEndTime)
+		k.SaveCurrentDrawRollovers(ctx, p.Pool.Id, p.DrawRollovers)
+
+		if p.DrawId >= nextDrawID {
+			nextDrawID = p.DrawId + 1
+		}
+
+		if p.UpcomingDrawId != 0 {
+			k.SaveUpcomingDrawID(ctx, p.Pool.Id, p.UpcomingDrawId)
+
+			if p.UpcomingDrawId >= nextDrawID {
+				nextDrawID = p.UpcomingDrawId + 1
+			}
+		}
+	}
+
+	for _, poolID := range state.PausedPools {
+		k.SetPoolSalesPaused(ctx, poolID, true)
+	}
+
 	k.SaveTickets(ctx, state.Tickets)
 
 	for _, data := range state.PastDraws {
 		k.SaveHistoricalDraw(ctx, data)
+
+		if data.Draw.Id >= nextDrawID {
+			nextDrawID = data.Draw.Id + 1
+		}
 	}
 
+	k.SetNextDrawID(ctx, nextDrawID)
+
 	k.SetDistributionParams(ctx, state.DistributionParams)
 	k.SetDrawParams(ctx, state.DrawParams)
 	k.SetTicketParams(ctx, state.TicketParams)
+	k.SetPrizeParams(ctx, state.PrizeParams)
+	k.SetRolloverParams(ctx, state.RolloverParams)
+	k.SetPruningParams(ctx, state.PruningParams)
+	k.SetSalesParams(ctx, state.SalesParams)
+	k.saveKnownSalesHalted(ctx, state.SalesParams.Halted)
+	k.SetSpendingLimitParams(ctx, state.SpendingLimitParams)
+	k.SetOracleParams(ctx, state.OracleParams)
+
+	for _, c := range state.EntropyCommitments {
+		k.SaveEntropyCommitment(ctx, c)
+	}
+
+	for _, m := range state.MissedReveals {
+		valAddr, err := sdk.ValAddressFromBech32(m.Validator)
+		if err != nil {
+			panic(err)
+		}
+		k.SetMissedReveals(ctx, valAddr, m.Count)
+	}
+
+	for _, e := range state.Exclusions {
+		k.SaveExclusion(ctx, e)
+	}
+
+	for _, l := range state.SpendingLimits {
+		k.SaveSpendingLimit(ctx, l)
+	}
+
+	for _, r := range state.SpendRecords {
+		k.SaveSpendRecord(ctx, r)
+	}
+
+	for _, f := range state.DrawFees {
+		k.SaveDrawFees(ctx, f)
+	}
+
+	for _, p := range state.PricePosts {
+		k.SavePricePost(ctx, p)
+	}
+
+	for _, p := range state.Prices {
+		k.SavePrice(ctx, p)
+	}
 }
@@ -11,15 +11,29 @@ import (
 func (suite *KeeperTestSuite) Test_ExportGenesis() {
 	usecases := []struct {
 		name               string
+		drawID             uint64
 		drawEndDate        time.Time
+		drawRollovers      uint32
+		upcomingDrawID     uint64
 		tickets            []types.Ticket
+		pastTickets        []types.Ticket
 		historicalDraws    []types.HistoricalDrawData
 		distributionParams types.DistributionParams
 		drawParams         types.DrawParams
 		ticketParams       types.TicketParams
+		rolloverParams     types.RolloverParams
+		pausedPools        []uint64
+		exclusions         []types.Exclusion
+		spendingLimits     []types.SpendingLimit
+		spendRecords       []types.SpendRecord
+		drawFees           []types.DrawFees
+		oracleParams       types.OracleParams
+		pricePosts         []types.PricePost
+		prices             []types.Price
 	}{
 		{
 			name:            "empty tickets and historical data",
+			drawID:          1,
 			drawEndDate:     time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 			tickets:         nil,
 			historicalDraws: nil,
@@ -27,28 +41,64 @@ func (suite *KeeperTestSuite) Test_ExportGenesis() {
 				sdk.NewDecWithPrec(98, 2),
 				sdk.NewDecWithPrec(1, 2),
 				sdk.NewDecWithPrec(1, 2),
+				types.FeeDestinationFeeCollector,
 			),
-			drawParams:   types.NewDrawParams(time.Minute * 5),
-			ticketParams: types.NewTicketParams(sdk.NewInt64Coin("stake", 10)),
+			drawParams:     types.NewDrawParams(time.Minute*5, time.Minute, 0, false),
+			ticketParams:   types.NewTicketParams(sdk.NewInt64Coin("stake", 10), nil, sdk.ZeroDec(), 0, 0),
+			rolloverParams: types.DefaultRolloverParams(),
+			oracleParams:   types.DefaultOracleParams(),
 		},
 		{
-			name:        "non empty tickets and historical data",
-			drawEndDate: time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+			name:           "non empty tickets and historical data",
+			drawID:         2,
+			drawEndDate:    time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+			drawRollovers:  2,
+			upcomingDrawID: 3,
 			tickets: []types.Ticket{
 				types.NewTicket(
 					"1",
+					2,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"2",
+					2,
 					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
 					"owner-2",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
+				),
+				types.NewTicket(
+					"3",
+					3,
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+					"owner-2",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
+				),
+			},
+			pastTickets: []types.Ticket{
+				types.NewTicket(
+					"old-ticket",
+					1,
+					time.Date(2019, 12, 31, 23, 59, 59, 000, time.UTC),
+					"old-winner",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 			},
 			historicalDraws: []types.HistoricalDrawData{
 				types.NewHistoricalDrawData(
 					types.NewDraw(
+						1,
+						types.DefaultPoolID,
 						1,
 						1,
 						sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -56,83 +106,218 @@ func (suite *KeeperTestSuite) Test_ExportGenesis() {
 					),
 					types.NewTicket(
 						"old-ticket",
+						1,
 						time.Date(2019, 12, 31, 23, 59, 59, 000, time.UTC),
 						"old-winner",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
+					nil,
+					nil,
+					0,
+					nil,
+					nil,
+					nil,
+					0,
+					0,
 				),
 			},
 			distributionParams: types.NewDistributionParams(
 				sdk.NewDecWithPrec(95, 2),
 				sdk.NewDecWithPrec(3, 2),
 				sdk.NewDecWithPrec(2, 2),
+				types.FeeDestinationFeeCollector,
 			),
-			drawParams:   types.NewDrawParams(time.Minute * 3),
-			ticketParams: types.NewTicketParams(sdk.NewInt64Coin("stake", 10)),
+			drawParams:     types.NewDrawParams(time.Minute*3, time.Minute, 0, false),
+			ticketParams:   types.NewTicketParams(sdk.NewInt64Coin("stake", 10), nil, sdk.ZeroDec(), 0, 0),
+			rolloverParams: types.NewRolloverParams(3, sdk.NewDecWithPrec(5, 2)),
+			pausedPools:    []uint64{types.DefaultPoolID},
+			exclusions: []types.Exclusion{
+				types.NewExclusion(
+					"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+					time.Date(2021, 2, 1, 00, 00, 00, 000, time.UTC),
+				),
+			},
+			spendingLimits: []types.SpendingLimit{
+				types.NewSpendingLimit(
+					"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+					sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+					time.Hour,
+					types.NewPendingSpendingLimit(
+						sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+						time.Hour,
+						time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+					),
+				),
+			},
+			spendRecords: []types.SpendRecord{
+				types.NewSpendRecord(
+					"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+					time.Date(2020, 12, 31, 23, 30, 00, 000, time.UTC),
+					sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				),
+			},
+			drawFees: []types.DrawFees{
+				types.NewDrawFees(1, types.FeeDestinationCommunityPool, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+				types.NewDrawFees(2, types.FeeDestinationStakers, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))),
+			},
+			oracleParams: types.NewOracleParams([]string{"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"}, time.Hour),
+			pricePosts: []types.PricePost{
+				types.NewPricePost(
+					"stake",
+					"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+					sdk.NewDecWithPrec(25, 2),
+					time.Date(2020, 12, 31, 23, 30, 00, 000, time.UTC),
+				),
+			},
+			prices: []types.Price{
+				types.NewPrice("stake", sdk.NewDecWithPrec(25, 2), time.Date(2020, 12, 31, 23, 30, 00, 000, time.UTC)),
+			},
 		},
 	}
 
 	for _, uc := range usecases {
 		suite.SetupTest()
 		suite.Run(uc.name, func() {
-			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, uc.drawEndDate)
+			suite.keeper.SaveCurrentDrawID(suite.ctx, types.DefaultPoolID, uc.drawID)
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, uc.drawEndDate)
+			suite.keeper.SaveCurrentDrawRollovers(suite.ctx, types.DefaultPoolID, uc.drawRollovers)
+			if uc.upcomingDrawID != 0 {
+				suite.keeper.SaveUpcomingDrawID(suite.ctx, types.DefaultPoolID, uc.upcomingDrawID)
+			}
 			suite.keeper.SaveTickets(suite.ctx, uc.tickets)
+
+			// Tickets of past draws waiting to be pruned should not be exported
+			suite.keeper.SaveTickets(suite.ctx, uc.pastTickets)
+			suite.keeper.EnqueueDrawPruning(suite.ctx, 1)
+
 			for _, h := range uc.historicalDraws {
 				suite.keeper.SaveHistoricalDraw(suite.ctx, h)
 			}
 			suite.keeper.SetDistributionParams(suite.ctx, uc.distributionParams)
 			suite.keeper.SetDrawParams(suite.ctx, uc.drawParams)
 			suite.keeper.SetTicketParams(suite.ctx, uc.ticketParams)
+			suite.keeper.SetRolloverParams(suite.ctx, uc.rolloverParams)
+			for _, poolID := range uc.pausedPools {
+				suite.keeper.SetPoolSalesPaused(suite.ctx, poolID, true)
+			}
+			for _, e := range uc.exclusions {
+				suite.keeper.SaveExclusion(suite.ctx, e)
+			}
+			for _, l := range uc.spendingLimits {
+				suite.keeper.SaveSpendingLimit(suite.ctx, l)
+			}
+			for _, r := range uc.spendRecords {
+				suite.keeper.SaveSpendRecord(suite.ctx, r)
+			}
+			for _, f := range uc.drawFees {
+				suite.keeper.SaveDrawFees(suite.ctx, f)
+			}
+			suite.keeper.SetOracleParams(suite.ctx, uc.oracleParams)
+			for _, p := range uc.pricePosts {
+				suite.keeper.SavePricePost(suite.ctx, p)
+			}
+			for _, p := range uc.prices {
+				suite.keeper.SavePrice(suite.ctx, p)
+			}
 
 			exported := suite.keeper.ExportGenesis(suite.ctx)
+			suite.Require().Equal(uc.drawID, exported.DrawId)
 			suite.Require().Equal(uc.drawEndDate, exported.DrawEndTime)
+			suite.Require().Equal(uc.drawRollovers, exported.DrawRollovers)
+			suite.Require().Equal(uc.upcomingDrawID, exported.UpcomingDrawId)
 			suite.Require().Equal(uc.tickets, exported.Tickets)
 			suite.Require().Equal(uc.historicalDraws, exported.PastDraws)
 			suite.Require().Equal(uc.distributionParams, exported.DistributionParams)
 			suite.Require().Equal(uc.drawParams, exported.DrawParams)
 			suite.Require().Equal(uc.ticketParams, exported.TicketParams)
+			suite.Require().Equal(uc.rolloverParams, exported.RolloverParams)
+			suite.Require().Equal(uc.pausedPools, exported.PausedPools)
+			suite.Require().Equal(uc.exclusions, exported.Exclusions)
+			suite.Require().Equal(uc.spendingLimits, exported.SpendingLimits)
+			suite.Require().Equal(uc.spendRecords, exported.SpendRecords)
+			suite.Require().Equal(uc.drawFees, exported.DrawFees)
+			suite.Require().Equal(uc.oracleParams, exported.OracleParams)
+			suite.Require().Equal(uc.pricePosts, exported.PricePosts)
+			suite.Require().Equal(uc.prices, exported.Prices)
 		})
 	}
 }
 
 func (suite *KeeperTestSuite) Test_ImportGenesis() {
 	usecases := []struct {
-		name    string
-		genesis *types.GenesisState
+		name          string
+		genesis       *types.GenesisState
+		expNextDrawID uint64
 	}{
 		{
 			name: "empty tickets and historical data",
 			genesis: types.NewGenesisState(
+				1,
 				time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				0,
 				nil,
 				nil,
 				types.NewDistributionParams(
 					sdk.NewDecWithPrec(98, 2),
 					sdk.NewDecWithPrec(1, 2),
 					sdk.NewDecWithPrec(1, 2),
+					types.FeeDestinationFeeCollector,
 				),
-				types.NewDrawParams(time.Minute*5),
-				types.NewTicketParams(sdk.NewInt64Coin("stake", 10)),
+				types.NewDrawParams(time.Minute*5, time.Minute, 0, false),
+				types.NewTicketParams(sdk.NewInt64Coin("stake", 10), nil, sdk.ZeroDec(), 0, 0),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				nil,
+				nil,
 			),
+			expNextDrawID: 2,
 		},
 		{
 			name: "non empty tickets and historical data",
 			genesis: types.NewGenesisState(
+				2,
 				time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				0,
 				[]types.Ticket{
 					types.NewTicket(
 						"1",
+						2,
 						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 						"owner-1",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
 					types.NewTicket(
 						"2",
+						2,
 						time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
 						"owner-2",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
 				},
 				[]types.HistoricalDrawData{
 					types.NewHistoricalDrawData(
 						types.NewDraw(
+							1,
+							types.DefaultPoolID,
 							1,
 							1,
 							sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -140,19 +325,140 @@ func (suite *KeeperTestSuite) Test_ImportGenesis() {
 						),
 						types.NewTicket(
 							"old-ticket",
+							1,
 							time.Date(2019, 12, 31, 23, 59, 59, 000, time.UTC),
 							"old-winner",
+							"",
+							sdk.NewInt64Coin("stake", 10),
+							nil,
 						),
+						nil,
+						nil,
+						0,
+						nil,
+						nil,
+						nil,
+						0,
+						0,
 					),
 				},
 				types.NewDistributionParams(
 					sdk.NewDecWithPrec(95, 2),
 					sdk.NewDecWithPrec(3, 2),
 					sdk.NewDecWithPrec(2, 2),
+					types.FeeDestinationFeeCollector,
 				),
-				types.NewDrawParams(time.Minute*3),
-				types.NewTicketParams(sdk.NewInt64Coin("stake", 10)),
+				types.NewDrawParams(time.Minute*3, time.Minute, 0, false),
+				types.NewTicketParams(sdk.NewInt64Coin("stake", 10), nil, sdk.ZeroDec(), 0, 0),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				nil,
+				nil,
+			),
+			expNextDrawID: 3,
+		},
+		{
+			name: "multiple pools",
+			genesis: types.NewGenesisState(
+				1,
+				time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+				0,
+				[]types.Ticket{
+					types.NewTicket(
+						"1",
+						5,
+						time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+						"owner-1",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
+					),
+				},
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				[]types.PoolState{
+					types.NewPoolState(
+						types.NewPool(
+							1,
+							types.DefaultDistributionParams(),
+							types.NewDrawParams(time.Hour, time.Minute, 0, false),
+							types.NewTicketParams(sdk.NewInt64Coin("stake", 100), nil, sdk.ZeroDec(), 0, 0),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						5,
+						time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+						1,
+						7,
+					),
+				},
+				[]uint64{1},
+				types.DefaultSalesParams(),
+				0,
+				[]types.Exclusion{
+					types.NewExclusion(
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						time.Date(2021, 2, 1, 00, 00, 00, 000, time.UTC),
+					),
+				},
+				types.NewSpendingLimitParams(time.Hour*48),
+				[]types.SpendingLimit{
+					types.NewSpendingLimit(
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+						time.Hour,
+						types.NewPendingSpendingLimit(
+							sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+							time.Hour,
+							time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+						),
+					),
+				},
+				[]types.SpendRecord{
+					types.NewSpendRecord(
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						time.Date(2020, 12, 31, 23, 30, 00, 000, time.UTC),
+						sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+					),
+				},
+				[]types.DrawFees{
+					types.NewDrawFees(1, types.FeeDestinationTreasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+				},
+				types.NewOracleParams([]string{"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"}, time.Hour),
+				[]types.PricePost{
+					types.NewPricePost(
+						"stake",
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						sdk.NewDecWithPrec(25, 2),
+						time.Date(2020, 12, 31, 23, 30, 00, 000, time.UTC),
+					),
+				},
+				[]types.Price{
+					types.NewPrice("stake", sdk.NewDecWithPrec(25, 2), time.Date(2020, 12, 31, 23, 30, 00, 000, time.UTC)),
+				},
 			),
+			expNextDrawID: 8,
 		},
 	}
 
@@ -161,7 +467,8 @@ func (suite *KeeperTestSuite) Test_ImportGenesis() {
 		suite.Run(uc.name, func() {
 			suite.keeper.InitGenesis(suite.ctx, *uc.genesis)
 
-			draw := suite.keeper.GetCurrentDraw(suite.ctx)
+			draw := suite.keeper.GetCurrentDraw(suite.ctx, types.DefaultPoolID)
+			suite.Require().Equal(uc.genesis.DrawId, draw.Id)
 			suite.Require().Equal(uc.genesis.DrawEndTime, draw.EndTime)
 
 			suite.Require().Equal(uc.genesis.Tickets, suite.keeper.GetTickets(suite.ctx))
@@ -170,6 +477,57 @@ func (suite *KeeperTestSuite) Test_ImportGenesis() {
 			suite.Require().Equal(uc.genesis.DistributionParams, suite.keeper.GetDistributionParams(suite.ctx))
 			suite.Require().Equal(uc.genesis.DrawParams, suite.keeper.GetDrawParams(suite.ctx))
 			suite.Require().Equal(uc.genesis.TicketParams, suite.keeper.GetTicketParams(suite.ctx))
+			suite.Require().Equal(uc.genesis.RolloverParams, suite.keeper.GetRolloverParams(suite.ctx))
+			suite.Require().Equal(uc.genesis.DrawRollovers, suite.keeper.GetCurrentDrawRollovers(suite.ctx, types.DefaultPoolID))
+
+			for _, p := range uc.genesis.Pools {
+				pool, found := suite.keeper.GetPool(suite.ctx, p.Pool.Id)
+				suite.Require().True(found)
+				suite.Require().Equal(p.Pool, pool)
+
+				draw := suite.keeper.GetCurrentDraw(suite.ctx, p.Pool.Id)
+				suite.Require().Equal(p.DrawId, draw.Id)
+				suite.Require().Equal(p.DrawEndTime, draw.EndTime)
+				suite.Require().Equal(p.DrawRollovers, suite.keeper.GetCurrentDrawRollovers(suite.ctx, p.Pool.Id))
+
+				upcomingDrawID, found := suite.keeper.GetUpcomingDrawID(suite.ctx, p.Pool.Id)
+				suite.Require().Equal(p.UpcomingDrawId != 0, found)
+				suite.Require().Equal(p.UpcomingDrawId, upcomingDrawID)
+			}
+
+			suite.Require().Equal(uc.genesis.PausedPools, suite.keeper.GetPausedPools(suite.ctx))
+			suite.Require().Equal(uc.genesis.Exclusions, suite.keeper.GetExclusions(suite.ctx))
+			suite.Require().Equal(uc.genesis.SpendingLimitParams, suite.keeper.GetSpendingLimitParams(suite.ctx))
+			suite.Require().Equal(uc.genesis.SpendingLimits, suite.keeper.GetSpendingLimits(suite.ctx))
+			suite.Require().Equal(uc.genesis.SpendRecords, suite.keeper.GetSpendRecords(suite.ctx))
+			suite.Require().Equal(uc.genesis.DrawFees, suite.keeper.GetAllDrawFees(suite.ctx))
+			suite.Require().Equal(uc.genesis.OracleParams, suite.keeper.GetOracleParams(suite.ctx))
+			suite.Require().Equal(uc.genesis.PricePosts, suite.keeper.GetAllPricePosts(suite.ctx))
+			suite.Require().Equal(uc.genesis.Prices, suite.keeper.GetPrices(suite.ctx))
+			suite.Require().Equal(uc.expNextDrawID, suite.keeper.GetNextDrawID(suite.ctx))
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) Test_ExportGenesis_MovingTickets() {
+	suite.SetupTest()
+	suite.keeper.SaveCurrentDrawID(suite.ctx, types.DefaultPoolID, 2)
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC))
+
+	date := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	suite.keeper.SaveTickets(suite.ctx, []types.Ticket{
+		types.NewTicket("1", 1, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("2", 1, date, "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("3", 2, date, "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+	})
+	suite.keeper.EnqueueDrawTicketsMove(suite.ctx, 1, 2)
+	suite.keeper.MoveDrawTickets(suite.ctx, 1, 2, 1)
+
+	// Tickets still being moved are exported as part of the new draw, after the ones already moved
+	exported := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Equal([]types.Ticket{
+		types.NewTicket("3", 2, date, "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("1", 2, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("2", 2, date, "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+	}, exported.Tickets)
+}
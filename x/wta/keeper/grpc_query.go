@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,7 +26,7 @@ func NewQuerierImpl(k Keeper) types.QueryServer {
 	return querier{Keeper: k}
 }
 
-// Tickets queries all tickets for the next draw
+// Tickets queries all tickets for the next draw of the given pool
 func (k querier) Tickets(ctx context.Context, req *types.QueryTicketsRequest) (*types.QueryTicketsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -33,39 +34,83 @@ func (k querier) Tickets(ctx context.Context, req *types.QueryTicketsRequest) (*
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	if !k.HasPool(sdkCtx, req.PoolId) {
+		return nil, status.Errorf(codes.NotFound, "pool with id %d not found", req.PoolId)
+	}
+	drawID := k.GetCurrentDrawID(sdkCtx, req.PoolId)
+
 	store := sdkCtx.KVStore(k.storeKey)
-	ticketsStore := prefix.NewStore(store, types.TicketsStorePrefix)
+	ticketsStore := prefix.NewStore(store, types.TicketsPrefix(drawID))
 
 	var tickets []types.Ticket
-	pageRes, err := query.FilteredPaginate(ticketsStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+	pageRes, err := query.Paginate(ticketsStore, req.Pagination, func(_ []byte, value []byte) error {
 		ticket, err := types.UnmarshalTicket(k.cdc, value)
 		if err != nil {
-			return false, err
+			return err
 		}
 
-		if accumulate {
-			tickets = append(tickets, ticket)
+		tickets = append(tickets, ticket)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTicketsResponse{Tickets: tickets, Pagination: pageRes}, err
+}
+
+// TicketsByOwner queries all the tickets owned by the given address
+func (k querier) TicketsByOwner(
+	ctx context.Context, req *types.QueryTicketsByOwnerRequest,
+) (*types.QueryTicketsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty owner address")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := sdkCtx.KVStore(k.storeKey)
+	ownerStore := prefix.NewStore(store, types.OwnerTicketsPrefix(req.Owner))
+
+	var tickets []types.Ticket
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(_ []byte, value []byte) error {
+		ticket, found := k.GetTicket(sdkCtx, string(value))
+		if !found {
+			return fmt.Errorf("ticket with id %s not found", value)
 		}
 
-		return true, nil
+		tickets = append(tickets, ticket)
+		return nil
 	})
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryTicketsResponse{Tickets: tickets, Pagination: pageRes}, err
+	return &types.QueryTicketsByOwnerResponse{Tickets: tickets, Pagination: pageRes}, nil
 }
 
-// NextDraw queries the details of the next draw
+// NextDraw queries the details of the next draw of the given pool
 func (k querier) NextDraw(ctx context.Context, req *types.QueryNextDrawRequest) (*types.QueryNextDrawResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	draw := k.GetCurrentDraw(sdkCtx)
-	return &types.QueryNextDrawResponse{Draw: draw}, nil
+	if !k.HasPool(sdkCtx, req.PoolId) {
+		return nil, status.Errorf(codes.NotFound, "pool with id %d not found", req.PoolId)
+	}
+
+	draw := k.GetCurrentDraw(sdkCtx, req.PoolId)
+	return &types.QueryNextDrawResponse{
+		Draw:        draw,
+		SalesPaused: k.IsPoolSalesPaused(sdkCtx, req.PoolId),
+	}, nil
 }
 
 // PastDraws queries the details of the past draws
@@ -100,6 +145,194 @@ func (k querier) PastDraws(ctx context.Context, req *types.QueryPastDrawsRequest
 	return &types.QueryPastDrawsResponse{Draws: draws, Pagination: pageRes}, nil
 }
 
+// Draw queries the details of the draw having the given id
+func (k querier) Draw(ctx context.Context, req *types.QueryDrawRequest) (*types.QueryDrawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// The current draws have not been drawn yet
+	for _, pool := range k.GetPools(sdkCtx) {
+		if req.DrawId == k.GetCurrentDrawID(sdkCtx, pool.Id) {
+			return &types.QueryDrawResponse{Draw: k.GetCurrentDraw(sdkCtx, pool.Id)}, nil
+		}
+	}
+
+	data, found := k.GetHistoricalDraw(sdkCtx, req.DrawId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "draw with id %d not found", req.DrawId)
+	}
+
+	return &types.QueryDrawResponse{Draw: data.Draw, WinningTicket: &data.WinningTicket}, nil
+}
+
+// DrawProof queries the data needed to verify the winner of a past draw
+func (k querier) DrawProof(ctx context.Context, req *types.QueryDrawProofRequest) (*types.QueryDrawProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	draw, found := k.GetHistoricalDraw(sdkCtx, req.DrawId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "draw with id %d not found", req.DrawId)
+	}
+
+	if draw.Status != types.DrawStatusSettled {
+		return nil, status.Errorf(codes.FailedPrecondition, "draw with id %d has not been settled", req.DrawId)
+	}
+
+	return &types.QueryDrawProofResponse{
+		Seed:           draw.Seed,
+		TicketsRoot:    draw.TicketsRoot,
+		WinningIndex:   draw.WinningIndex,
+		TotalTickets:   draw.Draw.TicketsSold,
+		WinningTicket:  draw.WinningTicket,
+		Proof:          draw.WinningTicketProof,
+		Winners:        draw.Winners,
+		WinningNumbers: draw.WinningNumbers,
+		MaxNumber:      draw.MaxNumber,
+	}, nil
+}
+
+// DrawWinners queries the winners of a past draw, optionally filtering them by the amount of matched numbers
+func (k querier) DrawWinners(ctx context.Context, req *types.QueryDrawWinnersRequest) (*types.QueryDrawWinnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	draw, found := k.GetHistoricalDraw(sdkCtx, req.DrawId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "draw with id %d not found", req.DrawId)
+	}
+
+	winners := draw.Winners
+	if req.Matches != 0 {
+		winners = []types.DrawWinner{}
+		for _, winner := range draw.Winners {
+			if winner.Matches == req.Matches {
+				winners = append(winners, winner)
+			}
+		}
+	}
+
+	return &types.QueryDrawWinnersResponse{
+		Winners:        winners,
+		WinningNumbers: draw.WinningNumbers,
+	}, nil
+}
+
+// DrawFees queries the fees of a draw that have been sent to each destination
+func (k querier) DrawFees(ctx context.Context, req *types.QueryDrawFeesRequest) (*types.QueryDrawFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryDrawFeesResponse{Fees: k.GetDrawFeesByDraw(sdkCtx, req.DrawId)}, nil
+}
+
+// Pools queries all the existing pools
+func (k querier) Pools(ctx context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryPoolsResponse{Pools: k.GetPools(sdkCtx)}, nil
+}
+
+// PruningQueue queries the past draws whose tickets are still being removed
+func (k querier) PruningQueue(
+	ctx context.Context, req *types.QueryPruningQueueRequest,
+) (*types.QueryPruningQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryPruningQueueResponse{Draws: k.GetPruningQueue(sdkCtx)}, nil
+}
+
+// Exclusion queries the self exclusion of the given address
+func (k querier) Exclusion(
+	ctx context.Context, req *types.QueryExclusionRequest,
+) (*types.QueryExclusionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", req.Address)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	exclusion, found := k.GetExclusion(sdkCtx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "exclusion of %s not found", req.Address)
+	}
+
+	return &types.QueryExclusionResponse{
+		Exclusion: exclusion,
+		Excluded:  exclusion.IsActive(sdkCtx.BlockTime()),
+	}, nil
+}
+
+// SpendingLimit queries the spending limit of an address, along with the amount spent within its window
+func (k querier) SpendingLimit(
+	ctx context.Context, req *types.QuerySpendingLimitRequest,
+) (*types.QuerySpendingLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", req.Address)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	limit, found := k.GetSpendingLimit(sdkCtx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "spending limit of %s not found", req.Address)
+	}
+
+	return &types.QuerySpendingLimitResponse{
+		SpendingLimit: limit,
+		Spent:         k.GetSpentAmount(sdkCtx, addr, sdkCtx.BlockTime().Add(-limit.Window)),
+	}, nil
+}
+
+// Price queries the median price of the given denom, along with the prices posted by the oracles
+func (k querier) Price(ctx context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	price, found := k.GetPrice(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "price of %s not found", req.Denom)
+	}
+
+	return &types.QueryPriceResponse{
+		Price: price,
+		Stale: price.IsStale(sdkCtx.BlockTime(), k.GetOracleParams(sdkCtx).MaxPriceAge),
+		Posts: k.GetPricePosts(sdkCtx, req.Denom),
+	}, nil
+}
+
 // Params queries the currently stored parameters
 func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -108,8 +341,14 @@ func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*typ
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return &types.QueryParamsResponse{
-		DistributionParams: k.GetDistributionParams(sdkCtx),
-		DrawParams:         k.GetDrawParams(sdkCtx),
-		TicketParams:       k.GetTicketParams(sdkCtx),
+		DistributionParams:  k.GetDistributionParams(sdkCtx),
+		DrawParams:          k.GetDrawParams(sdkCtx),
+		TicketParams:        k.GetTicketParams(sdkCtx),
+		PrizeParams:         k.GetPrizeParams(sdkCtx),
+		RolloverParams:      k.GetRolloverParams(sdkCtx),
+		PruningParams:       k.GetPruningParams(sdkCtx),
+		SalesParams:         k.GetSalesParams(sdkCtx),
+		SpendingLimitParams: k.GetSpendingLimitParams(sdkCtx),
+		OracleParams:        k.GetOracleParams(sdkCtx),
 	}, nil
 }
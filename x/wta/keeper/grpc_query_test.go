@@ -14,18 +14,30 @@ func (suite *KeeperTestSuite) Test_Querier_Tickets() {
 	tickets := []types.Ticket{
 		types.NewTicket(
 			"1",
+			1,
 			time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 			"owner-1",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
 		types.NewTicket(
 			"2",
+			1,
 			time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
 			"owner-2",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
 		types.NewTicket(
 			"3",
+			1,
 			time.Date(2020, 1, 3, 00, 00, 00, 000, time.UTC),
 			"owner-3",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
 	}
 
@@ -42,7 +54,7 @@ func (suite *KeeperTestSuite) Test_Querier_Tickets() {
 		},
 		{
 			name: "small pagination",
-			req: types.NewTicketsRequest(&query.PageRequest{
+			req: types.NewTicketsRequest(types.DefaultPoolID, &query.PageRequest{
 				Offset: 1,
 				Limit:  1,
 			}),
@@ -51,13 +63,18 @@ func (suite *KeeperTestSuite) Test_Querier_Tickets() {
 		},
 		{
 			name: "large pagination",
-			req: types.NewTicketsRequest(&query.PageRequest{
+			req: types.NewTicketsRequest(types.DefaultPoolID, &query.PageRequest{
 				Offset: 0,
 				Limit:  1000,
 			}),
 			shouldErr:  false,
 			expTickets: tickets,
 		},
+		{
+			name:      "non existing pool",
+			req:       types.NewTicketsRequest(1, nil),
+			shouldErr: true,
+		},
 	}
 
 	for _, uc := range usecases {
@@ -65,6 +82,11 @@ func (suite *KeeperTestSuite) Test_Querier_Tickets() {
 		suite.Run(uc.name, func() {
 			suite.keeper.SaveTickets(suite.ctx, tickets)
 
+			// Tickets of other draws should not be returned
+			suite.keeper.SaveTickets(suite.ctx, []types.Ticket{
+				types.NewTicket("4", 2, time.Date(2020, 1, 4, 00, 00, 00, 000, time.UTC), "owner-4", "", sdk.NewInt64Coin("stake", 10), nil),
+			})
+
 			querier := keeper.NewQuerierImpl(suite.keeper)
 			res, err := querier.Tickets(sdk.WrapSDKContext(suite.ctx), uc.req)
 
@@ -78,6 +100,97 @@ func (suite *KeeperTestSuite) Test_Querier_Tickets() {
 	}
 }
 
+func (suite *KeeperTestSuite) Test_Querier_TicketsByOwner() {
+	tickets := []types.Ticket{
+		types.NewTicket(
+			"1",
+			1,
+			time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+			"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
+		),
+		types.NewTicket(
+			"2",
+			1,
+			time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+			"cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
+		),
+		types.NewTicket(
+			"3",
+			2,
+			time.Date(2020, 1, 3, 00, 00, 00, 000, time.UTC),
+			"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
+		),
+	}
+
+	usecases := []struct {
+		name       string
+		req        *types.QueryTicketsByOwnerRequest
+		shouldErr  bool
+		expTickets []types.Ticket
+	}{
+		{
+			name:      "empty request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "empty owner",
+			req:       types.NewTicketsByOwnerRequest("", nil),
+			shouldErr: true,
+		},
+		{
+			name:       "owner without tickets",
+			req:        types.NewTicketsByOwnerRequest("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", nil),
+			shouldErr:  false,
+			expTickets: nil,
+		},
+		{
+			name: "small pagination",
+			req: types.NewTicketsByOwnerRequest("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", &query.PageRequest{
+				Offset: 1,
+				Limit:  1,
+			}),
+			shouldErr:  false,
+			expTickets: []types.Ticket{tickets[2]},
+		},
+		{
+			name: "large pagination",
+			req: types.NewTicketsByOwnerRequest("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", &query.PageRequest{
+				Offset: 0,
+				Limit:  1000,
+			}),
+			shouldErr:  false,
+			expTickets: []types.Ticket{tickets[0], tickets[2]},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveTickets(suite.ctx, tickets)
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.TicketsByOwner(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expTickets, res.Tickets)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) Test_Querier_NextDraw() {
 	usecases := []struct {
 		name        string
@@ -93,6 +206,12 @@ func (suite *KeeperTestSuite) Test_Querier_NextDraw() {
 			req:       nil,
 			shouldErr: true,
 		},
+		{
+			name:        "non existing pool",
+			drawEndTime: time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+			req:         types.NewNextDrawRequest(1),
+			shouldErr:   true,
+		},
 		{
 			name:        "valid request",
 			drawEndTime: time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
@@ -100,23 +219,37 @@ func (suite *KeeperTestSuite) Test_Querier_NextDraw() {
 			tickets: []types.Ticket{
 				types.NewTicket(
 					"ticket-1",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-2",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-3",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-2",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 			},
-			req:       &types.QueryNextDrawRequest{},
+			req:       types.NewNextDrawRequest(types.DefaultPoolID),
 			shouldErr: false,
 			expDraw: types.NewDraw(
+				1,
+				types.DefaultPoolID,
 				2,
 				3,
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
@@ -148,6 +281,8 @@ func (suite *KeeperTestSuite) Test_Querier_PastDraws() {
 	draws := []types.HistoricalDrawData{
 		types.NewHistoricalDrawData(
 			types.NewDraw(
+				1,
+				types.DefaultPoolID,
 				1,
 				1,
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -155,12 +290,26 @@ func (suite *KeeperTestSuite) Test_Querier_PastDraws() {
 			),
 			types.NewTicket(
 				"ticket-1",
+				1,
 				time.Date(2019, 12, 31, 23, 59, 59, 999, time.UTC),
 				"winner-1",
+				"",
+				sdk.NewInt64Coin("stake", 10),
+				nil,
 			),
+			nil,
+			nil,
+			0,
+			nil,
+			nil,
+			nil,
+			0,
+			0,
 		),
 		types.NewHistoricalDrawData(
 			types.NewDraw(
+				2,
+				types.DefaultPoolID,
 				10,
 				100,
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
@@ -168,9 +317,21 @@ func (suite *KeeperTestSuite) Test_Querier_PastDraws() {
 			),
 			types.NewTicket(
 				"ticket-2",
+				2,
 				time.Date(2020, 1, 1, 23, 59, 59, 999, time.UTC),
 				"winner-3",
+				"",
+				sdk.NewInt64Coin("stake", 10),
+				nil,
 			),
+			nil,
+			nil,
+			0,
+			nil,
+			nil,
+			nil,
+			0,
+			0,
 		),
 	}
 
@@ -223,14 +384,718 @@ func (suite *KeeperTestSuite) Test_Querier_PastDraws() {
 	}
 }
 
+func (suite *KeeperTestSuite) Test_Querier_Draw() {
+	pastDraw := types.NewHistoricalDrawData(
+		types.NewDraw(
+			1,
+			types.DefaultPoolID,
+			1,
+			1,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+		),
+		types.NewTicket(
+			"ticket-1",
+			1,
+			time.Date(2019, 12, 31, 23, 59, 59, 999, time.UTC),
+			"winner-1",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
+		),
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
+		nil,
+		0,
+		0,
+	)
+
+	usecases := []struct {
+		name      string
+		req       *types.QueryDrawRequest
+		shouldErr bool
+		expRes    *types.QueryDrawResponse
+	}{
+		{
+			name:      "invalid request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "draw not found",
+			req:       types.NewDrawRequest(3),
+			shouldErr: true,
+		},
+		{
+			name:      "past draw",
+			req:       types.NewDrawRequest(1),
+			shouldErr: false,
+			expRes: &types.QueryDrawResponse{
+				Draw:          pastDraw.Draw,
+				WinningTicket: &pastDraw.WinningTicket,
+			},
+		},
+		{
+			name:      "current draw",
+			req:       types.NewDrawRequest(2),
+			shouldErr: false,
+			expRes: &types.QueryDrawResponse{
+				Draw: types.NewDraw(
+					2,
+					types.DefaultPoolID,
+					0,
+					0,
+					sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+					time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				),
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveHistoricalDraw(suite.ctx, pastDraw)
+			suite.keeper.SaveCurrentDrawID(suite.ctx, types.DefaultPoolID, 2)
+			suite.SaveDrawData(
+				suite.ctx,
+				time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			)
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.Draw(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().True(uc.expRes.Draw.Equal(res.Draw))
+				suite.Require().Equal(uc.expRes.WinningTicket, res.WinningTicket)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_Querier_DrawProof() {
+	tickets := []types.Ticket{
+		types.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+	}
+	seed := []byte("seed")
+	root, proofs := computeTicketsMerkleProofs(tickets)
+	indexes := types.ComputeWinningIndexes(seed, uint32(len(tickets)), 2)
+	index := indexes[0]
+	winners := []types.DrawWinner{
+		types.NewDrawWinner(0, tickets[indexes[0]], indexes[0], proofs[indexes[0]].Aunts, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), 0),
+		types.NewDrawWinner(1, tickets[indexes[1]], indexes[1], proofs[indexes[1]].Aunts, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 0),
+	}
+
+	draw := types.NewHistoricalDrawData(
+		types.NewDraw(
+			1,
+			types.DefaultPoolID,
+			3,
+			3,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+			time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+		),
+		tickets[index],
+		seed,
+		root,
+		index,
+		proofs[index].Aunts,
+		winners,
+		nil,
+		0,
+		0,
+	)
+	rolledOver := types.NewUnsettledHistoricalDrawData(
+		types.NewDraw(
+			2,
+			types.DefaultPoolID,
+			1,
+			1,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC),
+		),
+		types.DrawStatusRolledOver,
+		1,
+	)
+
+	usecases := []struct {
+		name      string
+		req       *types.QueryDrawProofRequest
+		shouldErr bool
+		expRes    *types.QueryDrawProofResponse
+	}{
+		{
+			name:      "invalid request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "draw not found",
+			req:       types.NewDrawProofRequest(3),
+			shouldErr: true,
+		},
+		{
+			name:      "draw not settled",
+			req:       types.NewDrawProofRequest(rolledOver.Draw.Id),
+			shouldErr: true,
+		},
+		{
+			name:      "draw found",
+			req:       types.NewDrawProofRequest(draw.Draw.Id),
+			shouldErr: false,
+			expRes: &types.QueryDrawProofResponse{
+				Seed:          seed,
+				TicketsRoot:   root,
+				WinningIndex:  index,
+				TotalTickets:  3,
+				WinningTicket: tickets[index],
+				Proof:         proofs[index].Aunts,
+				Winners:       winners,
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveHistoricalDraw(suite.ctx, draw)
+			suite.keeper.SaveHistoricalDraw(suite.ctx, rolledOver)
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.DrawProof(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expRes, res)
+
+				err = types.VerifyTicketInclusion(res.TicketsRoot, res.WinningTicket, res.WinningIndex, res.TotalTickets, res.Proof)
+				suite.Require().NoError(err)
+
+				err = res.Verify()
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_Querier_DrawWinners() {
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	tickets := []types.Ticket{
+		types.NewTicket("1", 1, timestamp, "owner-1", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 2, 3}),
+		types.NewTicket("2", 1, timestamp, "owner-2", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 2, 4}),
+		types.NewTicket("3", 1, timestamp, "owner-3", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 5, 6}),
+	}
+	root, proofs := computeTicketsMerkleProofs(tickets)
+	winners := []types.DrawWinner{
+		types.NewDrawWinner(0, tickets[0], 0, proofs[0].Aunts, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), 3),
+		types.NewDrawWinner(1, tickets[1], 1, proofs[1].Aunts, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), 2),
+	}
+	draw := types.NewHistoricalDrawData(
+		types.NewDraw(1, 1, 3, 3, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), timestamp),
+		types.Ticket{},
+		[]byte("seed"),
+		root,
+		0,
+		nil,
+		winners,
+		[]uint32{1, 2, 3},
+		10,
+		0,
+	)
+
+	usecases := []struct {
+		name      string
+		req       *types.QueryDrawWinnersRequest
+		shouldErr bool
+		expRes    *types.QueryDrawWinnersResponse
+	}{
+		{
+			name:      "invalid request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "draw not found",
+			req:       types.NewDrawWinnersRequest(2, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "all winners",
+			req:       types.NewDrawWinnersRequest(1, 0),
+			shouldErr: false,
+			expRes: &types.QueryDrawWinnersResponse{
+				Winners:        winners,
+				WinningNumbers: []uint32{1, 2, 3},
+			},
+		},
+		{
+			name:      "winners filtered by matches",
+			req:       types.NewDrawWinnersRequest(1, 2),
+			shouldErr: false,
+			expRes: &types.QueryDrawWinnersResponse{
+				Winners:        winners[1:],
+				WinningNumbers: []uint32{1, 2, 3},
+			},
+		},
+		{
+			name:      "no winner with the given matches",
+			req:       types.NewDrawWinnersRequest(1, 1),
+			shouldErr: false,
+			expRes: &types.QueryDrawWinnersResponse{
+				Winners:        []types.DrawWinner{},
+				WinningNumbers: []uint32{1, 2, 3},
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveHistoricalDraw(suite.ctx, draw)
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.DrawWinners(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expRes, res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_Querier_DrawFees() {
+	fees := []types.DrawFees{
+		types.NewDrawFees(1, types.FeeDestinationFeeCollector, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		types.NewDrawFees(1, types.FeeDestinationTreasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))),
+		types.NewDrawFees(2, types.FeeDestinationStakers, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))),
+	}
+
+	usecases := []struct {
+		name      string
+		req       *types.QueryDrawFeesRequest
+		shouldErr bool
+		expRes    *types.QueryDrawFeesResponse
+	}{
+		{
+			name:      "invalid request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "draw without fees",
+			req:       types.NewDrawFeesRequest(3),
+			shouldErr: false,
+			expRes:    &types.QueryDrawFeesResponse{Fees: nil},
+		},
+		{
+			name:      "draw with fees",
+			req:       types.NewDrawFeesRequest(1),
+			shouldErr: false,
+			expRes:    &types.QueryDrawFeesResponse{Fees: fees[:2]},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			for _, f := range fees {
+				suite.keeper.SaveDrawFees(suite.ctx, f)
+			}
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.DrawFees(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expRes, res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_Querier_Pools() {
+	pool := types.NewPool(
+		1,
+		types.NewDistributionParams(sdk.NewDecWithPrec(50, 2), sdk.NewDecWithPrec(30, 2), sdk.NewDecWithPrec(20, 2), types.FeeDestinationFeeCollector),
+		types.NewDrawParams(time.Hour, time.Minute, 0, false),
+		types.NewTicketParams(sdk.NewInt64Coin("stake", 5), nil, sdk.ZeroDec(), 0, 0),
+		types.DefaultPrizeParams(),
+		types.DefaultGameParams(),
+		types.DefaultRolloverParams(),
+	)
+
+	usecases := []struct {
+		name      string
+		stored    []types.Pool
+		req       *types.QueryPoolsRequest
+		shouldErr bool
+		expPools  []types.Pool
+	}{
+		{
+			name:      "invalid request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "only the default pool",
+			req:       &types.QueryPoolsRequest{},
+			shouldErr: false,
+			expPools: []types.Pool{
+				types.NewPool(
+					types.DefaultPoolID,
+					types.DefaultDistributionParams(),
+					types.DefaultDrawParams(),
+					types.DefaultTicketParams(),
+					types.DefaultPrizeParams(),
+					types.DefaultGameParams(),
+					types.DefaultRolloverParams(),
+				),
+			},
+		},
+		{
+			name:      "stored pools",
+			stored:    []types.Pool{pool},
+			req:       &types.QueryPoolsRequest{},
+			shouldErr: false,
+			expPools: []types.Pool{
+				types.NewPool(
+					types.DefaultPoolID,
+					types.DefaultDistributionParams(),
+					types.DefaultDrawParams(),
+					types.DefaultTicketParams(),
+					types.DefaultPrizeParams(),
+					types.DefaultGameParams(),
+					types.DefaultRolloverParams(),
+				),
+				pool,
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			for _, p := range uc.stored {
+				suite.keeper.SavePool(suite.ctx, p)
+			}
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.Pools(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expPools, res.Pools)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_Querier_PruningQueue() {
+	usecases := []struct {
+		name      string
+		req       *types.QueryPruningQueueRequest
+		shouldErr bool
+		expDraws  []types.PruningDraw
+	}{
+		{
+			name:      "invalid request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "valid request",
+			req:       &types.QueryPruningQueueRequest{},
+			shouldErr: false,
+			expDraws: []types.PruningDraw{
+				types.NewPruningDraw(1, 2),
+				types.NewPruningDraw(2, 1),
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			date := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+			suite.keeper.SaveTickets(suite.ctx, []types.Ticket{
+				types.NewTicket("1", 1, date, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", sdk.NewInt64Coin("stake", 10), nil),
+				types.NewTicket("2", 1, date, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", sdk.NewInt64Coin("stake", 10), nil),
+				types.NewTicket("3", 2, date, "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu", "", sdk.NewInt64Coin("stake", 10), nil),
+			})
+			suite.keeper.EnqueueDrawPruning(suite.ctx, 1)
+			suite.keeper.EnqueueDrawPruning(suite.ctx, 2)
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.PruningQueue(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expDraws, res.Draws)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_Querier_Exclusion() {
+	activeExclusion := types.NewExclusion(
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		time.Date(2021, 2, 1, 00, 00, 00, 000, time.UTC),
+	)
+	expiredExclusion := types.NewExclusion(
+		"cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu",
+		time.Date(2020, 12, 1, 00, 00, 00, 000, time.UTC),
+	)
+
+	usecases := []struct {
+		name      string
+		req       *types.QueryExclusionRequest
+		shouldErr bool
+		expRes    *types.QueryExclusionResponse
+	}{
+		{
+			name:      "invalid request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid address",
+			req:       types.NewExclusionRequest("address"),
+			shouldErr: true,
+		},
+		{
+			name:      "exclusion not found",
+			req:       types.NewExclusionRequest("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+			shouldErr: true,
+		},
+		{
+			name:      "active exclusion",
+			req:       types.NewExclusionRequest(activeExclusion.Address),
+			shouldErr: false,
+			expRes:    &types.QueryExclusionResponse{Exclusion: activeExclusion, Excluded: true},
+		},
+		{
+			name:      "expired exclusion",
+			req:       types.NewExclusionRequest(expiredExclusion.Address),
+			shouldErr: false,
+			expRes:    &types.QueryExclusionResponse{Exclusion: expiredExclusion, Excluded: false},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveExclusion(suite.ctx, activeExclusion)
+			suite.keeper.SaveExclusion(suite.ctx, expiredExclusion)
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.Exclusion(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expRes, res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_Querier_SpendingLimit() {
+	blockTime := time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)
+	limit := types.NewSpendingLimit(
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		time.Hour,
+		nil,
+	)
+	removedLimit := types.NewSpendingLimit(
+		"cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu",
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		time.Hour,
+		types.NewPendingSpendingLimit(nil, 0, blockTime.Add(-time.Minute)),
+	)
+
+	usecases := []struct {
+		name      string
+		req       *types.QuerySpendingLimitRequest
+		shouldErr bool
+		expRes    *types.QuerySpendingLimitResponse
+	}{
+		{
+			name:      "invalid request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "invalid address",
+			req:       types.NewSpendingLimitRequest("address"),
+			shouldErr: true,
+		},
+		{
+			name:      "spending limit not found",
+			req:       types.NewSpendingLimitRequest("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+			shouldErr: true,
+		},
+		{
+			name:      "spending limit removed after the delay",
+			req:       types.NewSpendingLimitRequest(removedLimit.Address),
+			shouldErr: true,
+		},
+		{
+			name:      "spending limit found",
+			req:       types.NewSpendingLimitRequest(limit.Address),
+			shouldErr: false,
+			expRes: &types.QuerySpendingLimitResponse{
+				SpendingLimit: limit,
+				Spent:         sdk.NewCoins(sdk.NewInt64Coin("stake", 150)),
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.ctx = suite.ctx.WithBlockTime(blockTime)
+			suite.keeper.SaveSpendingLimit(suite.ctx, limit)
+			suite.keeper.SaveSpendingLimit(suite.ctx, removedLimit)
+
+			// Only the amounts spent within the window are counted
+			suite.keeper.SaveSpendRecord(suite.ctx, types.NewSpendRecord(
+				limit.Address, blockTime.Add(-time.Hour*2), sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+			))
+			suite.keeper.SaveSpendRecord(suite.ctx, types.NewSpendRecord(
+				limit.Address, blockTime.Add(-time.Minute*30), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			))
+			suite.keeper.SaveSpendRecord(suite.ctx, types.NewSpendRecord(
+				limit.Address, blockTime, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+			))
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.SpendingLimit(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expRes, res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_Querier_Price() {
+	blockTime := time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)
+	posts := []types.PricePost{
+		types.NewPricePost("stake", "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu", sdk.NewDecWithPrec(35, 2), blockTime),
+		types.NewPricePost("stake", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewDecWithPrec(25, 2), blockTime),
+	}
+	price := types.NewPrice("stake", sdk.NewDecWithPrec(30, 2), blockTime)
+	stalePrice := types.NewPrice("atom", sdk.NewDec(10), blockTime.Add(-time.Hour*2))
+
+	usecases := []struct {
+		name      string
+		req       *types.QueryPriceRequest
+		shouldErr bool
+		expRes    *types.QueryPriceResponse
+	}{
+		{
+			name:      "invalid request",
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name:      "empty denom",
+			req:       types.NewPriceRequest(""),
+			shouldErr: true,
+		},
+		{
+			name:      "price not found",
+			req:       types.NewPriceRequest("btc"),
+			shouldErr: true,
+		},
+		{
+			name:      "stale price",
+			req:       types.NewPriceRequest("atom"),
+			shouldErr: false,
+			expRes: &types.QueryPriceResponse{
+				Price: stalePrice,
+				Stale: true,
+			},
+		},
+		{
+			name:      "price found",
+			req:       types.NewPriceRequest("stake"),
+			shouldErr: false,
+			expRes: &types.QueryPriceResponse{
+				Price: price,
+				Stale: false,
+				Posts: posts,
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.ctx = suite.ctx.WithBlockTime(blockTime)
+			suite.keeper.SetOracleParams(suite.ctx, types.NewOracleParams(nil, time.Hour))
+			for _, post := range posts {
+				suite.keeper.SavePricePost(suite.ctx, post)
+			}
+			suite.keeper.SavePrice(suite.ctx, price)
+			suite.keeper.SavePrice(suite.ctx, stalePrice)
+
+			querier := keeper.NewQuerierImpl(suite.keeper)
+			res, err := querier.Price(sdk.WrapSDKContext(suite.ctx), uc.req)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expRes, res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) Test_Querier_Params() {
 	distributionParams := types.NewDistributionParams(
 		sdk.NewDecWithPrec(95, 2),
 		sdk.NewDecWithPrec(3, 2),
 		sdk.NewDecWithPrec(2, 2),
+		types.FeeDestinationTreasury,
 	)
-	drawParams := types.NewDrawParams(time.Minute * 3)
-	ticketParams := types.NewTicketParams(sdk.NewInt64Coin("stake", 10))
+	drawParams := types.NewDrawParams(time.Minute*3, time.Minute, 0, false)
+	ticketParams := types.NewTicketParams(sdk.NewInt64Coin("stake", 10), nil, sdk.ZeroDec(), 0, 0)
+	prizeParams := types.NewPrizeParams([]types.PrizeTier{
+		types.NewPrizeTier(1, sdk.NewDecWithPrec(70, 2)),
+		types.NewPrizeTier(3, sdk.NewDecWithPrec(30, 2)),
+	})
+	rolloverParams := types.NewRolloverParams(3, sdk.NewDecWithPrec(5, 2))
+	pruningParams := types.NewPruningParams(50)
+	salesParams := types.NewSalesParams(true, "Wrong ticket price")
+	spendingLimitParams := types.NewSpendingLimitParams(time.Hour * 48)
+	oracleParams := types.NewOracleParams([]string{"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"}, time.Minute*30)
 
 	usecases := []struct {
 		name      string
@@ -255,6 +1120,12 @@ func (suite *KeeperTestSuite) Test_Querier_Params() {
 			suite.keeper.SetDistributionParams(suite.ctx, distributionParams)
 			suite.keeper.SetDrawParams(suite.ctx, drawParams)
 			suite.keeper.SetTicketParams(suite.ctx, ticketParams)
+			suite.keeper.SetPrizeParams(suite.ctx, prizeParams)
+			suite.keeper.SetRolloverParams(suite.ctx, rolloverParams)
+			suite.keeper.SetPruningParams(suite.ctx, pruningParams)
+			suite.keeper.SetSalesParams(suite.ctx, salesParams)
+			suite.keeper.SetSpendingLimitParams(suite.ctx, spendingLimitParams)
+			suite.keeper.SetOracleParams(suite.ctx, oracleParams)
 
 			querier := keeper.NewQuerierImpl(suite.keeper)
 			res, err := querier.Params(sdk.WrapSDKContext(suite.ctx), uc.req)
@@ -266,6 +1137,12 @@ func (suite *KeeperTestSuite) Test_Querier_Params() {
 				suite.Require().Equal(distributionParams, res.DistributionParams)
 				suite.Require().Equal(drawParams, res.DrawParams)
 				suite.Require().Equal(ticketParams, res.TicketParams)
+				suite.Require().Equal(prizeParams, res.PrizeParams)
+				suite.Require().Equal(rolloverParams, res.RolloverParams)
+				suite.Require().Equal(pruningParams, res.PruningParams)
+				suite.Require().Equal(salesParams, res.SalesParams)
+				suite.Require().Equal(spendingLimitParams, res.SpendingLimitParams)
+				suite.Require().Equal(oracleParams, res.OracleParams)
 			}
 		})
 	}
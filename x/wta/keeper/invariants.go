@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+// RegisterInvariants registers the wta module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "prize-collector", PrizeCollectorInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "prize-burner", PrizeBurnerInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-tickets", ValidTicketsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-historical-draws", ValidHistoricalDrawsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the wta module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			PrizeCollectorInvariant(k),
+			PrizeBurnerInvariant(k),
+			ValidTicketsInvariant(k),
+			ValidHistoricalDrawsInvariant(k),
+		} {
+			res, stop := invariant(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// PrizeCollectorInvariant checks that the prize collectors of each pool hold at least the prize share of all
+// the tickets of the draws they collect the prize for. The prize collector of a pool covers the tickets of its
+// current draw, including the ones of the rolled over draws that are still being moved into it, while the
+// upcoming prize collector covers the tickets of its upcoming draw.
+// The balances are only required to be greater or equal, since the prizes can also be funded in other ways
+func PrizeCollectorInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		sumPrizeShares := func(drawIDs ...uint64) sdk.Coins {
+			shares := sdk.NewCoins()
+			for _, drawID := range drawIDs {
+				k.IterateDrawTickets(ctx, drawID, func(_ uint32, ticket types.Ticket) (stop bool) {
+					shares = shares.Add(ticket.PrizeShare...)
+					return false
+				})
+			}
+			return shares
+		}
+
+		checkCollector := func(poolID uint64, collector sdk.AccAddress, drawIDs ...uint64) {
+			shares := sumPrizeShares(drawIDs...)
+			balance := k.bk.GetAllBalances(ctx, collector)
+			if !balance.IsAllGTE(shares) {
+				count++
+				msg += fmt.Sprintf("	pool %d has a prize collector %s balance of %s but its tickets prize shares are %s\n",
+					poolID, collector, balance, shares)
+			}
+		}
+
+		for _, pool := range k.GetPools(ctx) {
+			currentDrawID := k.GetCurrentDrawID(ctx, pool.Id)
+			drawIDs := append([]uint64{currentDrawID}, k.getMovingDrawsIDs(ctx, currentDrawID)...)
+			checkCollector(pool.Id, types.PoolPrizeCollectorAddress(pool.Id), drawIDs...)
+
+			if upcomingDrawID, found := k.GetUpcomingDrawID(ctx, pool.Id); found {
+				checkCollector(pool.Id, types.PoolUpcomingPrizeCollectorAddress(pool.Id), upcomingDrawID)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "prize-collector",
+			fmt.Sprintf("amount of underfunded prize collectors found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// PrizeBurnerInvariant checks that the prize burner does not hold any coin, since all the coins sent to it
+// should be burnt within the same transaction
+func PrizeBurnerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balance := k.bk.GetAllBalances(ctx, k.ak.GetModuleAddress(types.PrizeBurnerName))
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "prize-burner",
+			fmt.Sprintf("\tprize burner balance: %s\n", balance),
+		), !balance.IsZero()
+	}
+}
+
+// ValidTicketsInvariant checks that all the stored tickets are valid, belong to either a current, an upcoming
+// or a past draw, and have been bought before the end time of the current draw of their pool
+func ValidTicketsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		// Tickets can only be bought before the end time of the current draw, since the ones bought during
+		// the sales cutoff window are either rejected or assigned to the upcoming draw
+		closingTimes := map[uint64]time.Time{}
+		drawsPools := map[uint64]uint64{}
+		for _, pool := range k.GetPools(ctx) {
+			closingTimes[pool.Id] = k.GetCurrentDrawEndTime(ctx, pool.Id)
+			drawsPools[k.GetCurrentDrawID(ctx, pool.Id)] = pool.Id
+			if upcomingDrawID, found := k.GetUpcomingDrawID(ctx, pool.Id); found {
+				drawsPools[upcomingDrawID] = pool.Id
+			}
+		}
+
+		k.IterateTickets(ctx, func(_ int64, ticket types.Ticket) (stop bool) {
+			if err := ticket.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\tticket %s is invalid: %s\n", ticket.Id, err)
+				return false
+			}
+
+			// Tickets of past draws that are waiting to be pruned belong to the pool of their historical draw
+			poolID, found := drawsPools[ticket.DrawId]
+			if !found {
+				data, found := k.GetHistoricalDraw(ctx, ticket.DrawId)
+				if !found {
+					count++
+					msg += fmt.Sprintf("\tticket %s belongs to the non existing draw %d\n", ticket.Id, ticket.DrawId)
+					return false
+				}
+
+				poolID = data.Draw.PoolId
+				drawsPools[ticket.DrawId] = poolID
+			}
+
+			if closingTime, found := closingTimes[poolID]; !found || ticket.Timestamp.After(closingTime) {
+				count++
+				msg += fmt.Sprintf("\tticket %s has timestamp %s after the current draw of pool %d\n",
+					ticket.Id, ticket.Timestamp.Format(time.RFC3339), poolID)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "valid-tickets",
+			fmt.Sprintf("amount of invalid tickets found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// ValidHistoricalDrawsInvariant checks that all the historical draws are valid and have an id that has already
+// been assigned and is not used by any current or upcoming draw
+func ValidHistoricalDrawsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		currentDraws := map[uint64]bool{}
+		for _, pool := range k.GetPools(ctx) {
+			currentDraws[k.GetCurrentDrawID(ctx, pool.Id)] = true
+			if upcomingDrawID, found := k.GetUpcomingDrawID(ctx, pool.Id); found {
+				currentDraws[upcomingDrawID] = true
+			}
+		}
+
+		nextDrawID := k.GetNextDrawID(ctx)
+		k.IterateHistoricalDrawsData(ctx, func(_ int64, data types.HistoricalDrawData) (stop bool) {
+			err := data.Validate()
+			switch {
+			case err != nil:
+				count++
+				msg += fmt.Sprintf("\thistorical draw %d is invalid: %s\n", data.Draw.Id, err)
+
+			case data.Draw.Id >= nextDrawID:
+				count++
+				msg += fmt.Sprintf("\thistorical draw %d has an id not lower than the next one\n", data.Draw.Id)
+
+			case currentDraws[data.Draw.Id]:
+				count++
+				msg += fmt.Sprintf("\thistorical draw %d has the same id of a current or upcoming draw\n", data.Draw.Id)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "valid-historical-draws",
+			fmt.Sprintf("amount of invalid historical draws found %d\n%s", count, msg),
+		), count != 0
+	}
+}
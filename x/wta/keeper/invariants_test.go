@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmicbet/ledger/x/wta/keeper"
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+func (suite *KeeperTestSuite) Test_PrizeCollectorInvariant() {
+	owner := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	price := sdk.NewInt64Coin("stake", 10)
+
+	usecases := []struct {
+		name            string
+		tickets         []types.Ticket
+		movingDrawID    uint64
+		upcomingDrawID  uint64
+		balance         sdk.Coins
+		upcomingBalance sdk.Coins
+		expBroken       bool
+	}{
+		{
+			name:      "no tickets and empty prize collector",
+			expBroken: false,
+		},
+		{
+			name: "prize collector holding exactly the prize shares",
+			tickets: []types.Ticket{
+				withPrizeShare(types.NewTicket("1", 1, timestamp, owner, "", price, nil), sdk.NewInt64Coin("stake", 8)),
+				withPrizeShare(types.NewTicket("2", 1, timestamp, owner, "", price, nil), sdk.NewInt64Coin("stake", 8)),
+			},
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 16)),
+			expBroken: false,
+		},
+		{
+			name: "prize collector holding more than the prize shares",
+			tickets: []types.Ticket{
+				withPrizeShare(types.NewTicket("1", 1, timestamp, owner, "", price, nil), sdk.NewInt64Coin("stake", 8)),
+			},
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expBroken: false,
+		},
+		{
+			name: "prize collector holding less than the prize shares",
+			tickets: []types.Ticket{
+				withPrizeShare(types.NewTicket("1", 1, timestamp, owner, "", price, nil), sdk.NewInt64Coin("stake", 8)),
+				withPrizeShare(types.NewTicket("2", 1, timestamp, owner, "", price, nil), sdk.NewInt64Coin("stake", 8)),
+			},
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 15)),
+			expBroken: true,
+		},
+		{
+			name: "prize collector missing the shares of the tickets being moved",
+			tickets: []types.Ticket{
+				withPrizeShare(types.NewTicket("1", 1, timestamp, owner, "", price, nil), sdk.NewInt64Coin("stake", 8)),
+				withPrizeShare(types.NewTicket("2", 2, timestamp, owner, "", price, nil), sdk.NewInt64Coin("stake", 8)),
+			},
+			movingDrawID: 2,
+			balance:      sdk.NewCoins(sdk.NewInt64Coin("stake", 8)),
+			expBroken:    true,
+		},
+		{
+			name: "upcoming prize collector holding less than the prize shares",
+			tickets: []types.Ticket{
+				withPrizeShare(types.NewTicket("1", 3, timestamp, owner, "", price, nil), sdk.NewInt64Coin("stake", 8)),
+			},
+			upcomingDrawID: 3,
+			balance:        sdk.NewCoins(sdk.NewInt64Coin("stake", 8)),
+			expBroken:      true,
+		},
+		{
+			name: "upcoming prize collector holding the prize shares",
+			tickets: []types.Ticket{
+				withPrizeShare(types.NewTicket("1", 3, timestamp, owner, "", price, nil), sdk.NewInt64Coin("stake", 8)),
+			},
+			upcomingDrawID:  3,
+			upcomingBalance: sdk.NewCoins(sdk.NewInt64Coin("stake", 8)),
+			expBroken:       false,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveTickets(suite.ctx, uc.tickets)
+			if uc.movingDrawID != 0 {
+				suite.keeper.EnqueueDrawTicketsMove(suite.ctx, uc.movingDrawID, 1)
+			}
+			if uc.upcomingDrawID != 0 {
+				suite.keeper.SaveUpcomingDrawID(suite.ctx, types.DefaultPoolID, uc.upcomingDrawID)
+			}
+
+			collector := types.PoolPrizeCollectorAddress(types.DefaultPoolID)
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, collector, uc.balance))
+
+			upcomingCollector := types.PoolUpcomingPrizeCollectorAddress(types.DefaultPoolID)
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, upcomingCollector, uc.upcomingBalance))
+
+			_, broken := keeper.PrizeCollectorInvariant(suite.keeper)(suite.ctx)
+			suite.Require().Equal(uc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_PrizeBurnerInvariant() {
+	usecases := []struct {
+		name      string
+		balance   sdk.Coins
+		expBroken bool
+	}{
+		{
+			name:      "empty prize burner",
+			balance:   nil,
+			expBroken: false,
+		},
+		{
+			name:      "non empty prize burner",
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expBroken: true,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			burner := authtypes.NewModuleAddress(types.PrizeBurnerName)
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, burner, uc.balance))
+
+			_, broken := keeper.PrizeBurnerInvariant(suite.keeper)(suite.ctx)
+			suite.Require().Equal(uc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_ValidTicketsInvariant() {
+	owner := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	endTime := time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC)
+	pastDraw := types.NewUnsettledHistoricalDrawData(
+		types.NewDraw(2, types.DefaultPoolID, 1, 1, nil, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)),
+		types.DrawStatusCancelled,
+		0,
+	)
+
+	usecases := []struct {
+		name           string
+		upcomingDrawID uint64
+		tickets        []types.Ticket
+		expBroken      bool
+	}{
+		{
+			name: "valid tickets",
+			tickets: []types.Ticket{
+				types.NewTicket("1", 1, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC), owner, "", sdk.NewInt64Coin("stake", 10), nil),
+				types.NewTicket("2", 2, time.Date(2019, 12, 31, 00, 00, 00, 000, time.UTC), owner, "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			expBroken: false,
+		},
+		{
+			name: "ticket bought at the end time of the current draw",
+			tickets: []types.Ticket{
+				types.NewTicket("1", 1, endTime, owner, "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			expBroken: false,
+		},
+		{
+			name: "ticket bought during the reveal window",
+			tickets: []types.Ticket{
+				types.NewTicket("1", 1, endTime.Add(types.DefaultDrawParams().RevealDuration), owner, "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			expBroken: true,
+		},
+		{
+			name: "ticket with invalid owner",
+			tickets: []types.Ticket{
+				types.NewTicket("1", 1, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC), "owner", "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			expBroken: true,
+		},
+		{
+			name: "ticket of a non existing draw",
+			tickets: []types.Ticket{
+				types.NewTicket("1", 3, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC), owner, "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			expBroken: true,
+		},
+		{
+			name:           "ticket of the upcoming draw",
+			upcomingDrawID: 3,
+			tickets: []types.Ticket{
+				types.NewTicket("1", 3, time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC), owner, "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			expBroken: false,
+		},
+		{
+			name: "ticket bought after the current draw",
+			tickets: []types.Ticket{
+				types.NewTicket("1", 1, endTime.Add(time.Second), owner, "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, endTime)
+			suite.keeper.SaveHistoricalDraw(suite.ctx, pastDraw)
+			if uc.upcomingDrawID != 0 {
+				suite.keeper.SaveUpcomingDrawID(suite.ctx, types.DefaultPoolID, uc.upcomingDrawID)
+			}
+			suite.keeper.SaveTickets(suite.ctx, uc.tickets)
+
+			_, broken := keeper.ValidTicketsInvariant(suite.keeper)(suite.ctx)
+			suite.Require().Equal(uc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_ValidHistoricalDrawsInvariant() {
+	endTime := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	usecases := []struct {
+		name      string
+		pastDraw  types.HistoricalDrawData
+		expBroken bool
+	}{
+		{
+			name: "valid historical draw",
+			pastDraw: types.NewUnsettledHistoricalDrawData(
+				types.NewDraw(2, types.DefaultPoolID, 1, 1, nil, endTime), types.DrawStatusCancelled, 0,
+			),
+			expBroken: false,
+		},
+		{
+			name: "invalid historical draw",
+			pastDraw: types.NewUnsettledHistoricalDrawData(
+				types.NewDraw(2, types.DefaultPoolID, 2, 1, nil, endTime), types.DrawStatusCancelled, 0,
+			),
+			expBroken: true,
+		},
+		{
+			name: "historical draw with a not yet assigned id",
+			pastDraw: types.NewUnsettledHistoricalDrawData(
+				types.NewDraw(3, types.DefaultPoolID, 1, 1, nil, endTime), types.DrawStatusCancelled, 0,
+			),
+			expBroken: true,
+		},
+		{
+			name: "historical draw with the id of the current draw",
+			pastDraw: types.NewUnsettledHistoricalDrawData(
+				types.NewDraw(1, types.DefaultPoolID, 1, 1, nil, endTime), types.DrawStatusCancelled, 0,
+			),
+			expBroken: true,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SetNextDrawID(suite.ctx, 3)
+			suite.keeper.SaveHistoricalDraw(suite.ctx, uc.pastDraw)
+
+			_, broken := keeper.ValidHistoricalDrawsInvariant(suite.keeper)(suite.ctx)
+			suite.Require().Equal(uc.expBroken, broken)
+		})
+	}
+}
@@ -1,16 +1,20 @@
 package keeper
 
 import (
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmicbet/ledger/x/wta/types"
 )
@@ -24,6 +28,7 @@ type Keeper struct {
 	ak authkeeper.AccountKeeper
 	bk bankkeeper.Keeper
 	dk distrkeeper.Keeper
+	sk stakingkeeper.Keeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -31,7 +36,7 @@ type Keeper struct {
 // NewKeeper creates new instances of the wta Keeper
 func NewKeeper(
 	cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, paramSpace paramstypes.Subspace,
-	ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, dk distrkeeper.Keeper,
+	ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, dk distrkeeper.Keeper, sk stakingkeeper.Keeper,
 	feeCollectorName string,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
@@ -46,6 +51,7 @@ func NewKeeper(
 		ak: ak,
 		bk: bk,
 		dk: dk,
+		sk: sk,
 
 		feeCollectorName: feeCollectorName,
 	}
@@ -53,98 +59,969 @@ func NewKeeper(
 
 // ------------------------------------------------------------------------------------------------------------------
 
-// WithdrawTicketsCost allows the provided buyer to buy the given quantity of tickets.
-func (k Keeper) WithdrawTicketsCost(ctx sdk.Context, quantity uint32, buyer sdk.AccAddress) error {
+// WithdrawTicketsCost allows the provided buyer to buy the given quantity of tickets of the given pool,
+// paying each one of them the given price. The price must be the current ticket price of the pool in its denom.
+// The draw id must be either the one of the current draw of the pool or the one reserved to its upcoming draw,
+// whose prize is kept aside until the draw starts. The part of the tickets cost added to the prize is returned
+func (k Keeper) WithdrawTicketsCost(
+	ctx sdk.Context, pool types.Pool, drawID uint64, quantity uint32, ticketPrice sdk.Coin, buyer sdk.AccAddress,
+) (sdk.Coin, error) {
 	// Check tickets quantity
 	if quantity <= 0 {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount of tickets: %d", quantity)
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount of tickets: %d", quantity)
+	}
+
+	// Check the tickets price
+	price, err := k.GetTicketPrice(ctx, pool, ticketPrice.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if price.Denom != ticketPrice.Denom || !price.Amount.Equal(ticketPrice.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPrice,
+			"invalid ticket price: %s, expected %s", ticketPrice, price)
 	}
 
-	ticketPrice := k.GetTicketParams(ctx).Price
 	ticketsTotal := sdk.NewCoin(ticketPrice.Denom, ticketPrice.Amount.MulRaw(int64(quantity)))
 
 	// Check the user balance
 	balance := k.bk.GetBalance(ctx, buyer, ticketsTotal.Denom)
 	if balance.IsLT(ticketsTotal) {
-		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "cannot purchase %d tickets", quantity)
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "cannot purchase %d tickets", quantity)
+	}
+
+	// Check the user spending limit
+	err = k.CheckSpendingLimit(ctx, buyer, sdk.NewCoins(ticketsTotal))
+	if err != nil {
+		return sdk.Coin{}, err
 	}
 
-	params := k.GetDistributionParams(ctx)
+	params := pool.DistributionParams
 
 	// Update the prize pool
 	prizeAmount := ticketsTotal.Amount.ToDec().Mul(params.PrizePercentage).RoundInt()
 	prizeCoin := sdk.NewCoin(ticketsTotal.Denom, prizeAmount)
-	err := k.bk.SendCoinsFromAccountToModule(ctx, buyer, types.PrizeCollectorName, sdk.NewCoins(prizeCoin))
+
+	if drawID == k.GetCurrentDrawID(ctx, pool.Id) {
+		err = k.sendToPrizeCollector(ctx, pool.Id, buyer, sdk.NewCoins(prizeCoin))
+	} else {
+		err = k.bk.SendCoins(ctx, buyer, types.PoolUpcomingPrizeCollectorAddress(pool.Id), sdk.NewCoins(prizeCoin))
+	}
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypePrizeIncrease,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(drawID, 10)),
 			sdk.NewAttribute(types.AttributeKeyPrizeAmount, prizeCoin.String()),
 		),
 	)
 
-	// Send the fee amount to the fee pool
+	// Send the fee amount to its destination
 	feeAmount := ticketsTotal.Amount.ToDec().Mul(params.FeePercentage).RoundInt()
 	feeCoin := sdk.NewCoin(ticketsTotal.Denom, feeAmount)
-	err = k.bk.SendCoinsFromAccountToModule(ctx, buyer, k.feeCollectorName, sdk.NewCoins(feeCoin))
+	err = k.sendFee(ctx, drawID, params.FeeDestination, buyer, sdk.NewCoins(feeCoin))
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	// Burn the tokens
 	burnCoin := ticketsTotal.Sub(prizeCoin).Sub(feeCoin)
 	err = k.bk.SendCoinsFromAccountToModule(ctx, buyer, types.PrizeBurnerName, sdk.NewCoins(burnCoin))
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
+	}
+
+	err = k.bk.BurnCoins(ctx, types.PrizeBurnerName, sdk.NewCoins(burnCoin))
+	if err != nil {
+		return sdk.Coin{}, err
 	}
 
-	return k.bk.BurnCoins(ctx, types.PrizeBurnerName, sdk.NewCoins(burnCoin))
+	k.trackSpending(ctx, buyer, sdk.NewCoins(ticketsTotal))
+	return prizeCoin, nil
 }
 
-// SaveTickets sets the given tickets for the given user
+// SaveTickets sets the given tickets for the given user, indexing each one of them by its owner.
+// New tickets are appended to the tickets of their draw, updating its tickets Merkle tree, while existing tickets
+// keep their position. Existing tickets moved to another draw are removed from the positions of their previous draw.
+// The tickets and participants counters of the involved draws are updated accordingly.
+// Tickets can only be moved to another draw starting from the first position, using MoveDrawTickets.
+// Newly created tickets should be saved using SaveNewTickets instead, so that no existing ticket is overwritten
 func (k Keeper) SaveTickets(ctx sdk.Context, tickets []types.Ticket) {
+	// Existing tickets are allowed, so no error can be returned
+	_ = k.saveTickets(ctx, tickets, true)
+}
+
+// SaveNewTickets saves the given newly created tickets as SaveTickets does, returning an error if any of them has
+// the same id of an existing ticket. In such case, the changes made to the store must be discarded
+func (k Keeper) SaveNewTickets(ctx sdk.Context, tickets []types.Ticket) error {
+	return k.saveTickets(ctx, tickets, false)
+}
+
+// saveTickets saves the given tickets, overwriting the existing ones only if allowExisting is true
+func (k Keeper) saveTickets(ctx sdk.Context, tickets []types.Ticket, allowExisting bool) error {
 	store := ctx.KVStore(k.storeKey)
+	counters := k.newCountersCache(ctx)
 	for _, t := range tickets {
-		store.Set(types.TicketsStoreKey(t.Id), types.MustMarshalTicket(k.cdc, t))
+		// Remove the previous version of the ticket from the counters and the owner index, if any
+		existing, found := k.GetTicket(ctx, t.Id)
+		if found && !allowExisting {
+			return sdkerrors.Wrap(types.ErrTicketExists, t.Id)
+		}
+		if found {
+			counters.decrementDrawCounters(existing.DrawId, existing.Owner)
+			if existing.Owner != t.Owner {
+				store.Delete(types.OwnerTicketStoreKey(existing.Owner, t.Id))
+			}
+			if existing.DrawId != t.DrawId {
+				_, oldPosition := types.MustUnmarshalTicketDrawPosition(store.Get(types.TicketPositionStoreKey(t.Id)))
+				store.Delete(types.TicketsStoreKey(existing.DrawId, t.Id))
+				k.deleteDrawTicketPosition(ctx, existing.DrawId, oldPosition)
+			}
+		}
+
+		// Tickets already part of the draw keep their position, while the others are added after the last one
+		position := counters.count(types.DrawTicketsCountStoreKey(t.DrawId))
+		if found && existing.DrawId == t.DrawId {
+			_, position = types.MustUnmarshalTicketDrawPosition(store.Get(types.TicketPositionStoreKey(t.Id)))
+		}
+
+		store.Set(types.TicketsStoreKey(t.DrawId, t.Id), types.MustMarshalTicket(k.cdc, t))
+		store.Set(types.OwnerTicketStoreKey(t.Owner, t.Id), []byte(t.Id))
+		store.Set(types.DrawTicketStoreKey(t.DrawId, position), []byte(t.Id))
+		store.Set(types.TicketPositionStoreKey(t.Id), types.MustMarshalTicketDrawPosition(t.DrawId, position))
+		k.setDrawTicketsTreeLeaf(ctx, t.DrawId, position, types.TicketMerkleLeafHash(t))
+		counters.incrementDrawCounters(t.DrawId, t.Owner)
 	}
+
+	k.flushCounters(ctx, counters)
+	return nil
 }
 
-// WipeCurrentTickets removes all the currently stored tickets
-func (k Keeper) WipeCurrentTickets(ctx sdk.Context) {
+// GetTicket returns the ticket having the given id
+func (k Keeper) GetTicket(ctx sdk.Context, id string) (ticket types.Ticket, found bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	tickets := k.GetTickets(ctx)
-	for _, t := range tickets {
-		store.Delete(types.TicketsStoreKey(t.Id))
+	bz := store.Get(types.TicketPositionStoreKey(id))
+	if bz == nil {
+		return types.Ticket{}, false
+	}
+
+	drawID, _ := types.MustUnmarshalTicketDrawPosition(bz)
+	return types.MustUnmarshalTicket(k.cdc, store.Get(types.TicketsStoreKey(drawID, id))), true
+}
+
+// EnqueueDrawPruning schedules the removal of all the tickets of the past draw having the given id,
+// which will be performed a few tickets at a time at the end of each block by PruneTickets.
+// The tickets and participants counters of the draw are removed immediately
+func (k Keeper) EnqueueDrawPruning(ctx sdk.Context, drawID uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	ticketsCount := k.GetDrawTicketsCount(ctx, drawID)
+	if ticketsCount > 0 {
+		store.Set(types.PruningQueueStoreKey(drawID), types.MustMarshalTicketsCount(ticketsCount))
+	}
+
+	store.Delete(types.DrawTicketsCountStoreKey(drawID))
+	store.Delete(types.DrawParticipantsStoreKey(drawID))
+}
+
+// PruneDrawTickets removes at most limit tickets of the draw having the given id, starting from the first position,
+// along with their owner index, their positions and the nodes of the draw tickets Merkle tree containing them.
+// The number of removed tickets is returned
+func (k Keeper) PruneDrawTickets(ctx sdk.Context, drawID uint64, limit uint32) uint32 {
+	store := ctx.KVStore(k.storeKey)
+
+	// Collect the tickets before deleting them, as the store cannot be changed while iterating it
+	var positions []uint32
+	var tickets []types.Ticket
+	k.IterateDrawTickets(ctx, drawID, func(position uint32, ticket types.Ticket) (stop bool) {
+		if uint32(len(tickets)) == limit {
+			return true
+		}
+
+		positions = append(positions, position)
+		tickets = append(tickets, ticket)
+		return false
+	})
+
+	for i, t := range tickets {
+		store.Delete(types.TicketsStoreKey(drawID, t.Id))
+		store.Delete(types.OwnerTicketStoreKey(t.Owner, t.Id))
+		store.Delete(types.TicketPositionStoreKey(t.Id))
+		store.Delete(types.DrawOwnerTicketsStoreKey(drawID, t.Owner))
+		k.deleteDrawTicketPosition(ctx, drawID, positions[i])
+	}
+
+	return uint32(len(tickets))
+}
+
+// deleteDrawTicketPosition removes the given position of the draw having the provided id, along with the leaf of
+// the draw tickets Merkle tree and all the subtrees that are completed by it
+func (k Keeper) deleteDrawTicketPosition(ctx sdk.Context, drawID uint64, position uint32) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.DrawTicketStoreKey(drawID, position))
+	for level := uint8(0); level < 32; level++ {
+		if level > 0 && (position+1)%(1<<level) != 0 {
+			break
+		}
+		store.Delete(types.DrawTicketsTreeNodeStoreKey(drawID, level, position>>level))
+	}
+}
+
+// PruneTickets removes the tickets of the past draws inside the pruning queue, starting from the oldest draw.
+// At most the number of tickets allowed by the pruning params are removed, and each draw is removed from the
+// queue once all its tickets have been removed
+func (k Keeper) PruneTickets(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	limit := k.GetPruningParams(ctx).MaxTicketsPerBlock
+	for _, draw := range k.GetPruningQueue(ctx) {
+		if limit == 0 {
+			return
+		}
+
+		pruned := k.PruneDrawTickets(ctx, draw.DrawId, limit)
+		limit -= pruned
+
+		// A draw is completely pruned once there are no more tickets left
+		if pruned < draw.RemainingTickets && limit == 0 {
+			store.Set(types.PruningQueueStoreKey(draw.DrawId), types.MustMarshalTicketsCount(draw.RemainingTickets-pruned))
+			return
+		}
+
+		store.Delete(types.PruningQueueStoreKey(draw.DrawId))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDrawPruned,
+				sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(draw.DrawId, 10)),
+			),
+		)
+	}
+}
+
+// EnqueueDrawTicketsMove schedules the move of all the tickets of the rolled over draw having the given id to the
+// draw having the new id, which will be performed a few tickets at a time at the end of each block by MoveTickets
+func (k Keeper) EnqueueDrawTicketsMove(ctx sdk.Context, drawID uint64, newDrawID uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	if k.GetDrawTicketsCount(ctx, drawID) > 0 {
+		store.Set(types.TicketsMoveQueueStoreKey(drawID), types.MustMarshalDrawID(newDrawID))
+	}
+}
+
+// MoveDrawTickets moves at most limit tickets of the draw having the given id to the draw having the new id,
+// starting from the first position. Moved tickets keep their order, and are added after the last ticket
+// of the new draw. The number of moved tickets is returned
+func (k Keeper) MoveDrawTickets(ctx sdk.Context, drawID uint64, newDrawID uint64, limit uint32) uint32 {
+	// Collect the tickets before moving them, as the store cannot be changed while iterating it.
+	// Their positions inside the old draw are removed while saving them
+	var tickets []types.Ticket
+	k.IterateDrawTickets(ctx, drawID, func(_ uint32, ticket types.Ticket) (stop bool) {
+		if uint32(len(tickets)) == limit {
+			return true
+		}
+
+		ticket.DrawId = newDrawID
+		tickets = append(tickets, ticket)
+		return false
+	})
+
+	k.SaveTickets(ctx, tickets)
+
+	return uint32(len(tickets))
+}
+
+// MoveTickets moves the tickets of the rolled over draws inside the move queue to their new draw, starting from
+// the oldest draw. At most the number of tickets allowed by the pruning params are moved, and each draw is removed
+// from the queue once all its tickets have been moved
+func (k Keeper) MoveTickets(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	limit := k.GetPruningParams(ctx).MaxTicketsPerBlock
+	for _, move := range k.GetTicketsMoveQueue(ctx) {
+		if limit == 0 {
+			return
+		}
+
+		limit -= k.MoveDrawTickets(ctx, move.DrawId, move.NewDrawId, limit)
+
+		// A draw is completely moved once there are no more tickets left
+		if k.GetDrawTicketsCount(ctx, move.DrawId) > 0 {
+			return
+		}
+
+		store.Delete(types.TicketsMoveQueueStoreKey(move.DrawId))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTicketsMoved,
+				sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(move.DrawId, 10)),
+				sdk.NewAttribute(types.AttributeKeyNewDrawID, strconv.FormatUint(move.NewDrawId, 10)),
+			),
+		)
+	}
+}
+
+// getMovingDrawsIDs returns the ids of the rolled over draws whose tickets are still being moved to the draw
+// having the given id
+func (k Keeper) getMovingDrawsIDs(ctx sdk.Context, newDrawID uint64) []uint64 {
+	var ids []uint64
+	for _, move := range k.GetTicketsMoveQueue(ctx) {
+		if move.NewDrawId == newDrawID {
+			ids = append(ids, move.DrawId)
+		}
+	}
+	return ids
+}
+
+// HasMovingTickets tells whether some tickets of the previous draws are still being moved to the draw
+// having the given id
+func (k Keeper) HasMovingTickets(ctx sdk.Context, drawID uint64) bool {
+	return len(k.getMovingDrawsIDs(ctx, drawID)) > 0
+}
+
+// GetDrawTicketAt returns the ticket at the given position of the draw having the provided id
+func (k Keeper) GetDrawTicketAt(ctx sdk.Context, drawID uint64, position uint32) (ticket types.Ticket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.DrawTicketStoreKey(drawID, position))
+	if bz == nil {
+		return types.Ticket{}, false
+	}
+
+	return k.GetTicket(ctx, string(bz))
+}
+
+// setDrawTicketsTreeLeaf sets the leaf at the given position of the tickets Merkle tree of the draw having
+// the provided id, updating all the complete subtrees containing it
+func (k Keeper) setDrawTicketsTreeLeaf(ctx sdk.Context, drawID uint64, position uint32, leafHash []byte) {
+	types.SetMerkleLeaf(position, leafHash, k.drawTicketsTreeGetter(ctx, drawID),
+		func(level uint8, index uint32, hash []byte) {
+			ctx.KVStore(k.storeKey).Set(types.DrawTicketsTreeNodeStoreKey(drawID, level, index), hash)
+		})
+}
+
+// drawTicketsTreeGetter returns the getter of the nodes of the tickets Merkle tree of the draw having the given id
+func (k Keeper) drawTicketsTreeGetter(ctx sdk.Context, drawID uint64) types.MerkleNodeGetter {
+	return func(level uint8, index uint32) []byte {
+		return ctx.KVStore(k.storeKey).Get(types.DrawTicketsTreeNodeStoreKey(drawID, level, index))
+	}
+}
+
+// GetDrawTicketsRoot returns the Merkle root of all the tickets of the draw having the given id, sorted by position
+func (k Keeper) GetDrawTicketsRoot(ctx sdk.Context, drawID uint64) []byte {
+	total := k.GetDrawTicketsCount(ctx, drawID)
+	return types.ComputeMerkleRootFromNodes(total, k.drawTicketsTreeGetter(ctx, drawID))
+}
+
+// GetDrawTicketProof returns the aunts of the inclusion proof of the ticket at the given position of the draw
+// having the provided id, computed against the root returned by GetDrawTicketsRoot
+func (k Keeper) GetDrawTicketProof(ctx sdk.Context, drawID uint64, position uint32) [][]byte {
+	total := k.GetDrawTicketsCount(ctx, drawID)
+	return types.ComputeMerkleAuntsFromNodes(total, position, k.drawTicketsTreeGetter(ctx, drawID))
+}
+
+// RefundDrawTickets gives back to each payer of the tickets of the given draw the prize share stored inside each
+// one of them, which is the part of the tickets cost that has been added to the prize of the given pool when they
+// were bought, emitting an event for each payer. Refunds are limited to the amount currently held by the pool,
+// and the total refunded amount is returned
+func (k Keeper) RefundDrawTickets(ctx sdk.Context, pool types.Pool, drawID uint64) (sdk.Coins, error) {
+	// The tickets are read to get the prize share paid for each one of them, since they could have been bought
+	// using different denominations or prize percentages. Each ticket is refunded to the address that paid for it,
+	// unless it has been transferred, in which case it is refunded to its owner
+	var payers []string
+	ticketsCount := map[string]int64{}
+	shares := map[string]sdk.Coins{}
+	k.IterateDrawTickets(ctx, drawID, func(_ uint32, ticket types.Ticket) (stop bool) {
+		payer := ticket.RefundAddress()
+		if _, found := ticketsCount[payer]; !found {
+			payers = append(payers, payer)
+		}
+		ticketsCount[payer]++
+		shares[payer] = shares[payer].Add(ticket.PrizeShare...)
+		return false
+	})
+	sort.Strings(payers)
+
+	available := k.bk.GetAllBalances(ctx, types.PoolPrizeCollectorAddress(pool.Id))
+
+	refunded := sdk.NewCoins()
+	for _, payer := range payers {
+		payerAddr, err := sdk.AccAddressFromBech32(payer)
+		if err != nil {
+			return nil, err
+		}
+
+		refund := sdk.NewCoins()
+		for _, share := range shares[payer] {
+			amount := sdk.MinInt(share.Amount, available.AmountOf(share.Denom))
+			refund = refund.Add(sdk.NewCoin(share.Denom, amount))
+		}
+		if refund.IsZero() {
+			continue
+		}
+
+		err = k.TransferDrawPrize(ctx, pool.Id, refund, payerAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		available = available.Sub(refund)
+		refunded = refunded.Add(refund...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTicketsRefund,
+				sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(drawID, 10)),
+				sdk.NewAttribute(types.AttributeKeyTicketsPayer, payer),
+				sdk.NewAttribute(types.AttributeKeyTicketsCount, strconv.FormatInt(ticketsCount[payer], 10)),
+				sdk.NewAttribute(types.AttributeKeyRefundedAmount, refund.String()),
+			),
+		)
+	}
+
+	return refunded, nil
+}
+
+// CancelCurrentDraw cancels the current draw of the given pool, refunding all its tickets and scheduling their removal.
+// The draw is saved as a past draw having the given status and number of rollovers, and the pool moves on
+// to a new draw. The total refunded amount is returned
+func (k Keeper) CancelCurrentDraw(
+	ctx sdk.Context, pool types.Pool, status types.DrawStatus, rollovers uint32,
+) (sdk.Coins, error) {
+	draw := k.GetCurrentDraw(ctx, pool.Id)
+
+	refunded, err := k.RefundDrawTickets(ctx, pool, draw.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The tickets of the rolled over draws that are still being moved are refunded and pruned as well
+	for _, drawID := range k.getMovingDrawsIDs(ctx, draw.Id) {
+		movingRefunded, err := k.RefundDrawTickets(ctx, pool, drawID)
+		if err != nil {
+			return nil, err
+		}
+		refunded = refunded.Add(movingRefunded...)
+
+		ctx.KVStore(k.storeKey).Delete(types.TicketsMoveQueueStoreKey(drawID))
+		k.EnqueueDrawPruning(ctx, drawID)
+	}
+
+	k.SaveHistoricalDraw(ctx, types.NewUnsettledHistoricalDrawData(draw, status, rollovers))
+	k.EnqueueDrawPruning(ctx, draw.Id)
+
+	k.AssignNextDrawID(ctx, pool.Id)
+	k.SaveCurrentDrawRollovers(ctx, pool.Id, 0)
+
+	return refunded, nil
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// SavePool stores the given pool
+func (k Keeper) SavePool(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PoolStoreKey(pool.Id), types.MustMarshalPool(k.cdc, pool))
+}
+
+// HasPool tells whether the pool having the given id exists
+func (k Keeper) HasPool(ctx sdk.Context, id uint64) bool {
+	if id == types.DefaultPoolID {
+		return true
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PoolStoreKey(id))
+}
+
+// GetPool returns the pool having the given id.
+// The default pool always exists and uses the module parameters
+func (k Keeper) GetPool(ctx sdk.Context, id uint64) (pool types.Pool, found bool) {
+	if id == types.DefaultPoolID {
+		return k.getDefaultPool(ctx), true
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.PoolStoreKey(id))
+	if bz == nil {
+		return types.Pool{}, false
+	}
+
+	return types.MustUnmarshalPool(k.cdc, bz), true
+}
+
+// getDefaultPool returns the default pool, built using the module parameters
+func (k Keeper) getDefaultPool(ctx sdk.Context) types.Pool {
+	return types.NewPool(
+		types.DefaultPoolID,
+		k.GetDistributionParams(ctx),
+		k.GetDrawParams(ctx),
+		k.GetTicketParams(ctx),
+		k.GetPrizeParams(ctx),
+		types.DefaultGameParams(),
+		k.GetRolloverParams(ctx),
+	)
+}
+
+// SetPoolSalesPaused sets whether the ticket sales of the pool having the given id are paused
+func (k Keeper) SetPoolSalesPaused(ctx sdk.Context, poolID uint64, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !paused {
+		store.Delete(types.PausedPoolStoreKey(poolID))
+		return
+	}
+	store.Set(types.PausedPoolStoreKey(poolID), []byte{0x1})
+}
+
+// IsPoolSalesPaused tells whether the ticket sales of the pool having the given id are paused
+func (k Keeper) IsPoolSalesPaused(ctx sdk.Context, poolID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PausedPoolStoreKey(poolID))
+}
+
+// saveKnownSalesHalted stores the given value as the last known halted flag of the SalesParams
+func (k Keeper) saveKnownSalesHalted(ctx sdk.Context, halted bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !halted {
+		store.Delete(types.SalesHaltedStoreKey)
+		return
+	}
+	store.Set(types.SalesHaltedStoreKey, []byte{0x1})
+}
+
+// TrackSalesHalted emits an event if the halted flag of the SalesParams differs from the last known one, so that
+// the sales being halted or restarted through a parameter change proposal are reported as well
+func (k Keeper) TrackSalesHalted(ctx sdk.Context) {
+	params := k.GetSalesParams(ctx)
+	if ctx.KVStore(k.storeKey).Has(types.SalesHaltedStoreKey) == params.Halted {
+		return
+	}
+
+	k.saveKnownSalesHalted(ctx, params.Halted)
+	k.emitSalesHaltedEvent(ctx, params)
+}
+
+// emitSalesHaltedEvent emits the event reporting that the ticket sales have been halted or restarted
+func (k Keeper) emitSalesHaltedEvent(ctx sdk.Context, params types.SalesParams) {
+	if params.Halted {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSalesHalted,
+				sdk.NewAttribute(types.AttributeKeyHaltReason, params.HaltReason),
+			),
+		)
+	} else {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeSalesUnhalted),
+		)
+	}
+}
+
+// GetPausedPools returns the ids of all the pools whose ticket sales are paused
+func (k Keeper) GetPausedPools(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PausedPoolsStorePrefix)
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()[len(types.PausedPoolsStorePrefix):]))
+	}
+
+	return ids
+}
+
+// SetNextDrawID sets the id that will be assigned to the next draw that will be started
+func (k Keeper) SetNextDrawID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextDrawIDStoreKey, types.MustMarshalDrawID(id))
+}
+
+// GetNextDrawID returns the id that will be assigned to the next draw that will be started
+func (k Keeper) GetNextDrawID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextDrawIDStoreKey)
+	if bz == nil {
+		return 1
+	}
+
+	return types.MustUnmarshalDrawID(bz)
+}
+
+// AssignNextDrawID assigns a new id to the current draw of the given pool, returning it.
+// If an id has already been reserved to the upcoming draw of the pool, such id is used instead,
+// and the prize collected for the upcoming draw is added to the prize of the pool
+func (k Keeper) AssignNextDrawID(ctx sdk.Context, poolID uint64) uint64 {
+	nextDrawID, found := k.GetUpcomingDrawID(ctx, poolID)
+	if found {
+		k.startUpcomingDraw(ctx, poolID)
+	} else {
+		nextDrawID = k.GetNextDrawID(ctx)
+		k.SetNextDrawID(ctx, nextDrawID+1)
+	}
+
+	k.SaveCurrentDrawID(ctx, poolID, nextDrawID)
+	return nextDrawID
+}
+
+// SaveUpcomingDrawID stores the given id as the one reserved to the draw following the current one of the given pool
+func (k Keeper) SaveUpcomingDrawID(ctx sdk.Context, poolID uint64, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UpcomingDrawIDStoreKey(poolID), types.MustMarshalDrawID(id))
+}
+
+// GetUpcomingDrawID returns the id reserved to the draw following the current one of the given pool, if any
+func (k Keeper) GetUpcomingDrawID(ctx sdk.Context, poolID uint64) (id uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.UpcomingDrawIDStoreKey(poolID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return types.MustUnmarshalDrawID(bz), true
+}
+
+// ReserveUpcomingDrawID returns the id reserved to the draw following the current one of the given pool,
+// reserving a new one if no id has been reserved yet
+func (k Keeper) ReserveUpcomingDrawID(ctx sdk.Context, poolID uint64) uint64 {
+	id, found := k.GetUpcomingDrawID(ctx, poolID)
+	if found {
+		return id
+	}
+
+	id = k.GetNextDrawID(ctx)
+	k.SetNextDrawID(ctx, id+1)
+	k.SaveUpcomingDrawID(ctx, poolID, id)
+	return id
+}
+
+// startUpcomingDraw removes the id reserved to the upcoming draw of the given pool,
+// and moves the prize collected for such draw to the prize of the pool
+func (k Keeper) startUpcomingDraw(ctx sdk.Context, poolID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UpcomingDrawIDStoreKey(poolID))
+
+	upcomingCollector := types.PoolUpcomingPrizeCollectorAddress(poolID)
+	prize := k.bk.GetAllBalances(ctx, upcomingCollector)
+	if !prize.IsZero() {
+		err := k.sendToPrizeCollector(ctx, poolID, upcomingCollector, prize)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// sendToPrizeCollector sends the given amount from the sender to the account holding the prize of the given pool
+func (k Keeper) sendToPrizeCollector(ctx sdk.Context, poolID uint64, sender sdk.AccAddress, amount sdk.Coins) error {
+	if poolID == types.DefaultPoolID {
+		return k.bk.SendCoinsFromAccountToModule(ctx, sender, types.PrizeCollectorName, amount)
+	}
+	return k.bk.SendCoins(ctx, sender, types.PoolPrizeCollectorAddress(poolID), amount)
+}
+
+// TransferDrawPrize transfers the provided prize of the given pool to the specified winner account
+func (k Keeper) TransferDrawPrize(ctx sdk.Context, poolID uint64, prize sdk.Coins, winner sdk.AccAddress) error {
+	if poolID == types.DefaultPoolID {
+		return k.bk.SendCoinsFromModuleToAccount(ctx, types.PrizeCollectorName, winner, prize)
+	}
+	return k.bk.SendCoins(ctx, types.PoolPrizeCollectorAddress(poolID), winner, prize)
+}
+
+// sendFee sends the given fee of the draw having the given id from the sender to the provided destination,
+// keeping track of the total amount that the destination has received for the draw
+func (k Keeper) sendFee(
+	ctx sdk.Context, drawID uint64, destination types.FeeDestination, sender sdk.AccAddress, fee sdk.Coins,
+) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	var err error
+	switch destination {
+	case types.FeeDestinationFeeCollector:
+		err = k.bk.SendCoinsFromAccountToModule(ctx, sender, k.feeCollectorName, fee)
+	case types.FeeDestinationCommunityPool:
+		err = k.dk.FundCommunityPool(ctx, fee, sender)
+	case types.FeeDestinationTreasury:
+		err = k.bk.SendCoinsFromAccountToModule(ctx, sender, types.TreasuryName, fee)
+	case types.FeeDestinationStakers:
+		err = k.bk.SendCoinsFromAccountToModule(ctx, sender, types.StakersFeesName, fee)
+	default:
+		err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fee destination: %d", destination)
+	}
+	if err != nil {
+		return err
+	}
+
+	fees, found := k.GetDrawFees(ctx, drawID, destination)
+	if !found {
+		fees = types.NewDrawFees(drawID, destination, sdk.NewCoins())
+	}
+	fees.Amount = fees.Amount.Add(fee...)
+	k.SaveDrawFees(ctx, fees)
+
+	return nil
+}
+
+// AllocateStakersFees sends the fees accumulated inside the StakersFeesName module account to the distribution
+// module, and allocates them to the bonded validators proportionally to their stake so that they are shared with
+// their delegators. The remainder left by the truncation of each validator share is sent to the community pool.
+// Fees are allocated once per block, so that buying tickets does not require going through all the validators
+func (k Keeper) AllocateStakersFees(ctx sdk.Context) error {
+	amount := k.bk.GetAllBalances(ctx, k.ak.GetModuleAddress(types.StakersFeesName))
+	if amount.IsZero() {
+		return nil
+	}
+
+	err := k.bk.SendCoinsFromModuleToModule(ctx, types.StakersFeesName, distrtypes.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	total := sdk.NewDecCoinsFromCoins(amount...)
+	remaining := total
+
+	totalBonded := k.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsPositive() {
+		k.sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+			fraction := validator.GetBondedTokens().ToDec().QuoTruncate(totalBonded.ToDec())
+			reward := total.MulDecTruncate(fraction)
+			k.dk.AllocateTokensToValidator(ctx, validator, reward)
+			remaining = remaining.Sub(reward)
+			return false
+		})
+	}
+
+	feePool := k.dk.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
+	k.dk.SetFeePool(ctx, feePool)
+	return nil
+}
+
+// SaveDrawFees stores the given fees of a draw
+func (k Keeper) SaveDrawFees(ctx sdk.Context, fees types.DrawFees) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DrawFeesStoreKey(fees.DrawId, fees.Destination), types.MustMarshalDrawFees(k.cdc, fees))
+}
+
+// GetDrawFees returns the fees of the draw having the given id that have been sent to the given destination
+func (k Keeper) GetDrawFees(
+	ctx sdk.Context, drawID uint64, destination types.FeeDestination,
+) (fees types.DrawFees, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.DrawFeesStoreKey(drawID, destination))
+	if bz == nil {
+		return types.DrawFees{}, false
+	}
+
+	return types.MustUnmarshalDrawFees(k.cdc, bz), true
+}
+
+// GetCommunityPool returns the funds currently held by the community pool
+func (k Keeper) GetCommunityPool(ctx sdk.Context) sdk.DecCoins {
+	return k.dk.GetFeePool(ctx).CommunityPool
+}
+
+// GetTreasury returns the funds currently held by the wta treasury
+func (k Keeper) GetTreasury(ctx sdk.Context) sdk.Coins {
+	return k.bk.GetAllBalances(ctx, k.ak.GetModuleAddress(types.TreasuryName))
+}
+
+// FundPrizeFromTreasury moves the given amount from the wta treasury to the prize of the given pool
+func (k Keeper) FundPrizeFromTreasury(ctx sdk.Context, poolID uint64, amount sdk.Coins) error {
+	if !k.GetTreasury(ctx).IsAllGTE(amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "cannot fund %s from the treasury", amount)
 	}
+
+	return k.sendToPrizeCollector(ctx, poolID, k.ak.GetModuleAddress(types.TreasuryName), amount)
+}
+
+// SaveCurrentDrawEndTime stores the given time as the end time of the current draw of the given pool
+func (k Keeper) SaveCurrentDrawEndTime(ctx sdk.Context, poolID uint64, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CurrentDrawEndTimeStoreKey(poolID), types.MustMarshalDrawEndTime(endTime))
 }
 
 // ------------------------------------------------------------------------------------------------------------------
 
-// TransferDrawPrize transfers the provided prize to the specified winner account
-func (k Keeper) TransferDrawPrize(ctx sdk.Context, prize sdk.Coins, winner sdk.AccAddress) error {
-	return k.bk.SendCoinsFromModuleToAccount(ctx, types.PrizeCollectorName, winner, prize)
+// getCount returns the counter stored using the given key, or 0 if not found
+func (k Keeper) getCount(ctx sdk.Context, key []byte) uint32 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+
+	return types.MustUnmarshalTicketsCount(bz)
+}
+
+// setCount stores the given counter using the provided key, removing it when it reaches 0
+func (k Keeper) setCount(ctx sdk.Context, key []byte, count uint32) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, types.MustMarshalTicketsCount(count))
+}
+
+// countersCache caches the counters read and updated while saving multiple tickets,
+// so that each one of them is read and written only once
+type countersCache struct {
+	values map[string]uint32
+	get    func(key []byte) uint32
+}
+
+// newCountersCache returns a new countersCache reading the counters that are not cached from the store
+func (k Keeper) newCountersCache(ctx sdk.Context) countersCache {
+	return countersCache{
+		values: map[string]uint32{},
+		get: func(key []byte) uint32 {
+			return k.getCount(ctx, key)
+		},
+	}
+}
+
+// count returns the counter having the given key
+func (c countersCache) count(key []byte) uint32 {
+	if value, found := c.values[string(key)]; found {
+		return value
+	}
+
+	value := c.get(key)
+	c.values[string(key)] = value
+	return value
+}
+
+// flushCounters stores all the counters of the given cache, sorted by key
+func (k Keeper) flushCounters(ctx sdk.Context, cache countersCache) {
+	keys := make([]string, 0, len(cache.values))
+	for key := range cache.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		k.setCount(ctx, []byte(key), cache.values[key])
+	}
+}
+
+// incrementDrawCounters adds a ticket of the given owner to the counters of the draw having the given id
+func (c countersCache) incrementDrawCounters(drawID uint64, owner string) {
+	ownerTickets := c.count(types.DrawOwnerTicketsStoreKey(drawID, owner))
+	if ownerTickets == 0 {
+		c.values[string(types.DrawParticipantsStoreKey(drawID))] = c.count(types.DrawParticipantsStoreKey(drawID)) + 1
+	}
+
+	c.values[string(types.DrawOwnerTicketsStoreKey(drawID, owner))] = ownerTickets + 1
+	c.values[string(types.DrawTicketsCountStoreKey(drawID))] = c.count(types.DrawTicketsCountStoreKey(drawID)) + 1
+}
+
+// decrementDrawCounters removes a ticket of the given owner from the counters of the draw having the given id
+func (c countersCache) decrementDrawCounters(drawID uint64, owner string) {
+	ownerTickets := c.count(types.DrawOwnerTicketsStoreKey(drawID, owner))
+	if ownerTickets == 0 {
+		return
+	}
+
+	if ownerTickets == 1 {
+		c.values[string(types.DrawParticipantsStoreKey(drawID))] = c.count(types.DrawParticipantsStoreKey(drawID)) - 1
+	}
+
+	c.values[string(types.DrawOwnerTicketsStoreKey(drawID, owner))] = ownerTickets - 1
+	c.values[string(types.DrawTicketsCountStoreKey(drawID))] = c.count(types.DrawTicketsCountStoreKey(drawID)) - 1
+}
+
+// GetDrawTicketsCount returns the number of tickets sold for the draw having the given id
+func (k Keeper) GetDrawTicketsCount(ctx sdk.Context, drawID uint64) uint32 {
+	return k.getCount(ctx, types.DrawTicketsCountStoreKey(drawID))
+}
+
+// GetDrawParticipantsCount returns the number of distinct participants of the draw having the given id
+func (k Keeper) GetDrawParticipantsCount(ctx sdk.Context, drawID uint64) uint32 {
+	return k.getCount(ctx, types.DrawParticipantsStoreKey(drawID))
+}
+
+// GetDrawOwnerTicketsCount returns the number of tickets bought by the given owner for the draw having the given id
+func (k Keeper) GetDrawOwnerTicketsCount(ctx sdk.Context, drawID uint64, owner string) uint32 {
+	return k.getCount(ctx, types.DrawOwnerTicketsStoreKey(drawID, owner))
+}
+
+// GetCurrentDrawEndTime returns the end time of the current draw of the given pool
+func (k Keeper) GetCurrentDrawEndTime(ctx sdk.Context, poolID uint64) time.Time {
+	store := ctx.KVStore(k.storeKey)
+	return types.MustUnmarshalDrawEndTime(store.Get(types.CurrentDrawEndTimeStoreKey(poolID)))
+}
+
+// IsCurrentDrawOpen tells whether the current draw of the given pool still accepts tickets and entropy commitments
+func (k Keeper) IsCurrentDrawOpen(ctx sdk.Context, poolID uint64) bool {
+	return ctx.BlockTime().Before(k.GetCurrentDrawEndTime(ctx, poolID))
+}
+
+// IsCurrentDrawSalesCutoff tells whether the current draw of the given pool is within the sales cutoff window
+// preceding its end time, during which its tickets can no longer be bought
+func (k Keeper) IsCurrentDrawSalesCutoff(ctx sdk.Context, pool types.Pool) bool {
+	cutoff := pool.DrawParams.SalesCutoff
+	if cutoff == 0 {
+		return false
+	}
+	return !ctx.BlockTime().Before(k.GetCurrentDrawEndTime(ctx, pool.Id).Add(-cutoff))
+}
+
+// SaveCurrentDrawID stores the given id as the one of the current draw of the given pool
+func (k Keeper) SaveCurrentDrawID(ctx sdk.Context, poolID uint64, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CurrentDrawIDStoreKey(poolID), types.MustMarshalDrawID(id))
+}
+
+// GetCurrentDrawID returns the id of the current draw of the given pool
+func (k Keeper) GetCurrentDrawID(ctx sdk.Context, poolID uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return types.MustUnmarshalDrawID(store.Get(types.CurrentDrawIDStoreKey(poolID)))
 }
 
-// SaveCurrentDraw stores the given draw as the next draw
-func (k Keeper) SaveCurrentDrawEndTime(ctx sdk.Context, endTime time.Time) {
+// SaveCurrentDrawRollovers stores the given number of consecutive rollovers of the current draw of the given pool
+func (k Keeper) SaveCurrentDrawRollovers(ctx sdk.Context, poolID uint64, rollovers uint32) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.CurrentDrawEndTimeStoreKey, types.MustMarshalDrawEndTime(endTime))
+	store.Set(types.CurrentDrawRolloversStoreKey(poolID), types.MustMarshalDrawRollovers(rollovers))
 }
 
-// GetCurrentDraw returns the Draw for which the tickets can be currently bought
-func (k Keeper) GetCurrentDraw(ctx sdk.Context) types.Draw {
+// GetCurrentDrawRollovers returns the number of consecutive rollovers of the current draw of the given pool
+func (k Keeper) GetCurrentDrawRollovers(ctx sdk.Context, poolID uint64) uint32 {
 	store := ctx.KVStore(k.storeKey)
-	endTime := types.MustUnmarshalDrawEndTime(store.Get(types.CurrentDrawEndTimeStoreKey))
 
-	acc := authtypes.NewModuleAddress(types.PrizeCollectorName)
-	prize := k.bk.GetAllBalances(ctx, acc)
+	bz := store.Get(types.CurrentDrawRolloversStoreKey(poolID))
+	if bz == nil {
+		return 0
+	}
+
+	return types.MustUnmarshalDrawRollovers(bz)
+}
+
+// GetCurrentDraw returns the Draw of the given pool for which the tickets can be currently bought
+func (k Keeper) GetCurrentDraw(ctx sdk.Context, poolID uint64) types.Draw {
+	id := k.GetCurrentDrawID(ctx, poolID)
+	endTime := k.GetCurrentDrawEndTime(ctx, poolID)
+
+	prize := k.bk.GetAllBalances(ctx, types.PoolPrizeCollectorAddress(poolID))
 
-	participants, ticketsSold := k.GetDrawParticipantsAndTickets(ctx)
-	return types.NewDraw(uint32(len(participants)), uint32(len(ticketsSold)), prize, endTime)
+	participants := k.GetDrawParticipantsCount(ctx, id)
+	ticketsSold := k.GetDrawTicketsCount(ctx, id)
+	return types.NewDraw(id, poolID, participants, ticketsSold, prize, endTime)
 }
 
 // ------------------------------------------------------------------------------------------------------------------
@@ -152,5 +1029,384 @@ func (k Keeper) GetCurrentDraw(ctx sdk.Context) types.Draw {
 // SaveHistoricalDraw saves the given draw as an historical draw
 func (k Keeper) SaveHistoricalDraw(ctx sdk.Context, draw types.HistoricalDrawData) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.HistoricalDataStoreKey(draw.Draw.EndTime), types.MustMarshalHistoricalDraw(k.cdc, draw))
+	store.Set(types.HistoricalDataStoreKey(draw.Draw.Id), types.MustMarshalHistoricalDraw(k.cdc, draw))
+}
+
+// GetHistoricalDraw returns the historical data of the draw having the given id
+func (k Keeper) GetHistoricalDraw(ctx sdk.Context, drawID uint64) (draw types.HistoricalDrawData, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.HistoricalDataStoreKey(drawID)
+	if !store.Has(key) {
+		return types.HistoricalDrawData{}, false
+	}
+
+	return types.MustUnmarshalHistoricalDrawData(k.cdc, store.Get(key)), true
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// IsBondedValidator tells whether the given address belongs to a currently bonded validator
+func (k Keeper) IsBondedValidator(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	return found && validator.IsBonded()
+}
+
+// SaveEntropyCommitment stores the given entropy commitment for the current draw
+func (k Keeper) SaveEntropyCommitment(ctx sdk.Context, commitment types.EntropyCommitment) {
+	valAddr, err := sdk.ValAddressFromBech32(commitment.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.EntropyCommitmentStoreKey(commitment.PoolId, valAddr)
+	store.Set(key, types.MustMarshalEntropyCommitment(k.cdc, commitment))
+}
+
+// GetEntropyCommitment returns the entropy commitment of the given validator for the current draw of the given pool, if any
+func (k Keeper) GetEntropyCommitment(
+	ctx sdk.Context, poolID uint64, valAddr sdk.ValAddress,
+) (commitment types.EntropyCommitment, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EntropyCommitmentStoreKey(poolID, valAddr))
+	if bz == nil {
+		return types.EntropyCommitment{}, false
+	}
+
+	return types.MustUnmarshalEntropyCommitment(k.cdc, bz), true
+}
+
+// DeletePoolEntropyCommitments deletes all the entropy commitments made for the current draw of the given pool,
+// without tracking the missing reveals
+func (k Keeper) DeletePoolEntropyCommitments(ctx sdk.Context, poolID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, c := range k.GetPoolEntropyCommitments(ctx, poolID) {
+		valAddr, err := sdk.ValAddressFromBech32(c.Validator)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(types.EntropyCommitmentStoreKey(poolID, valAddr))
+	}
+}
+
+// SetMissedReveals sets the number of entropy reveals missed by the given validator
+func (k Keeper) SetMissedReveals(ctx sdk.Context, valAddr sdk.ValAddress, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MissedRevealsStoreKey(valAddr), types.MustMarshalMissedRevealsCount(count))
+}
+
+// GetMissedReveals returns the number of entropy reveals missed by the given validator
+func (k Keeper) GetMissedReveals(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.MissedRevealsStoreKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+
+	return types.MustUnmarshalMissedRevealsCount(bz)
+}
+
+// SaveExclusion stores the given self exclusion, replacing any existing one of the same address
+func (k Keeper) SaveExclusion(ctx sdk.Context, exclusion types.Exclusion) {
+	addr, err := sdk.AccAddressFromBech32(exclusion.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ExclusionStoreKey(addr), types.MustMarshalExclusion(k.cdc, exclusion))
+}
+
+// GetExclusion returns the self exclusion of the given address, if any
+func (k Keeper) GetExclusion(ctx sdk.Context, addr sdk.AccAddress) (exclusion types.Exclusion, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ExclusionStoreKey(addr))
+	if bz == nil {
+		return types.Exclusion{}, false
+	}
+
+	return types.MustUnmarshalExclusion(k.cdc, bz), true
+}
+
+// IsExcluded tells whether the given address has a self exclusion that has not expired yet
+func (k Keeper) IsExcluded(ctx sdk.Context, addr sdk.AccAddress) bool {
+	exclusion, found := k.GetExclusion(ctx, addr)
+	return found && exclusion.IsActive(ctx.BlockTime())
+}
+
+// SaveSpendingLimit stores the given spending limit, replacing any existing one of the same address
+func (k Keeper) SaveSpendingLimit(ctx sdk.Context, limit types.SpendingLimit) {
+	addr, err := sdk.AccAddressFromBech32(limit.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SpendingLimitStoreKey(addr), types.MustMarshalSpendingLimit(k.cdc, limit))
+}
+
+// GetSpendingLimit returns the spending limit of the given address that is in effect at the current block time.
+// If a pending removal of the limit has become effective, found is false
+func (k Keeper) GetSpendingLimit(ctx sdk.Context, addr sdk.AccAddress) (limit types.SpendingLimit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SpendingLimitStoreKey(addr))
+	if bz == nil {
+		return types.SpendingLimit{}, false
+	}
+
+	limit = types.MustUnmarshalSpendingLimit(k.cdc, bz).Current(ctx.BlockTime())
+	return limit, limit.IsLimited()
+}
+
+// DeleteSpendingLimit removes the spending limit of the given address, along with the amounts it has spent
+func (k Keeper) DeleteSpendingLimit(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SpendingLimitStoreKey(addr))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SpendRecordsPrefix(addr))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SaveSpendRecord stores the given spend record, replacing any existing one of the same address and time
+func (k Keeper) SaveSpendRecord(ctx sdk.Context, record types.SpendRecord) {
+	addr, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SpendRecordStoreKey(addr, record.Time), types.MustMarshalSpendRecord(k.cdc, record))
+}
+
+// GetSpentAmount returns the total amount spent by the given address since the provided time
+func (k Keeper) GetSpentAmount(ctx sdk.Context, addr sdk.AccAddress, since time.Time) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(
+		types.SpendRecordStoreKey(addr, since),
+		sdk.PrefixEndBytes(types.SpendRecordsPrefix(addr)),
+	)
+	defer iterator.Close()
+
+	spent := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		spent = spent.Add(types.MustUnmarshalSpendRecord(k.cdc, iterator.Value()).Amount...)
+	}
+
+	return spent
+}
+
+// CheckSpendingLimit returns an error if the given address cannot spend the provided amount
+// without exceeding the spending limit that is currently in effect, if any
+func (k Keeper) CheckSpendingLimit(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) error {
+	limit, found := k.GetSpendingLimit(ctx, addr)
+	if !found {
+		return nil
+	}
+
+	spent := k.GetSpentAmount(ctx, addr, ctx.BlockTime().Add(-limit.Window))
+	if !limit.CanSpend(spent, amount) {
+		return sdkerrors.Wrapf(types.ErrSpendingLimitReached,
+			"cannot spend %s, already spent %s out of %s in the last %s", amount, spent, limit.Amount, limit.Window)
+	}
+
+	return nil
+}
+
+// trackSpending records the given amount as spent by the provided address inside the current block, if it has
+// a spending limit. Addresses without a limit are not tracked to avoid storing the spending of every buyer, so the
+// window of a newly set limit starts when it is set and only counts the amounts spent after it. The amounts spent
+// before the window of the current and pending limits are removed, as they no longer count against them, while the
+// whole history is removed along with the limit once it is no longer in effect
+func (k Keeper) trackSpending(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+
+	limit, found := k.GetSpendingLimit(ctx, addr)
+	if !found {
+		if store.Has(types.SpendingLimitStoreKey(addr)) {
+			k.DeleteSpendingLimit(ctx, addr)
+		}
+		return
+	}
+
+	// Remove the amounts spent outside the longest window
+	window := limit.Window
+	if limit.Pending != nil && limit.Pending.Window > window {
+		window = limit.Pending.Window
+	}
+
+	iterator := store.Iterator(
+		types.SpendRecordsPrefix(addr),
+		types.SpendRecordStoreKey(addr, ctx.BlockTime().Add(-window)),
+	)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	// Add the amount to the one already spent inside the current block
+	spent := k.GetSpentAmount(ctx, addr, ctx.BlockTime())
+	k.SaveSpendRecord(ctx, types.NewSpendRecord(limit.Address, ctx.BlockTime(), spent.Add(amount...)))
+}
+
+// CloseEntropyRound closes the commit-reveal round of the current draw of the given pool.
+// The entropy revealed by validators is combined into the returned seed, while validators that
+// committed without revealing are skipped and have their missed reveals count increased.
+// All the commitments are then removed so that a new round can start with the next draw.
+// If no entropy has been revealed, nil is returned.
+func (k Keeper) CloseEntropyRound(ctx sdk.Context, poolID uint64) []byte {
+	store := ctx.KVStore(k.storeKey)
+
+	commitments := k.GetPoolEntropyCommitments(ctx, poolID)
+	for _, c := range commitments {
+		valAddr, err := sdk.ValAddressFromBech32(c.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		if !c.IsRevealed() {
+			missed := k.GetMissedReveals(ctx, valAddr) + 1
+			k.SetMissedReveals(ctx, valAddr, missed)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMissedReveal,
+					sdk.NewAttribute(types.AttributeKeyValidator, c.Validator),
+					sdk.NewAttribute(types.AttributeKeyMissedReveals, strconv.FormatUint(missed, 10)),
+				),
+			)
+		}
+
+		store.Delete(types.EntropyCommitmentStoreKey(poolID, valAddr))
+	}
+
+	return types.NewSeedFromEntropy(commitments)
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// GetTicketPrice returns the price of an individual ticket of the given pool paid using the given denom, or the
+// main price if the denom is empty. If the pool has a reference price, the amount is computed converting it using
+// the current median price of the denom, and an error is returned if such price is missing or stale
+func (k Keeper) GetTicketPrice(ctx sdk.Context, pool types.Pool, denom string) (sdk.Coin, error) {
+	price, found := pool.TicketParams.PriceOf(denom)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrDenomNotAccepted,
+			"tickets of pool %d cannot be bought using %s, accepted prices are %s",
+			pool.Id, denom, pool.TicketParams.Prices())
+	}
+
+	if !pool.TicketParams.HasReferencePrice() {
+		return price, nil
+	}
+
+	feedPrice, found := k.GetPrice(ctx, price.Denom)
+	if !found || feedPrice.IsStale(ctx.BlockTime(), k.GetOracleParams(ctx).MaxPriceAge) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrStalePrice, "no recent price of %s is available", price.Denom)
+	}
+
+	amount := pool.TicketParams.ReferencePrice.Quo(feedPrice.Price).Ceil().TruncateInt()
+	return sdk.NewCoin(price.Denom, amount), nil
+}
+
+// SavePricePost stores the given price post, replacing the previous price of the same denom posted by the same oracle
+func (k Keeper) SavePricePost(ctx sdk.Context, post types.PricePost) {
+	oracle, err := sdk.AccAddressFromBech32(post.Oracle)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PricePostStoreKey(post.Denom, oracle), types.MustMarshalPricePost(k.cdc, post))
+}
+
+// SavePrice stores the given median price of a denom
+func (k Keeper) SavePrice(ctx sdk.Context, price types.Price) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PriceStoreKey(price.Denom), types.MustMarshalPrice(k.cdc, price))
+}
+
+// GetPrice returns the median price of the given denom, if any
+func (k Keeper) GetPrice(ctx sdk.Context, denom string) (price types.Price, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.PriceStoreKey(denom))
+	if bz == nil {
+		return types.Price{}, false
+	}
+
+	return types.MustUnmarshalPrice(k.cdc, bz), true
+}
+
+// PruneRemovedOraclesPrices removes the price posts of the addresses that are no longer whitelisted as oracles,
+// which can happen after a parameter change proposal has updated the OracleParams. The median price of each denom
+// they posted is then computed again, and it is removed if no price posted by the current oracles is left
+func (k Keeper) PruneRemovedOraclesPrices(ctx sdk.Context) {
+	params := k.GetOracleParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	var denoms []string
+	affected := map[string]bool{}
+	for _, post := range k.GetAllPricePosts(ctx) {
+		if params.IsOracle(post.Oracle) {
+			continue
+		}
+
+		oracle, err := sdk.AccAddressFromBech32(post.Oracle)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(types.PricePostStoreKey(post.Denom, oracle))
+
+		if !affected[post.Denom] {
+			affected[post.Denom] = true
+			denoms = append(denoms, post.Denom)
+		}
+	}
+
+	for _, denom := range denoms {
+		if _, found := k.UpdatePrice(ctx, denom); !found {
+			store.Delete(types.PriceStoreKey(denom))
+		}
+	}
+}
+
+// UpdatePrice computes the median of the prices of the given denom posted by the current oracles that are not stale,
+// and stores it as the price of the denom. If no such price exists, false is returned and the stored price is left
+// untouched
+func (k Keeper) UpdatePrice(ctx sdk.Context, denom string) (price types.Price, found bool) {
+	params := k.GetOracleParams(ctx)
+
+	var prices []sdk.Dec
+	for _, post := range k.GetPricePosts(ctx, denom) {
+		if params.IsOracle(post.Oracle) && !post.IsStale(ctx.BlockTime(), params.MaxPriceAge) {
+			prices = append(prices, post.Price)
+		}
+	}
+
+	if len(prices) == 0 {
+		return types.Price{}, false
+	}
+
+	price = types.NewPrice(denom, types.MedianPrice(prices), ctx.BlockTime())
+	k.SavePrice(ctx, price)
+	return price, true
 }
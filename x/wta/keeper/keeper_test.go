@@ -5,8 +5,10 @@ import (
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	wtatypes "github.com/cosmicbet/ledger/x/wta/types"
 )
@@ -85,10 +87,11 @@ func (suite *KeeperTestSuite) Test_WithdrawTicketsCost() {
 		suite.SetupTest()
 		suite.Run(uc.name, func() {
 			// Set the params
-			suite.keeper.SetDistributionParams(suite.ctx,
-				wtatypes.NewDistributionParams(uc.prizePercentage, uc.feePercentage, uc.burnPercentage))
-			suite.keeper.SetDrawParams(suite.ctx, wtatypes.NewDrawParams(1*time.Minute))
-			suite.keeper.SetTicketParams(suite.ctx, wtatypes.NewTicketParams(uc.ticketPrice))
+			suite.keeper.SetDistributionParams(suite.ctx, wtatypes.NewDistributionParams(
+				uc.prizePercentage, uc.feePercentage, uc.burnPercentage, wtatypes.FeeDestinationFeeCollector,
+			))
+			suite.keeper.SetDrawParams(suite.ctx, wtatypes.NewDrawParams(1*time.Minute, time.Minute, 0, false))
+			suite.keeper.SetTicketParams(suite.ctx, wtatypes.NewTicketParams(uc.ticketPrice, nil, sdk.ZeroDec(), 0, 0))
 
 			// Get the account
 			addr, err := sdk.AccAddressFromBech32(uc.accountAddress)
@@ -100,7 +103,10 @@ func (suite *KeeperTestSuite) Test_WithdrawTicketsCost() {
 			suite.Require().NoError(err)
 
 			// Buy the ticket
-			err = suite.keeper.WithdrawTicketsCost(suite.ctx, uc.quantity, addr)
+			pool, found := suite.keeper.GetPool(suite.ctx, wtatypes.DefaultPoolID)
+			suite.Require().True(found)
+
+			_, err = suite.keeper.WithdrawTicketsCost(suite.ctx, pool, 1, uc.quantity, pool.TicketParams.Price, addr)
 
 			if uc.shouldErr {
 				suite.Require().Error(err)
@@ -125,6 +131,224 @@ func (suite *KeeperTestSuite) Test_WithdrawTicketsCost() {
 	}
 }
 
+func (suite *KeeperTestSuite) Test_WithdrawTicketsCost_SpendingLimit() {
+	address := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	addr, err := sdk.AccAddressFromBech32(address)
+	suite.Require().NoError(err)
+
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+	blockTime := time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)
+	limitAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	usecases := []struct {
+		name          string
+		storedLimits  []wtatypes.SpendingLimit
+		storedRecords []wtatypes.SpendRecord
+		expErr        error
+		expRecords    []wtatypes.SpendRecord
+	}{
+		{
+			name:   "no spending limit",
+			expErr: nil,
+		},
+		{
+			name: "spending limit exceeded",
+			storedLimits: []wtatypes.SpendingLimit{
+				wtatypes.NewSpendingLimit(address, limitAmount, time.Hour, nil),
+			},
+			storedRecords: []wtatypes.SpendRecord{
+				wtatypes.NewSpendRecord(address, blockTime.Add(-time.Minute*30), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 80))),
+			},
+			expErr: wtatypes.ErrSpendingLimitReached,
+		},
+		{
+			name: "amounts spent outside the window are removed",
+			storedLimits: []wtatypes.SpendingLimit{
+				wtatypes.NewSpendingLimit(address, limitAmount, time.Hour, nil),
+			},
+			storedRecords: []wtatypes.SpendRecord{
+				wtatypes.NewSpendRecord(address, blockTime.Add(-time.Hour*2), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 80))),
+				wtatypes.NewSpendRecord(address, blockTime.Add(-time.Minute*30), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))),
+			},
+			expRecords: []wtatypes.SpendRecord{
+				wtatypes.NewSpendRecord(address, blockTime.Add(-time.Minute*30), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))),
+				wtatypes.NewSpendRecord(address, blockTime, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))),
+			},
+		},
+		{
+			name: "raised limit not effective yet",
+			storedLimits: []wtatypes.SpendingLimit{
+				wtatypes.NewSpendingLimit(address, limitAmount, time.Hour, wtatypes.NewPendingSpendingLimit(
+					sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), time.Hour, blockTime.Add(time.Minute),
+				)),
+			},
+			storedRecords: []wtatypes.SpendRecord{
+				wtatypes.NewSpendRecord(address, blockTime.Add(-time.Minute*30), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 80))),
+			},
+			expErr: wtatypes.ErrSpendingLimitReached,
+		},
+		{
+			name: "raised limit effective",
+			storedLimits: []wtatypes.SpendingLimit{
+				wtatypes.NewSpendingLimit(address, limitAmount, time.Hour, wtatypes.NewPendingSpendingLimit(
+					sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), time.Hour, blockTime.Add(-time.Minute),
+				)),
+			},
+			storedRecords: []wtatypes.SpendRecord{
+				wtatypes.NewSpendRecord(address, blockTime.Add(-time.Minute*30), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 80))),
+			},
+			expRecords: []wtatypes.SpendRecord{
+				wtatypes.NewSpendRecord(address, blockTime.Add(-time.Minute*30), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 80))),
+				wtatypes.NewSpendRecord(address, blockTime, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))),
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.ctx = suite.ctx.WithBlockTime(blockTime)
+			suite.keeper.SetTicketParams(suite.ctx, wtatypes.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0))
+			suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+			for _, l := range uc.storedLimits {
+				suite.keeper.SaveSpendingLimit(suite.ctx, l)
+			}
+			for _, r := range uc.storedRecords {
+				suite.keeper.SaveSpendRecord(suite.ctx, r)
+			}
+
+			pool, found := suite.keeper.GetPool(suite.ctx, wtatypes.DefaultPoolID)
+			suite.Require().True(found)
+
+			_, err := suite.keeper.WithdrawTicketsCost(suite.ctx, pool, 1, 3, pool.TicketParams.Price, addr)
+
+			if uc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, uc.expErr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expRecords, suite.keeper.GetSpendRecords(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_WithdrawTicketsCost_FeeDestination() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	totalFees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+
+	usecases := []struct {
+		name              string
+		destination       wtatypes.FeeDestination
+		expFeeCollector   sdk.Coins
+		expCommunityPool  sdk.DecCoins
+		expTreasury       sdk.Coins
+		expStakersRewards []sdk.DecCoins
+	}{
+		{
+			name:              "fee collector",
+			destination:       wtatypes.FeeDestinationFeeCollector,
+			expFeeCollector:   totalFees,
+			expCommunityPool:  nil,
+			expTreasury:       sdk.NewCoins(),
+			expStakersRewards: []sdk.DecCoins{nil, nil},
+		},
+		{
+			name:              "community pool",
+			destination:       wtatypes.FeeDestinationCommunityPool,
+			expFeeCollector:   sdk.NewCoins(),
+			expCommunityPool:  sdk.NewDecCoinsFromCoins(totalFees...),
+			expTreasury:       sdk.NewCoins(),
+			expStakersRewards: []sdk.DecCoins{nil, nil},
+		},
+		{
+			name:              "treasury",
+			destination:       wtatypes.FeeDestinationTreasury,
+			expFeeCollector:   sdk.NewCoins(),
+			expCommunityPool:  nil,
+			expTreasury:       totalFees,
+			expStakersRewards: []sdk.DecCoins{nil, nil},
+		},
+		{
+			name:             "stakers",
+			destination:      wtatypes.FeeDestinationStakers,
+			expFeeCollector:  sdk.NewCoins(),
+			expCommunityPool: nil,
+			expTreasury:      sdk.NewCoins(),
+			expStakersRewards: []sdk.DecCoins{
+				sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 15)),
+				sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 5)),
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SetDistributionParams(suite.ctx, wtatypes.NewDistributionParams(
+				sdk.NewDecWithPrec(95, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(3, 2), uc.destination,
+			))
+			suite.keeper.SetTicketParams(suite.ctx, wtatypes.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), nil, sdk.ZeroDec(), 0, 0))
+			suite.sk.SetParams(suite.ctx, stakingtypes.DefaultParams())
+
+			// Set the bonded validators, having 75% and 25% of the stake
+			var validators []stakingtypes.Validator
+			for _, tokens := range []int64{300, 100} {
+				pubKey := ed25519.GenPrivKey().PubKey()
+				validator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{})
+				suite.Require().NoError(err)
+
+				validator.Status = stakingtypes.Bonded
+				validator, _ = validator.AddTokensFromDel(sdk.NewInt(tokens))
+				suite.sk.SetValidator(suite.ctx, validator)
+				suite.sk.SetValidatorByPowerIndex(suite.ctx, validator)
+				validators = append(validators, validator)
+			}
+
+			bondedPool := suite.sk.GetBondedPool(suite.ctx)
+			bondedTokens := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+			suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance.Add(bondedTokens...)))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, bondedPool.GetAddress(), bondedTokens))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+			pool, found := suite.keeper.GetPool(suite.ctx, wtatypes.DefaultPoolID)
+			suite.Require().True(found)
+
+			// Buy the tickets twice to make sure the fees are accumulated
+			for i := 0; i < 2; i++ {
+				_, err := suite.keeper.WithdrawTicketsCost(suite.ctx, pool, 1, 5, pool.TicketParams.Price, addr)
+				suite.Require().NoError(err)
+			}
+			suite.Require().NoError(suite.keeper.AllocateStakersFees(suite.ctx))
+
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, feeCollector).IsEqual(uc.expFeeCollector))
+
+			treasury := authtypes.NewModuleAddress(wtatypes.TreasuryName)
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, treasury).IsEqual(uc.expTreasury))
+
+			stakersFees := authtypes.NewModuleAddress(wtatypes.StakersFeesName)
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, stakersFees).IsZero())
+
+			suite.Require().Equal(uc.expCommunityPool, suite.keeper.GetCommunityPool(suite.ctx))
+
+			for i, validator := range validators {
+				rewards := suite.dk.GetValidatorOutstandingRewards(suite.ctx, validator.GetOperator())
+				suite.Require().Equal(uc.expStakersRewards[i], rewards.Rewards)
+			}
+
+			fees, found := suite.keeper.GetDrawFees(suite.ctx, 1, uc.destination)
+			suite.Require().True(found)
+			suite.Require().Equal(wtatypes.NewDrawFees(1, uc.destination, totalFees), fees)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) Test_SaveTickets() {
 	usecases := []struct {
 		name    string
@@ -137,9 +361,9 @@ func (suite *KeeperTestSuite) Test_SaveTickets() {
 		{
 			name: "non empty tickets slice",
 			tickets: []wtatypes.Ticket{
-				wtatypes.NewTicket("1", time.Now(), "owner-1"),
-				wtatypes.NewTicket("2", time.Now(), "owner-2"),
-				wtatypes.NewTicket("3", time.Now(), "owner-3"),
+				wtatypes.NewTicket("1", 1, time.Now(), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("2", 1, time.Now(), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("3", 1, time.Now(), "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
 			},
 		},
 	}
@@ -159,25 +383,131 @@ func (suite *KeeperTestSuite) Test_SaveTickets() {
 			for _, ticket := range tickets {
 				suite.Require().Contains(tickets, ticket)
 			}
+
+			store := suite.ctx.KVStore(suite.storeKey)
+			for _, ticket := range uc.tickets {
+				suite.Require().Equal([]byte(ticket.Id), store.Get(wtatypes.OwnerTicketStoreKey(ticket.Owner, ticket.Id)))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_SaveTickets_OwnerIndex() {
+	suite.SetupTest()
+
+	ticket := wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil)
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{ticket})
+
+	// Changing the owner should move the index entry
+	ticket.Owner = "owner-2"
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{ticket})
+
+	store := suite.ctx.KVStore(suite.storeKey)
+	suite.Require().False(store.Has(wtatypes.OwnerTicketStoreKey("owner-1", ticket.Id)))
+	suite.Require().True(store.Has(wtatypes.OwnerTicketStoreKey("owner-2", ticket.Id)))
+
+	stored, found := suite.keeper.GetTicket(suite.ctx, ticket.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(ticket, stored)
+
+	_, found = suite.keeper.GetTicket(suite.ctx, "2")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) Test_SaveNewTickets() {
+	suite.SetupTest()
+
+	ticket := wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil)
+	suite.Require().NoError(suite.keeper.SaveNewTickets(suite.ctx, []wtatypes.Ticket{ticket}))
+
+	// Saving a new ticket having an existing id should fail
+	other := wtatypes.NewTicket("1", 2, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil)
+	suite.Require().ErrorIs(suite.keeper.SaveNewTickets(suite.ctx, []wtatypes.Ticket{other}), wtatypes.ErrTicketExists)
+
+	stored, found := suite.keeper.GetTicket(suite.ctx, ticket.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(ticket, stored)
+}
+
+func (suite *KeeperTestSuite) Test_SaveTickets_DrawChange() {
+	suite.SetupTest()
+
+	first := wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil)
+	second := wtatypes.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil)
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{first, second})
+
+	// Moving a ticket to another draw should remove it from the positions of the previous one
+	first.DrawId = 2
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{first})
+
+	for drawID, expTickets := range map[uint64][]wtatypes.Ticket{1: {second}, 2: {first}} {
+		var tickets []wtatypes.Ticket
+		suite.keeper.IterateDrawTickets(suite.ctx, drawID, func(_ uint32, ticket wtatypes.Ticket) (stop bool) {
+			tickets = append(tickets, ticket)
+			return false
 		})
+		suite.Require().Equal(expTickets, tickets)
+		suite.Require().Equal(uint32(1), suite.keeper.GetDrawTicketsCount(suite.ctx, drawID))
 	}
+
+	_, found := suite.keeper.GetDrawTicketAt(suite.ctx, 1, 0)
+	suite.Require().False(found)
 }
 
-func (suite *KeeperTestSuite) Test_WipeCurrentTickets() {
+func (suite *KeeperTestSuite) Test_PruneDrawTickets() {
 	usecases := []struct {
 		name          string
 		storedTickets []wtatypes.Ticket
+		drawID        uint64
+		limit         uint32
+		expPruned     uint32
+		expTickets    []wtatypes.Ticket
 	}{
 		{
 			name:          "empty storage",
 			storedTickets: nil,
+			drawID:        1,
+			limit:         10,
+			expPruned:     0,
+			expTickets:    nil,
 		},
 		{
-			name: "non empty storage",
+			name: "all tickets are removed",
 			storedTickets: []wtatypes.Ticket{
-				wtatypes.NewTicket("1", time.Now(), "owner-1"),
-				wtatypes.NewTicket("2", time.Now(), "owner-2"),
-				wtatypes.NewTicket("3", time.Now(), "owner-3"),
+				wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			drawID:     1,
+			limit:      10,
+			expPruned:  3,
+			expTickets: nil,
+		},
+		{
+			name: "only the first tickets are removed when exceeding the limit",
+			storedTickets: []wtatypes.Ticket{
+				wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			drawID:    1,
+			limit:     2,
+			expPruned: 2,
+			expTickets: []wtatypes.Ticket{
+				wtatypes.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+		},
+		{
+			name: "tickets of other draws are not removed",
+			storedTickets: []wtatypes.Ticket{
+				wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("2", 2, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			drawID:    1,
+			limit:     10,
+			expPruned: 1,
+			expTickets: []wtatypes.Ticket{
+				wtatypes.NewTicket("2", 2, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
 			},
 		},
 	}
@@ -188,9 +518,430 @@ func (suite *KeeperTestSuite) Test_WipeCurrentTickets() {
 			suite.keeper.SaveTickets(suite.ctx, uc.storedTickets)
 			suite.Require().Len(suite.keeper.GetTickets(suite.ctx), len(uc.storedTickets))
 
-			suite.keeper.WipeCurrentTickets(suite.ctx)
+			pruned := suite.keeper.PruneDrawTickets(suite.ctx, uc.drawID, uc.limit)
+			suite.Require().Equal(uc.expPruned, pruned)
+			suite.Require().Equal(uc.expTickets, suite.keeper.GetTickets(suite.ctx))
+
+			// Make sure the owner index is kept in sync with the remaining tickets
+			store := suite.ctx.KVStore(suite.storeKey)
+			for _, ticket := range uc.storedTickets {
+				_, found := suite.keeper.GetTicket(suite.ctx, ticket.Id)
+				suite.Require().Equal(found, store.Has(wtatypes.OwnerTicketStoreKey(ticket.Owner, ticket.Id)))
+			}
+
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_PruneTickets() {
+	suite.SetupTest()
+	suite.keeper.SetPruningParams(suite.ctx, wtatypes.NewPruningParams(3))
+
+	date := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{
+		wtatypes.NewTicket("1", 1, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("2", 1, date, "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("3", 2, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("4", 2, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("5", 3, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+	})
+
+	suite.keeper.EnqueueDrawPruning(suite.ctx, 2)
+	suite.keeper.EnqueueDrawPruning(suite.ctx, 1)
+	suite.keeper.EnqueueDrawPruning(suite.ctx, 4)
+	suite.Require().Equal([]wtatypes.PruningDraw{
+		wtatypes.NewPruningDraw(1, 2),
+		wtatypes.NewPruningDraw(2, 2),
+	}, suite.keeper.GetPruningQueue(suite.ctx))
+
+	// The counters are removed as soon as the draw is enqueued
+	suite.Require().Zero(suite.keeper.GetDrawTicketsCount(suite.ctx, 1))
+	suite.Require().Zero(suite.keeper.GetDrawParticipantsCount(suite.ctx, 2))
+
+	suite.keeper.PruneTickets(suite.ctx)
+	suite.Require().Equal([]wtatypes.PruningDraw{
+		wtatypes.NewPruningDraw(2, 1),
+	}, suite.keeper.GetPruningQueue(suite.ctx))
+	suite.Require().Len(suite.keeper.GetTickets(suite.ctx), 2)
+
+	suite.keeper.PruneTickets(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetPruningQueue(suite.ctx))
+	suite.Require().Equal([]wtatypes.Ticket{
+		wtatypes.NewTicket("5", 3, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+	}, suite.keeper.GetTickets(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) Test_MoveDrawTickets() {
+	usecases := []struct {
+		name          string
+		storedTickets []wtatypes.Ticket
+		limit         uint32
+		expMoved      uint32
+		expTickets    []wtatypes.Ticket
+	}{
+		{
+			name:          "empty storage",
+			storedTickets: nil,
+			limit:         10,
+			expMoved:      0,
+			expTickets:    nil,
+		},
+		{
+			name: "at most limit tickets are moved starting from the first position",
+			storedTickets: []wtatypes.Ticket{
+				wtatypes.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+			limit:    1,
+			expMoved: 1,
+			expTickets: []wtatypes.Ticket{
+				wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("2", 3, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+			},
+		},
+		{
+			name: "tickets of other draws are not moved",
+			storedTickets: []wtatypes.Ticket{
+				wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("2", 2, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-3", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 2}),
+			},
+			limit:    10,
+			expMoved: 2,
+			expTickets: []wtatypes.Ticket{
+				wtatypes.NewTicket("2", 2, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("1", 3, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+				wtatypes.NewTicket("3", 3, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-3", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 2}),
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveTickets(suite.ctx, uc.storedTickets)
+
+			moved := suite.keeper.MoveDrawTickets(suite.ctx, 1, 3, uc.limit)
+			suite.Require().Equal(uc.expMoved, moved)
+			suite.Require().Equal(uc.expTickets, suite.keeper.GetTickets(suite.ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MoveTickets() {
+	suite.SetupTest()
+	suite.keeper.SetPruningParams(suite.ctx, wtatypes.NewPruningParams(3))
+
+	date := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{
+		wtatypes.NewTicket("1", 1, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("2", 1, date, "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("3", 2, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("4", 2, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("5", 3, date, "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+	})
+
+	suite.keeper.EnqueueDrawTicketsMove(suite.ctx, 2, 5)
+	suite.keeper.EnqueueDrawTicketsMove(suite.ctx, 1, 3)
+	suite.keeper.EnqueueDrawTicketsMove(suite.ctx, 4, 6)
+	suite.Require().Equal([]wtatypes.TicketsMove{
+		wtatypes.NewTicketsMove(1, 3),
+		wtatypes.NewTicketsMove(2, 5),
+	}, suite.keeper.GetTicketsMoveQueue(suite.ctx))
+	suite.Require().True(suite.keeper.HasMovingTickets(suite.ctx, 3))
+	suite.Require().False(suite.keeper.HasMovingTickets(suite.ctx, 6))
+
+	suite.keeper.MoveTickets(suite.ctx)
+	suite.Require().Equal([]wtatypes.TicketsMove{
+		wtatypes.NewTicketsMove(2, 5),
+	}, suite.keeper.GetTicketsMoveQueue(suite.ctx))
+	suite.Require().False(suite.keeper.HasMovingTickets(suite.ctx, 3))
+	suite.Require().Equal(uint32(3), suite.keeper.GetDrawTicketsCount(suite.ctx, 3))
+	suite.Require().Equal(uint32(1), suite.keeper.GetDrawTicketsCount(suite.ctx, 2))
+	suite.Require().Equal(uint32(1), suite.keeper.GetDrawTicketsCount(suite.ctx, 5))
+
+	suite.keeper.MoveTickets(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetTicketsMoveQueue(suite.ctx))
+	suite.Require().Zero(suite.keeper.GetDrawTicketsCount(suite.ctx, 2))
+	suite.Require().Equal(uint32(2), suite.keeper.GetDrawTicketsCount(suite.ctx, 5))
+
+	// Moved tickets are added after the ones already part of the new draw
+	var ids []string
+	suite.keeper.IterateDrawTickets(suite.ctx, 3, func(_ uint32, ticket wtatypes.Ticket) (stop bool) {
+		ids = append(ids, ticket.Id)
+		return false
+	})
+	suite.Require().Equal([]string{"5", "1", "2"}, ids)
+}
+
+func (suite *KeeperTestSuite) Test_RefundDrawTickets() {
+	pool := wtatypes.NewPool(
+		1,
+		wtatypes.NewDistributionParams(sdk.NewDecWithPrec(50, 2), sdk.NewDecWithPrec(30, 2), sdk.NewDecWithPrec(20, 2), wtatypes.FeeDestinationFeeCollector),
+		wtatypes.NewDrawParams(time.Hour, time.Minute, 0, false),
+		wtatypes.NewTicketParams(sdk.NewInt64Coin("stake", 10), sdk.NewCoins(sdk.NewInt64Coin("atom", 4)), sdk.ZeroDec(), 0, 0),
+		wtatypes.DefaultPrizeParams(),
+		wtatypes.DefaultGameParams(),
+		wtatypes.DefaultRolloverParams(),
+	)
+
+	firstOwner := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	secondOwner := "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"
+	tickets := []wtatypes.Ticket{
+		withPrizeShare(wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), firstOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+		withPrizeShare(wtatypes.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), firstOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+		withPrizeShare(wtatypes.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), secondOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+		withPrizeShare(wtatypes.NewTicket("4", 2, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), secondOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+	}
+
+	usecases := []struct {
+		name             string
+		tickets          []wtatypes.Ticket
+		prize            sdk.Coins
+		expFirstBalance  sdk.Coins
+		expSecondBalance sdk.Coins
+		expPrize         sdk.Coins
+	}{
+		{
+			name:             "prize part of the tickets cost is refunded",
+			tickets:          tickets,
+			prize:            sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expFirstBalance:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			expSecondBalance: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+			expPrize:         sdk.NewCoins(sdk.NewInt64Coin("stake", 85)),
+		},
+		{
+			name:             "refunds are limited by the prize",
+			tickets:          tickets,
+			prize:            sdk.NewCoins(sdk.NewInt64Coin("stake", 12)),
+			expFirstBalance:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			expSecondBalance: sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+			expPrize:         sdk.NewCoins(),
+		},
+		{
+			name: "tickets are refunded in the denominations used to buy them",
+			tickets: []wtatypes.Ticket{
+				withPrizeShare(wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), firstOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+				withPrizeShare(wtatypes.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), firstOwner, "", sdk.NewInt64Coin("atom", 4), nil), sdk.NewInt64Coin("atom", 2)),
+				withPrizeShare(wtatypes.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), secondOwner, "", sdk.NewInt64Coin("atom", 4), nil), sdk.NewInt64Coin("atom", 2)),
+			},
+			prize:            sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 3)),
+			expFirstBalance:  sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("atom", 2)),
+			expSecondBalance: sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			expPrize:         sdk.NewCoins(sdk.NewInt64Coin("stake", 95)),
+		},
+		{
+			name: "tickets bought on behalf of another address are refunded to the payer",
+			tickets: []wtatypes.Ticket{
+				withPrizeShare(wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), firstOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+				withPrizeShare(wtatypes.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), secondOwner, firstOwner, sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+			},
+			prize:            sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expFirstBalance:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			expSecondBalance: sdk.NewCoins(),
+			expPrize:         sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+		},
+		{
+			name: "stored prize share is refunded regardless of the current prize percentage",
+			tickets: []wtatypes.Ticket{
+				withPrizeShare(wtatypes.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), firstOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 8)),
+				withPrizeShare(wtatypes.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), secondOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 3)),
+			},
+			prize:            sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expFirstBalance:  sdk.NewCoins(sdk.NewInt64Coin("stake", 8)),
+			expSecondBalance: sdk.NewCoins(sdk.NewInt64Coin("stake", 3)),
+			expPrize:         sdk.NewCoins(sdk.NewInt64Coin("stake", 89)),
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveTickets(suite.ctx, uc.tickets)
+
+			collector := wtatypes.PoolPrizeCollectorAddress(pool.Id)
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, collector, uc.prize))
+
+			refunded, err := suite.keeper.RefundDrawTickets(suite.ctx, pool, 1)
+			suite.Require().NoError(err)
+			suite.Require().True(refunded.IsEqual(uc.prize.Sub(uc.expPrize)))
+
+			firstAddr, err := sdk.AccAddressFromBech32(firstOwner)
+			suite.Require().NoError(err)
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, firstAddr).IsEqual(uc.expFirstBalance))
+
+			secondAddr, err := sdk.AccAddressFromBech32(secondOwner)
+			suite.Require().NoError(err)
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, secondAddr).IsEqual(uc.expSecondBalance))
+
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, collector).IsEqual(uc.expPrize))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_CancelCurrentDraw_MovingTickets() {
+	suite.SetupTest()
+	suite.keeper.SaveCurrentDrawID(suite.ctx, wtatypes.DefaultPoolID, 2)
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, wtatypes.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+	suite.keeper.SetNextDrawID(suite.ctx, 3)
+
+	firstOwner := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	secondOwner := "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"
+	date := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{
+		withPrizeShare(wtatypes.NewTicket("1", 1, date, firstOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+		withPrizeShare(wtatypes.NewTicket("2", 2, date, secondOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+	})
+	suite.keeper.EnqueueDrawTicketsMove(suite.ctx, 1, 2)
+
+	pool, _ := suite.keeper.GetPool(suite.ctx, wtatypes.DefaultPoolID)
+	collector := wtatypes.PoolPrizeCollectorAddress(pool.Id)
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, collector, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+
+	// The tickets still being moved into the cancelled draw are refunded and pruned along with its own tickets
+	refunded, err := suite.keeper.CancelCurrentDraw(suite.ctx, pool, wtatypes.DrawStatusCancelled, 0)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.keeper.GetTicketsMoveQueue(suite.ctx))
+	suite.Require().Equal([]wtatypes.PruningDraw{
+		wtatypes.NewPruningDraw(1, 1),
+		wtatypes.NewPruningDraw(2, 1),
+	}, suite.keeper.GetPruningQueue(suite.ctx))
+
+	for _, owner := range []string{firstOwner, secondOwner} {
+		addr, err := sdk.AccAddressFromBech32(owner)
+		suite.Require().NoError(err)
+		suite.Require().False(suite.bk.GetAllBalances(suite.ctx, addr).IsZero())
+	}
+	suite.Require().True(refunded.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)).Sub(
+		suite.bk.GetAllBalances(suite.ctx, collector))))
+}
+
+func (suite *KeeperTestSuite) Test_GetPool() {
+	pool := wtatypes.NewPool(
+		1,
+		wtatypes.NewDistributionParams(sdk.NewDecWithPrec(50, 2), sdk.NewDecWithPrec(30, 2), sdk.NewDecWithPrec(20, 2), wtatypes.FeeDestinationFeeCollector),
+		wtatypes.NewDrawParams(time.Hour, time.Minute, 0, false),
+		wtatypes.NewTicketParams(sdk.NewInt64Coin("stake", 5), nil, sdk.ZeroDec(), 0, 0),
+		wtatypes.DefaultPrizeParams(),
+		wtatypes.DefaultGameParams(),
+		wtatypes.DefaultRolloverParams(),
+	)
+
+	usecases := []struct {
+		name     string
+		stored   []wtatypes.Pool
+		id       uint64
+		expFound bool
+		expPool  wtatypes.Pool
+	}{
+		{
+			name:     "default pool is built from the params",
+			stored:   nil,
+			id:       wtatypes.DefaultPoolID,
+			expFound: true,
+			expPool: wtatypes.NewPool(
+				wtatypes.DefaultPoolID,
+				wtatypes.DefaultDistributionParams(),
+				wtatypes.DefaultDrawParams(),
+				wtatypes.DefaultTicketParams(),
+				wtatypes.DefaultPrizeParams(),
+				wtatypes.DefaultGameParams(),
+				wtatypes.DefaultRolloverParams(),
+			),
+		},
+		{
+			name:     "missing pool",
+			stored:   nil,
+			id:       1,
+			expFound: false,
+		},
+		{
+			name:     "stored pool",
+			stored:   []wtatypes.Pool{pool},
+			id:       1,
+			expFound: true,
+			expPool:  pool,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			for _, p := range uc.stored {
+				suite.keeper.SavePool(suite.ctx, p)
+			}
+
+			stored, found := suite.keeper.GetPool(suite.ctx, uc.id)
+			suite.Require().Equal(uc.expFound, found)
+			if uc.expFound {
+				suite.Require().Equal(uc.expPool, stored)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_NextDrawID() {
+	suite.SetupTest()
+	suite.Require().Equal(uint64(1), suite.keeper.GetNextDrawID(suite.ctx))
+
+	suite.keeper.SetNextDrawID(suite.ctx, 10)
+	suite.Require().Equal(uint64(10), suite.keeper.GetNextDrawID(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) Test_AssignNextDrawID() {
+	upcomingPrize := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	usecases := []struct {
+		name            string
+		reserve         bool
+		expDrawID       uint64
+		expNextDrawID   uint64
+		expPrizeBalance sdk.Coins
+	}{
+		{
+			name:            "without an upcoming draw",
+			reserve:         false,
+			expDrawID:       2,
+			expNextDrawID:   3,
+			expPrizeBalance: sdk.NewCoins(),
+		},
+		{
+			name:            "with an upcoming draw",
+			reserve:         true,
+			expDrawID:       2,
+			expNextDrawID:   3,
+			expPrizeBalance: upcomingPrize,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawID(suite.ctx, wtatypes.DefaultPoolID, 1)
+			suite.keeper.SetNextDrawID(suite.ctx, 2)
 
-			suite.Require().Empty(suite.keeper.GetTickets(suite.ctx))
+			if uc.reserve {
+				suite.Require().Equal(uint64(2), suite.keeper.ReserveUpcomingDrawID(suite.ctx, wtatypes.DefaultPoolID))
+				suite.Require().Equal(uint64(2), suite.keeper.ReserveUpcomingDrawID(suite.ctx, wtatypes.DefaultPoolID))
+
+				upcomingCollector := wtatypes.PoolUpcomingPrizeCollectorAddress(wtatypes.DefaultPoolID)
+				suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(upcomingPrize))
+				suite.Require().NoError(suite.bk.SetBalances(suite.ctx, upcomingCollector, upcomingPrize))
+			}
+
+			drawID := suite.keeper.AssignNextDrawID(suite.ctx, wtatypes.DefaultPoolID)
+			suite.Require().Equal(uc.expDrawID, drawID)
+			suite.Require().Equal(uc.expDrawID, suite.keeper.GetCurrentDrawID(suite.ctx, wtatypes.DefaultPoolID))
+			suite.Require().Equal(uc.expNextDrawID, suite.keeper.GetNextDrawID(suite.ctx))
+
+			_, found := suite.keeper.GetUpcomingDrawID(suite.ctx, wtatypes.DefaultPoolID)
+			suite.Require().False(found)
+
+			upcomingCollector := wtatypes.PoolUpcomingPrizeCollectorAddress(wtatypes.DefaultPoolID)
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, upcomingCollector).IsZero())
+
+			collector := wtatypes.PoolPrizeCollectorAddress(wtatypes.DefaultPoolID)
+			suite.Require().Equal(uc.expPrizeBalance, suite.bk.GetAllBalances(suite.ctx, collector))
 		})
 	}
 }
@@ -234,7 +985,7 @@ func (suite *KeeperTestSuite) Test_TransferDrawPrize() {
 			suite.Require().NoError(err)
 
 			// Transfer prize
-			err = suite.keeper.TransferDrawPrize(suite.ctx, uc.prize, addr)
+			err = suite.keeper.TransferDrawPrize(suite.ctx, wtatypes.DefaultPoolID, uc.prize, addr)
 			suite.Require().NoError(err)
 
 			// Check balances
@@ -247,6 +998,62 @@ func (suite *KeeperTestSuite) Test_TransferDrawPrize() {
 	}
 }
 
+func (suite *KeeperTestSuite) Test_FundPrizeFromTreasury() {
+	usecases := []struct {
+		name        string
+		poolID      uint64
+		treasury    sdk.Coins
+		amount      sdk.Coins
+		shouldErr   bool
+		expTreasury sdk.Coins
+	}{
+		{
+			name:        "insufficient treasury returns error",
+			poolID:      wtatypes.DefaultPoolID,
+			treasury:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			shouldErr:   true,
+			expTreasury: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		},
+		{
+			name:        "default pool is funded properly",
+			poolID:      wtatypes.DefaultPoolID,
+			treasury:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			shouldErr:   false,
+			expTreasury: sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+		},
+		{
+			name:        "other pools are funded properly",
+			poolID:      1,
+			treasury:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 10)),
+			amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 5)),
+			shouldErr:   false,
+			expTreasury: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5)),
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			treasury := suite.ak.GetModuleAccount(suite.ctx, wtatypes.TreasuryName)
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, treasury.GetAddress(), uc.treasury))
+
+			err := suite.keeper.FundPrizeFromTreasury(suite.ctx, uc.poolID, uc.amount)
+			suite.Require().True(uc.expTreasury.IsEqual(suite.keeper.GetTreasury(suite.ctx)))
+
+			collector := wtatypes.PoolPrizeCollectorAddress(uc.poolID)
+			if uc.shouldErr {
+				suite.Require().Error(err)
+				suite.Require().True(suite.bk.GetAllBalances(suite.ctx, collector).IsZero())
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().True(suite.bk.GetAllBalances(suite.ctx, collector).IsEqual(uc.amount))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) Test_SaveCurrentDrawEndTime() {
 	usecases := []struct {
 		name     string
@@ -269,16 +1076,135 @@ func (suite *KeeperTestSuite) Test_SaveCurrentDrawEndTime() {
 		suite.SetupTest()
 		suite.Run(uc.name, func() {
 			if !uc.existing.IsZero() {
-				suite.keeper.SaveCurrentDrawEndTime(suite.ctx, uc.existing)
-				suite.Require().True(suite.keeper.GetCurrentDraw(suite.ctx).EndTime.Equal(uc.existing))
+				suite.keeper.SaveCurrentDrawEndTime(suite.ctx, wtatypes.DefaultPoolID, uc.existing)
+				suite.Require().True(suite.keeper.GetCurrentDraw(suite.ctx, wtatypes.DefaultPoolID).EndTime.Equal(uc.existing))
 			}
 
-			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, uc.toSave)
-			suite.Require().True(suite.keeper.GetCurrentDraw(suite.ctx).EndTime.Equal(uc.toSave))
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, wtatypes.DefaultPoolID, uc.toSave)
+			suite.Require().True(suite.keeper.GetCurrentDraw(suite.ctx, wtatypes.DefaultPoolID).EndTime.Equal(uc.toSave))
 		})
 	}
 }
 
+func (suite *KeeperTestSuite) Test_CurrentDrawRollovers() {
+	suite.SetupTest()
+	suite.Require().Equal(uint32(0), suite.keeper.GetCurrentDrawRollovers(suite.ctx, 1))
+
+	suite.keeper.SaveCurrentDrawRollovers(suite.ctx, 1, 3)
+	suite.Require().Equal(uint32(3), suite.keeper.GetCurrentDrawRollovers(suite.ctx, 1))
+	suite.Require().Equal(uint32(0), suite.keeper.GetCurrentDrawRollovers(suite.ctx, wtatypes.DefaultPoolID))
+}
+
+func (suite *KeeperTestSuite) Test_DrawCounters() {
+	suite.SetupTest()
+
+	date := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{
+		wtatypes.NewTicket("1", 1, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("2", 1, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("3", 1, date, "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("4", 2, date, "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+	})
+
+	suite.Require().Equal(uint32(3), suite.keeper.GetDrawTicketsCount(suite.ctx, 1))
+	suite.Require().Equal(uint32(2), suite.keeper.GetDrawParticipantsCount(suite.ctx, 1))
+	suite.Require().Equal(uint32(2), suite.keeper.GetDrawOwnerTicketsCount(suite.ctx, 1, "owner-1"))
+	suite.Require().Equal(uint32(1), suite.keeper.GetDrawOwnerTicketsCount(suite.ctx, 1, "owner-2"))
+	suite.Require().Equal(uint32(0), suite.keeper.GetDrawOwnerTicketsCount(suite.ctx, 1, "owner-3"))
+
+	// Saving an existing ticket again should not change the counters
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{wtatypes.NewTicket("1", 1, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil)})
+	suite.Require().Equal(uint32(3), suite.keeper.GetDrawTicketsCount(suite.ctx, 1))
+	suite.Require().Equal(uint32(2), suite.keeper.GetDrawParticipantsCount(suite.ctx, 1))
+
+	// Changing the owner of a ticket should move it between the owners counters
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{wtatypes.NewTicket("3", 1, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil)})
+	suite.Require().Equal(uint32(3), suite.keeper.GetDrawTicketsCount(suite.ctx, 1))
+	suite.Require().Equal(uint32(1), suite.keeper.GetDrawParticipantsCount(suite.ctx, 1))
+	suite.Require().Equal(uint32(3), suite.keeper.GetDrawOwnerTicketsCount(suite.ctx, 1, "owner-1"))
+	suite.Require().Equal(uint32(0), suite.keeper.GetDrawOwnerTicketsCount(suite.ctx, 1, "owner-2"))
+
+	// Moving the tickets should move the counters as well
+	suite.keeper.MoveDrawTickets(suite.ctx, 1, 3, 10)
+	suite.Require().Equal(uint32(0), suite.keeper.GetDrawTicketsCount(suite.ctx, 1))
+	suite.Require().Equal(uint32(0), suite.keeper.GetDrawParticipantsCount(suite.ctx, 1))
+	suite.Require().Equal(uint32(3), suite.keeper.GetDrawTicketsCount(suite.ctx, 3))
+	suite.Require().Equal(uint32(1), suite.keeper.GetDrawParticipantsCount(suite.ctx, 3))
+
+	// Pruning the tickets should reset the counters, without touching the other draws
+	suite.keeper.EnqueueDrawPruning(suite.ctx, 3)
+	suite.Require().Equal(uint32(0), suite.keeper.GetDrawTicketsCount(suite.ctx, 3))
+	suite.Require().Equal(uint32(0), suite.keeper.GetDrawParticipantsCount(suite.ctx, 3))
+	suite.keeper.PruneTickets(suite.ctx)
+	suite.Require().Equal(uint32(0), suite.keeper.GetDrawOwnerTicketsCount(suite.ctx, 3, "owner-1"))
+	suite.Require().Equal(uint32(1), suite.keeper.GetDrawTicketsCount(suite.ctx, 2))
+	suite.Require().Equal(uint32(1), suite.keeper.GetDrawParticipantsCount(suite.ctx, 2))
+}
+
+func (suite *KeeperTestSuite) Test_DrawTicketsTree() {
+	suite.SetupTest()
+
+	date := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	tickets := []wtatypes.Ticket{
+		wtatypes.NewTicket("c", 1, date, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("a", 1, date, "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+		wtatypes.NewTicket("b", 1, date, "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+	}
+	suite.keeper.SaveTickets(suite.ctx, tickets[:2])
+	suite.keeper.SaveTickets(suite.ctx, tickets[2:])
+
+	// Tickets should be sorted by the order in which they have been saved
+	for i, ticket := range tickets {
+		stored, found := suite.keeper.GetDrawTicketAt(suite.ctx, 1, uint32(i))
+		suite.Require().True(found)
+		suite.Require().Equal(ticket, stored)
+	}
+	_, found := suite.keeper.GetDrawTicketAt(suite.ctx, 1, 3)
+	suite.Require().False(found)
+
+	root, proofs := computeTicketsMerkleProofs(tickets)
+	suite.Require().Equal(root, suite.keeper.GetDrawTicketsRoot(suite.ctx, 1))
+	for i, proof := range proofs {
+		suite.Require().Equal(proof.Aunts, suite.keeper.GetDrawTicketProof(suite.ctx, 1, uint32(i)))
+	}
+
+	// Changing the owner of a ticket should keep its position and update the root
+	tickets[1].Owner = "owner-4"
+	suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{tickets[1]})
+	root, _ = computeTicketsMerkleProofs(tickets)
+	suite.Require().Equal(root, suite.keeper.GetDrawTicketsRoot(suite.ctx, 1))
+
+	// Moving the tickets should keep their order
+	suite.keeper.MoveDrawTickets(suite.ctx, 1, 2, 3)
+	for i := range tickets {
+		tickets[i].DrawId = 2
+	}
+	root, _ = computeTicketsMerkleProofs(tickets)
+	suite.Require().Equal(root, suite.keeper.GetDrawTicketsRoot(suite.ctx, 2))
+	_, found = suite.keeper.GetDrawTicketAt(suite.ctx, 1, 0)
+	suite.Require().False(found)
+
+	var positions []uint32
+	suite.keeper.IterateDrawTickets(suite.ctx, 2, func(position uint32, ticket wtatypes.Ticket) (stop bool) {
+		suite.Require().Equal(tickets[position], ticket)
+		positions = append(positions, position)
+		return false
+	})
+	suite.Require().Equal([]uint32{0, 1, 2}, positions)
+
+	// Pruning the tickets should remove the tree as well
+	suite.keeper.PruneDrawTickets(suite.ctx, 2, 3)
+	store := suite.ctx.KVStore(suite.storeKey)
+	for _, prefix := range [][]byte{
+		wtatypes.DrawTicketsStorePrefix, wtatypes.TicketPositionsStorePrefix, wtatypes.DrawTicketsTreeStorePrefix,
+		wtatypes.DrawOwnerTicketsStorePrefix,
+	} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		suite.Require().False(iterator.Valid())
+		iterator.Close()
+	}
+}
+
 func (suite *KeeperTestSuite) Test_GetCurrentDraw() {
 	usecases := []struct {
 		name        string
@@ -293,6 +1219,8 @@ func (suite *KeeperTestSuite) Test_GetCurrentDraw() {
 			prize:       sdk.NewCoins(),
 			tickets:     nil,
 			expDraw: wtatypes.NewDraw(
+				1,
+				wtatypes.DefaultPoolID,
 				0,
 				0,
 				sdk.NewCoins(),
@@ -306,21 +1234,35 @@ func (suite *KeeperTestSuite) Test_GetCurrentDraw() {
 			tickets: []wtatypes.Ticket{
 				wtatypes.NewTicket(
 					"ticket-1",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				wtatypes.NewTicket(
 					"ticket-2",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 				wtatypes.NewTicket(
 					"ticket-3",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"owner-2",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 			},
 			expDraw: wtatypes.NewDraw(
+				1,
+				wtatypes.DefaultPoolID,
 				2,
 				3,
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
@@ -335,7 +1277,7 @@ func (suite *KeeperTestSuite) Test_GetCurrentDraw() {
 			suite.SaveDrawData(suite.ctx, uc.drawEndTime, uc.prize)
 			suite.keeper.SaveTickets(suite.ctx, uc.tickets)
 
-			stored := suite.keeper.GetCurrentDraw(suite.ctx)
+			stored := suite.keeper.GetCurrentDraw(suite.ctx, wtatypes.DefaultPoolID)
 			suite.Require().True(stored.Equal(uc.expDraw))
 		})
 	}
@@ -353,6 +1295,8 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 			existing: nil,
 			toStore: wtatypes.NewHistoricalDrawData(
 				wtatypes.NewDraw(
+					1,
+					wtatypes.DefaultPoolID,
 					1,
 					1,
 					sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -360,13 +1304,27 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 				),
 				wtatypes.NewTicket(
 					"winning-ticket",
+					1,
 					time.Date(2019, 12, 31, 23, 50, 60, 000, time.UTC),
 					"winner",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
+				nil,
+				nil,
+				0,
+				nil,
+				nil,
+				nil,
+				0,
+				0,
 			),
 			expStored: []wtatypes.HistoricalDrawData{
 				wtatypes.NewHistoricalDrawData(
 					wtatypes.NewDraw(
+						1,
+						wtatypes.DefaultPoolID,
 						1,
 						1,
 						sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -374,9 +1332,21 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 					),
 					wtatypes.NewTicket(
 						"winning-ticket",
+						1,
 						time.Date(2019, 12, 31, 23, 50, 60, 000, time.UTC),
 						"winner",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
+					nil,
+					nil,
+					0,
+					nil,
+					nil,
+					nil,
+					0,
+					0,
 				),
 			},
 		},
@@ -384,6 +1354,8 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 			name: "overwrite existing data",
 			existing: &wtatypes.HistoricalDrawData{
 				Draw: wtatypes.NewDraw(
+					1,
+					wtatypes.DefaultPoolID,
 					1,
 					1,
 					sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -391,12 +1363,18 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 				),
 				WinningTicket: wtatypes.NewTicket(
 					"winning-ticket-1",
+					1,
 					time.Date(2019, 12, 31, 23, 50, 60, 000, time.UTC),
 					"winner-1",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 			},
 			toStore: wtatypes.NewHistoricalDrawData(
 				wtatypes.NewDraw(
+					1,
+					wtatypes.DefaultPoolID,
 					1,
 					1,
 					sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -404,13 +1382,27 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 				),
 				wtatypes.NewTicket(
 					"winning-ticket-2",
+					1,
 					time.Date(2019, 12, 31, 23, 50, 60, 000, time.UTC),
 					"winner-2",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
+				nil,
+				nil,
+				0,
+				nil,
+				nil,
+				nil,
+				0,
+				0,
 			),
 			expStored: []wtatypes.HistoricalDrawData{
 				wtatypes.NewHistoricalDrawData(
 					wtatypes.NewDraw(
+						1,
+						wtatypes.DefaultPoolID,
 						1,
 						1,
 						sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -418,9 +1410,21 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 					),
 					wtatypes.NewTicket(
 						"winning-ticket-2",
+						1,
 						time.Date(2019, 12, 31, 23, 50, 60, 000, time.UTC),
 						"winner-2",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
+					nil,
+					nil,
+					0,
+					nil,
+					nil,
+					nil,
+					0,
+					0,
 				),
 			},
 		},
@@ -428,6 +1432,8 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 			name: "adding new data",
 			existing: &wtatypes.HistoricalDrawData{
 				Draw: wtatypes.NewDraw(
+					1,
+					wtatypes.DefaultPoolID,
 					1,
 					1,
 					sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -435,12 +1441,18 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 				),
 				WinningTicket: wtatypes.NewTicket(
 					"winning-ticket",
+					1,
 					time.Date(2019, 12, 31, 23, 50, 60, 000, time.UTC),
 					"winner",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
 			},
 			toStore: wtatypes.NewHistoricalDrawData(
 				wtatypes.NewDraw(
+					2,
+					wtatypes.DefaultPoolID,
 					10,
 					100,
 					sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
@@ -448,13 +1460,27 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 				),
 				wtatypes.NewTicket(
 					"winning-ticket-2",
+					2,
 					time.Date(2020, 12, 31, 23, 50, 60, 000, time.UTC),
 					"winner-2",
+					"",
+					sdk.NewInt64Coin("stake", 10),
+					nil,
 				),
+				nil,
+				nil,
+				0,
+				nil,
+				nil,
+				nil,
+				0,
+				0,
 			),
 			expStored: []wtatypes.HistoricalDrawData{
 				wtatypes.NewHistoricalDrawData(
 					wtatypes.NewDraw(
+						1,
+						wtatypes.DefaultPoolID,
 						1,
 						1,
 						sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
@@ -462,12 +1488,26 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 					),
 					wtatypes.NewTicket(
 						"winning-ticket",
+						1,
 						time.Date(2019, 12, 31, 23, 50, 60, 000, time.UTC),
 						"winner",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
+					nil,
+					nil,
+					0,
+					nil,
+					nil,
+					nil,
+					0,
+					0,
 				),
 				wtatypes.NewHistoricalDrawData(
 					wtatypes.NewDraw(
+						2,
+						wtatypes.DefaultPoolID,
 						10,
 						100,
 						sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
@@ -475,9 +1515,21 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 					),
 					wtatypes.NewTicket(
 						"winning-ticket-2",
+						2,
 						time.Date(2020, 12, 31, 23, 50, 60, 000, time.UTC),
 						"winner-2",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
+					nil,
+					nil,
+					0,
+					nil,
+					nil,
+					nil,
+					0,
+					0,
 				),
 			},
 		},
@@ -500,3 +1552,200 @@ func (suite *KeeperTestSuite) Test_SaveHistoricalDraw() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) Test_DeletePoolEntropyCommitments() {
+	valAddr1 := sdk.ValAddress("validator-1_________")
+	valAddr2 := sdk.ValAddress("validator-2_________")
+
+	otherPoolCommitment := wtatypes.NewEntropyCommitment(
+		2,
+		valAddr1.String(),
+		wtatypes.ComputeEntropyCommitment(valAddr1, []byte("entropy-1")),
+		nil,
+	)
+	suite.keeper.SaveEntropyCommitment(suite.ctx, otherPoolCommitment)
+	suite.keeper.SaveEntropyCommitment(suite.ctx, wtatypes.NewEntropyCommitment(
+		wtatypes.DefaultPoolID,
+		valAddr1.String(),
+		wtatypes.ComputeEntropyCommitment(valAddr1, []byte("entropy-1")),
+		[]byte("entropy-1"),
+	))
+	suite.keeper.SaveEntropyCommitment(suite.ctx, wtatypes.NewEntropyCommitment(
+		wtatypes.DefaultPoolID,
+		valAddr2.String(),
+		wtatypes.ComputeEntropyCommitment(valAddr2, []byte("entropy-2")),
+		nil,
+	))
+
+	suite.keeper.DeletePoolEntropyCommitments(suite.ctx, wtatypes.DefaultPoolID)
+	suite.Require().Equal([]wtatypes.EntropyCommitment{otherPoolCommitment}, suite.keeper.GetEntropyCommitments(suite.ctx))
+	suite.Require().Empty(suite.keeper.GetAllMissedReveals(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) Test_CloseEntropyRound() {
+	valAddr1 := sdk.ValAddress("validator-1_________")
+	valAddr2 := sdk.ValAddress("validator-2_________")
+
+	usecases := []struct {
+		name             string
+		commitments      []wtatypes.EntropyCommitment
+		missedReveals    map[string]uint64
+		expSeed          []byte
+		expMissedReveals []wtatypes.MissedReveals
+	}{
+		{
+			name:        "no commitments",
+			commitments: nil,
+			expSeed:     nil,
+		},
+		{
+			name: "no reveals",
+			commitments: []wtatypes.EntropyCommitment{
+				wtatypes.NewEntropyCommitment(
+					wtatypes.DefaultPoolID,
+					valAddr1.String(),
+					wtatypes.ComputeEntropyCommitment(valAddr1, []byte("entropy-1")),
+					nil,
+				),
+			},
+			expSeed: nil,
+			expMissedReveals: []wtatypes.MissedReveals{
+				wtatypes.NewMissedReveals(valAddr1.String(), 1),
+			},
+		},
+		{
+			name: "missing reveals are skipped and tracked",
+			commitments: []wtatypes.EntropyCommitment{
+				wtatypes.NewEntropyCommitment(
+					wtatypes.DefaultPoolID,
+					valAddr1.String(),
+					wtatypes.ComputeEntropyCommitment(valAddr1, []byte("entropy-1")),
+					[]byte("entropy-1"),
+				),
+				wtatypes.NewEntropyCommitment(
+					wtatypes.DefaultPoolID,
+					valAddr2.String(),
+					wtatypes.ComputeEntropyCommitment(valAddr2, []byte("entropy-2")),
+					nil,
+				),
+			},
+			missedReveals: map[string]uint64{
+				valAddr2.String(): 2,
+			},
+			expSeed: wtatypes.NewSeedFromEntropy([]wtatypes.EntropyCommitment{
+				wtatypes.NewEntropyCommitment(wtatypes.DefaultPoolID, valAddr1.String(), nil, []byte("entropy-1")),
+			}),
+			expMissedReveals: []wtatypes.MissedReveals{
+				wtatypes.NewMissedReveals(valAddr2.String(), 3),
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			for _, c := range uc.commitments {
+				suite.keeper.SaveEntropyCommitment(suite.ctx, c)
+			}
+			for validator, count := range uc.missedReveals {
+				valAddr, err := sdk.ValAddressFromBech32(validator)
+				suite.Require().NoError(err)
+				suite.keeper.SetMissedReveals(suite.ctx, valAddr, count)
+			}
+
+			seed := suite.keeper.CloseEntropyRound(suite.ctx, wtatypes.DefaultPoolID)
+			suite.Require().Equal(uc.expSeed, seed)
+			suite.Require().Equal(uc.expMissedReveals, suite.keeper.GetAllMissedReveals(suite.ctx))
+			suite.Require().Empty(suite.keeper.GetEntropyCommitments(suite.ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_PruneRemovedOraclesPrices() {
+	firstOracle := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	secondOracle := "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"
+	thirdOracle := "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"
+	postTime := suite.ctx.BlockTime().Add(-time.Minute)
+
+	usecases := []struct {
+		name      string
+		oracles   []string
+		posts     []wtatypes.PricePost
+		prices    []wtatypes.Price
+		expPosts  []wtatypes.PricePost
+		expPrices []wtatypes.Price
+	}{
+		{
+			name:    "no oracle removed",
+			oracles: []string{firstOracle, secondOracle},
+			posts: []wtatypes.PricePost{
+				wtatypes.NewPricePost("uatom", firstOracle, sdk.NewDec(1), postTime),
+				wtatypes.NewPricePost("uatom", secondOracle, sdk.NewDec(3), postTime),
+			},
+			prices: []wtatypes.Price{
+				wtatypes.NewPrice("uatom", sdk.NewDec(2), postTime),
+			},
+			expPosts: []wtatypes.PricePost{
+				wtatypes.NewPricePost("uatom", secondOracle, sdk.NewDec(3), postTime),
+				wtatypes.NewPricePost("uatom", firstOracle, sdk.NewDec(1), postTime),
+			},
+			expPrices: []wtatypes.Price{
+				wtatypes.NewPrice("uatom", sdk.NewDec(2), postTime),
+			},
+		},
+		{
+			name:    "price computed again without the removed oracle",
+			oracles: []string{firstOracle, thirdOracle},
+			posts: []wtatypes.PricePost{
+				wtatypes.NewPricePost("uatom", firstOracle, sdk.NewDec(1), postTime),
+				wtatypes.NewPricePost("uatom", secondOracle, sdk.NewDec(100), postTime),
+				wtatypes.NewPricePost("uatom", thirdOracle, sdk.NewDec(3), postTime),
+			},
+			prices: []wtatypes.Price{
+				wtatypes.NewPrice("uatom", sdk.NewDec(3), postTime),
+			},
+			expPosts: []wtatypes.PricePost{
+				wtatypes.NewPricePost("uatom", firstOracle, sdk.NewDec(1), postTime),
+				wtatypes.NewPricePost("uatom", thirdOracle, sdk.NewDec(3), postTime),
+			},
+			expPrices: []wtatypes.Price{
+				wtatypes.NewPrice("uatom", sdk.NewDec(2), suite.ctx.BlockTime()),
+			},
+		},
+		{
+			name:    "price removed along with its only oracle",
+			oracles: []string{firstOracle},
+			posts: []wtatypes.PricePost{
+				wtatypes.NewPricePost("uatom", firstOracle, sdk.NewDec(1), postTime),
+				wtatypes.NewPricePost("uosmo", secondOracle, sdk.NewDec(5), postTime),
+			},
+			prices: []wtatypes.Price{
+				wtatypes.NewPrice("uatom", sdk.NewDec(1), postTime),
+				wtatypes.NewPrice("uosmo", sdk.NewDec(5), postTime),
+			},
+			expPosts: []wtatypes.PricePost{
+				wtatypes.NewPricePost("uatom", firstOracle, sdk.NewDec(1), postTime),
+			},
+			expPrices: []wtatypes.Price{
+				wtatypes.NewPrice("uatom", sdk.NewDec(1), postTime),
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SetOracleParams(suite.ctx, wtatypes.NewOracleParams(uc.oracles, time.Hour))
+			for _, post := range uc.posts {
+				suite.keeper.SavePricePost(suite.ctx, post)
+			}
+			for _, price := range uc.prices {
+				suite.keeper.SavePrice(suite.ctx, price)
+			}
+
+			suite.keeper.PruneRemovedOraclesPrices(suite.ctx)
+			suite.Require().Equal(uc.expPosts, suite.keeper.GetAllPricePosts(suite.ctx))
+			suite.Require().Equal(uc.expPrices, suite.keeper.GetPrices(suite.ctx))
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,21 +23,48 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &msgServer{keeper}
 }
 
-// generateTickets generates n random tickets for the given user
-func (k msgServer) generateTickets(ctx sdk.Context, n uint32, user sdk.AccAddress) []types.Ticket {
+// generateTickets generates n random tickets of the current draw of the given pool owned by the given user
+// and paid by the given payer. If some picks are provided, the numbers of each pick are assigned to the
+// ticket having the same index. The given prize share is split evenly among the tickets, assigning the
+// remainder to the first ones. The random ids are derived from the draw id and the position each ticket will have
+// inside the draw, so that tickets bought by different messages of the same transaction never share the same id
+func (k msgServer) generateTickets(
+	ctx sdk.Context, drawID uint64, n uint32, user sdk.AccAddress, payer sdk.AccAddress, price sdk.Coin,
+	prizeShare sdk.Coin, picks []types.NumbersPick,
+) []types.Ticket {
+	shareAmount := prizeShare.Amount.QuoRaw(int64(n))
+	shareRemainder := prizeShare.Amount.ModRaw(int64(n)).Int64()
+
+	offset := uint64(k.GetDrawTicketsCount(ctx, drawID))
+
 	tickets := make([]types.Ticket, n)
 	for i := range tickets {
-		r := types.NewRandFromCtxAndIndex(ctx, i)
+		r := types.NewRandFromCtxDrawAndIndex(ctx, drawID, offset+uint64(i))
 
 		// Get a 16-bytes random id
 		var id = make([]byte, 16)
 		r.Read(id)
 
+		var numbers []uint32
+		if len(picks) != 0 {
+			numbers = types.SortNumbers(picks[i].Numbers)
+		}
+
 		tickets[i] = types.NewTicket(
 			hex.EncodeToString(id),
+			drawID,
 			ctx.BlockTime(),
 			user.String(),
+			payer.String(),
+			price,
+			numbers,
 		)
+
+		share := shareAmount
+		if int64(i) < shareRemainder {
+			share = share.AddRaw(1)
+		}
+		tickets[i].PrizeShare = sdk.NewCoins(sdk.NewCoin(prizeShare.Denom, share))
 	}
 
 	return tickets
@@ -51,22 +80,114 @@ func (k msgServer) BuyTickets(ctx context.Context, msg *types.MsgBuyTickets) (*t
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address")
 	}
 
+	// Get the address that will own the tickets
+	owner := user
+	if msg.Recipient != "" {
+		owner, err = sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+		}
+	}
+
+	// Make sure neither the buyer nor the recipient have excluded themselves from buying tickets
+	for _, addr := range []sdk.AccAddress{user, owner} {
+		if exclusion, found := k.GetExclusion(sdkCtx, addr); found && exclusion.IsActive(sdkCtx.BlockTime()) {
+			return nil, sdkerrors.Wrapf(types.ErrSelfExcluded,
+				"%s cannot buy tickets until %s", addr, exclusion.Until.Format(time.RFC3339))
+		}
+	}
+
+	// Make sure the ticket sales have not been halted
+	if salesParams := k.GetSalesParams(sdkCtx); salesParams.Halted {
+		return nil, sdkerrors.Wrap(types.ErrSalesHalted, salesParams.HaltReason)
+	}
+
+	pool, found := k.GetPool(sdkCtx, msg.PoolId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", msg.PoolId)
+	}
+
+	// Make sure the ticket sales of the pool have not been paused by governance
+	if k.IsPoolSalesPaused(sdkCtx, pool.Id) {
+		return nil, sdkerrors.Wrapf(types.ErrSalesPaused, "pool %d", pool.Id)
+	}
+
+	// Make sure the tickets can be paid using the given denom
+	price, err := k.GetTicketPrice(sdkCtx, pool, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the message does not exceed the tickets limit of the pool
+	maxTicketsPerMsg := pool.TicketParams.MaxTicketsPerMsg
+	if maxTicketsPerMsg != 0 && msg.Quantity > maxTicketsPerMsg {
+		return nil, sdkerrors.Wrapf(types.ErrMaxTicketsPerMsg,
+			"cannot buy %d tickets of pool %d at once, the limit is %d", msg.Quantity, pool.Id, maxTicketsPerMsg)
+	}
+
+	// Make sure the picks match the pool game
+	if pool.GameParams.GameType == types.GameTypeLotto {
+		if uint32(len(msg.Picks)) != msg.Quantity {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPick, "a pick is required for each ticket of pool %d", pool.Id)
+		}
+
+		for _, pick := range msg.Picks {
+			if err := pool.GameParams.ValidatePick(pick.Numbers); err != nil {
+				return nil, sdkerrors.Wrap(types.ErrInvalidPick, err.Error())
+			}
+		}
+	} else if len(msg.Picks) != 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPick, "pool %d does not accept picked numbers", pool.Id)
+	}
+
+	// Make sure the draw still accepts tickets
+	if !k.IsCurrentDrawOpen(sdkCtx, pool.Id) {
+		return nil, sdkerrors.Wrap(types.ErrDrawClosed, "tickets cannot be bought until the next draw starts")
+	}
+
+	// Tickets bought during the sales cutoff window are either rejected or assigned to the upcoming draw
+	drawID := k.GetCurrentDrawID(sdkCtx, pool.Id)
+	if k.IsCurrentDrawSalesCutoff(sdkCtx, pool) {
+		if !pool.DrawParams.AssignToNextDraw {
+			return nil, sdkerrors.Wrapf(types.ErrSalesCutoff,
+				"tickets of pool %d cannot be bought until the next draw starts", pool.Id)
+		}
+		drawID = k.ReserveUpcomingDrawID(sdkCtx, pool.Id)
+	}
+
+	// Make sure the owner does not exceed the tickets limit of the draw
+	maxTicketsPerAddress := pool.TicketParams.MaxTicketsPerAddress
+	if maxTicketsPerAddress != 0 {
+		owned := k.GetDrawOwnerTicketsCount(sdkCtx, drawID, owner.String())
+		if uint64(owned)+uint64(msg.Quantity) > uint64(maxTicketsPerAddress) {
+			return nil, sdkerrors.Wrapf(types.ErrMaxTicketsPerAddress,
+				"%s already owns %d tickets of draw %d, the limit is %d", owner, owned, drawID, maxTicketsPerAddress)
+		}
+	}
+
 	// Withdraw the fees
-	err = k.WithdrawTicketsCost(sdkCtx, msg.Quantity, user)
+	prizeShare, err := k.WithdrawTicketsCost(sdkCtx, pool, drawID, msg.Quantity, price, user)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, err.Error())
+		return nil, err
 	}
 
-	tickets := k.generateTickets(sdkCtx, msg.Quantity, user)
-	k.SaveTickets(sdkCtx, tickets)
+	tickets := k.generateTickets(sdkCtx, drawID, msg.Quantity, owner, user, price, prizeShare, msg.Picks)
+
+	err = k.SaveNewTickets(sdkCtx, tickets)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, t := range tickets {
 		sdkCtx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeBuyTicket,
+				sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(t.DrawId, 10)),
 				sdk.NewAttribute(types.AttributeKeyTicketID, t.Id),
 				sdk.NewAttribute(types.AttributeKeyTicketTimestamp, t.Timestamp.Format(time.RFC3339)),
-				sdk.NewAttribute(types.AttributeKeyTicketBuyer, t.Owner),
+				sdk.NewAttribute(types.AttributeKeyTicketBuyer, t.PaidBy()),
+				sdk.NewAttribute(types.AttributeKeyTicketOwner, t.Owner),
 			),
 		)
 	}
@@ -82,3 +203,322 @@ func (k msgServer) BuyTickets(ctx context.Context, msg *types.MsgBuyTickets) (*t
 
 	return &types.MsgBuyTicketsResponse{}, nil
 }
+
+// CommitEntropy implements MsgServer
+func (k msgServer) CommitEntropy(ctx context.Context, msg *types.MsgCommitEntropy) (*types.MsgCommitEntropyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address")
+	}
+
+	if !k.IsBondedValidator(sdkCtx, valAddr) {
+		return nil, sdkerrors.Wrap(types.ErrNotValidator, msg.Validator)
+	}
+
+	if !k.HasPool(sdkCtx, msg.PoolId) {
+		return nil, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", msg.PoolId)
+	}
+
+	// Commitments are only accepted while the draw is open
+	if !k.IsCurrentDrawOpen(sdkCtx, msg.PoolId) {
+		return nil, sdkerrors.Wrap(types.ErrDrawClosed, "entropy cannot be committed until the next draw starts")
+	}
+
+	if _, found := k.GetEntropyCommitment(sdkCtx, msg.PoolId, valAddr); found {
+		return nil, sdkerrors.Wrap(types.ErrCommitmentExists, msg.Validator)
+	}
+
+	k.SaveEntropyCommitment(sdkCtx, types.NewEntropyCommitment(msg.PoolId, msg.Validator, msg.Commitment, nil))
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCommitEntropy,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(msg.Commitment)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgCommitEntropy),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Validator),
+		),
+	})
+
+	return &types.MsgCommitEntropyResponse{}, nil
+}
+
+// RevealEntropy implements MsgServer
+func (k msgServer) RevealEntropy(ctx context.Context, msg *types.MsgRevealEntropy) (*types.MsgRevealEntropyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address")
+	}
+
+	if !k.HasPool(sdkCtx, msg.PoolId) {
+		return nil, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", msg.PoolId)
+	}
+
+	// Reveals are only accepted once the draw has been closed
+	if k.IsCurrentDrawOpen(sdkCtx, msg.PoolId) {
+		return nil, sdkerrors.Wrap(types.ErrRevealNotAllowed, "entropy can be revealed only after the draw end time")
+	}
+
+	commitment, found := k.GetEntropyCommitment(sdkCtx, msg.PoolId, valAddr)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrCommitmentNotFound, msg.Validator)
+	}
+
+	if commitment.IsRevealed() {
+		return nil, sdkerrors.Wrap(types.ErrRevealNotAllowed, "entropy already revealed")
+	}
+
+	if !bytes.Equal(types.ComputeEntropyCommitment(valAddr, msg.Entropy), commitment.Commitment) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidReveal, "entropy does not match the commitment")
+	}
+
+	commitment.Entropy = msg.Entropy
+	k.SaveEntropyCommitment(sdkCtx, commitment)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealEntropy,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgRevealEntropy),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Validator),
+		),
+	})
+
+	return &types.MsgRevealEntropyResponse{}, nil
+}
+
+// SelfExclude implements MsgServer
+func (k msgServer) SelfExclude(ctx context.Context, msg *types.MsgSelfExclude) (*types.MsgSelfExcludeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid self exclusion address")
+	}
+
+	if !msg.Until.After(sdkCtx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExclusion,
+			"end time %s is not in the future", msg.Until.Format(time.RFC3339))
+	}
+
+	// Make sure an active exclusion is not shortened
+	if exclusion, found := k.GetExclusion(sdkCtx, addr); found && exclusion.IsActive(sdkCtx.BlockTime()) {
+		if msg.Until.Before(exclusion.Until) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidExclusion,
+				"%s is already excluded until %s", msg.Address, exclusion.Until.Format(time.RFC3339))
+		}
+	}
+
+	k.SaveExclusion(sdkCtx, types.NewExclusion(msg.Address, msg.Until))
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSelfExclude,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyExcludedUntil, msg.Until.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgSelfExclude),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	})
+
+	return &types.MsgSelfExcludeResponse{}, nil
+}
+
+// SetSpendingLimit implements MsgServer
+func (k msgServer) SetSpendingLimit(
+	ctx context.Context, msg *types.MsgSetSpendingLimit,
+) (*types.MsgSetSpendingLimitResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid spending limit address")
+	}
+
+	limit := types.NewSpendingLimit(msg.Address, msg.Amount, msg.Window, nil)
+	effectiveTime := sdkCtx.BlockTime()
+
+	// Raising the current limit only takes effect after the cooling-off delay, while lowering it applies immediately
+	current, found := k.GetSpendingLimit(sdkCtx, addr)
+	if found && limit.Raises(current) {
+		effectiveTime = effectiveTime.Add(k.GetSpendingLimitParams(sdkCtx).IncreaseDelay)
+		limit = types.NewSpendingLimit(msg.Address, current.Amount, current.Window,
+			types.NewPendingSpendingLimit(msg.Amount, msg.Window, effectiveTime))
+	}
+
+	// The spending of addresses without a limit in effect is not tracked, so the window of a new limit starts
+	// now. The amounts recorded under a previous limit whose removal has already become effective are discarded
+	if !found {
+		k.DeleteSpendingLimit(sdkCtx, addr)
+	}
+
+	if limit.IsLimited() {
+		k.SaveSpendingLimit(sdkCtx, limit)
+	} else {
+		k.DeleteSpendingLimit(sdkCtx, addr)
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSpendingLimit,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyLimitAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyLimitWindow, msg.Window.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, effectiveTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgSetSpendingLimit),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	})
+
+	return &types.MsgSetSpendingLimitResponse{}, nil
+}
+
+// PostPrice implements MsgServer
+func (k msgServer) PostPrice(ctx context.Context, msg *types.MsgPostPrice) (*types.MsgPostPriceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Make sure the sender is a whitelisted oracle
+	if !k.GetOracleParams(sdkCtx).IsOracle(msg.Oracle) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidOracle, "%s is not a whitelisted oracle", msg.Oracle)
+	}
+
+	k.SavePricePost(sdkCtx, types.NewPricePost(msg.Denom, msg.Oracle, msg.Price, sdkCtx.BlockTime()))
+
+	// Update the median price of the denom
+	price, _ := k.UpdatePrice(sdkCtx, msg.Denom)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePostPrice,
+			sdk.NewAttribute(types.AttributeKeyOracle, msg.Oracle),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyMedianPrice, price.Price.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgPostPrice),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Oracle),
+		),
+	})
+
+	return &types.MsgPostPriceResponse{}, nil
+}
+
+// TransferTickets implements MsgServer
+func (k msgServer) TransferTickets(
+	ctx context.Context, msg *types.MsgTransferTickets,
+) (*types.MsgTransferTicketsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+	}
+
+	// Make sure the recipient has not excluded themselves from buying tickets
+	if exclusion, found := k.GetExclusion(sdkCtx, recipient); found && exclusion.IsActive(sdkCtx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrSelfExcluded,
+			"%s cannot receive tickets until %s", recipient, exclusion.Until.Format(time.RFC3339))
+	}
+
+	pool, found := k.GetPool(sdkCtx, msg.PoolId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", msg.PoolId)
+	}
+
+	// Tickets of the current draw can only be transferred before its sales cutoff, while tickets already
+	// assigned to the upcoming draw can be transferred until such draw starts
+	currentDrawID := k.GetCurrentDrawID(sdkCtx, pool.Id)
+	currentDrawOpen := k.IsCurrentDrawOpen(sdkCtx, pool.Id) && !k.IsCurrentDrawSalesCutoff(sdkCtx, pool)
+	upcomingDrawID, hasUpcomingDraw := k.GetUpcomingDrawID(sdkCtx, pool.Id)
+
+	tickets := make([]types.Ticket, len(msg.TicketIds))
+	transferred := map[uint64]uint32{}
+	for i, id := range msg.TicketIds {
+		ticket, found := k.GetTicket(sdkCtx, id)
+		if !found {
+			return nil, sdkerrors.Wrap(types.ErrTicketNotFound, id)
+		}
+
+		if ticket.Owner != msg.Owner {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "ticket %s is not owned by %s", id, msg.Owner)
+		}
+
+		switch {
+		case ticket.DrawId == currentDrawID && !currentDrawOpen:
+			return nil, sdkerrors.Wrapf(types.ErrSalesCutoff,
+				"tickets of draw %d cannot be transferred anymore", ticket.DrawId)
+		case ticket.DrawId != currentDrawID && !(hasUpcomingDraw && ticket.DrawId == upcomingDrawID):
+			return nil, sdkerrors.Wrapf(types.ErrDrawClosed,
+				"ticket %s does not belong to the current draw of pool %d", id, pool.Id)
+		}
+
+		// Make sure the recipient does not exceed the tickets limit of the draw
+		transferred[ticket.DrawId]++
+		maxTicketsPerAddress := pool.TicketParams.MaxTicketsPerAddress
+		if maxTicketsPerAddress != 0 {
+			owned := k.GetDrawOwnerTicketsCount(sdkCtx, ticket.DrawId, recipient.String())
+			if uint64(owned)+uint64(transferred[ticket.DrawId]) > uint64(maxTicketsPerAddress) {
+				return nil, sdkerrors.Wrapf(types.ErrMaxTicketsPerAddress,
+					"%s already owns %d tickets of draw %d, the limit is %d",
+					recipient, owned, ticket.DrawId, maxTicketsPerAddress)
+			}
+		}
+
+		// The payer is kept, while the ticket is marked as transferred so that, if the draw is refunded,
+		// the prize share of the ticket goes to its new owner along with everything else the ticket is worth
+		ticket.Owner = recipient.String()
+		ticket.Transferred = true
+		tickets[i] = ticket
+	}
+
+	k.SaveTickets(sdkCtx, tickets)
+
+	for _, t := range tickets {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransferTicket,
+				sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(t.DrawId, 10)),
+				sdk.NewAttribute(types.AttributeKeyTicketID, t.Id),
+				sdk.NewAttribute(types.AttributeKeyTicketSender, msg.Owner),
+				sdk.NewAttribute(types.AttributeKeyTicketRecipient, t.Owner),
+			),
+		)
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TypeMsgTransferTickets),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+
+	return &types.MsgTransferTicketsResponse{}, nil
+}
@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmicbet/ledger/x/wta/keeper"
 	"github.com/cosmicbet/ledger/x/wta/types"
@@ -18,12 +21,17 @@ func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets() {
 		sdk.NewDecWithPrec(98, 2),
 		sdk.NewDecWithPrec(1, 2),
 		sdk.NewDecWithPrec(1, 2),
+		types.FeeDestinationFeeCollector,
 	)
-	drawParams := types.NewDrawParams(time.Minute * 1)
-	ticketParams := types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	drawParams := types.NewDrawParams(time.Minute*1, time.Minute, 0, false)
+	ticketParams := types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0)
 
 	usecases := []struct {
 		name            string
+		drawEndTime     time.Time
+		salesParams     types.SalesParams
+		salesPaused     bool
+		exclusions      []types.Exclusion
 		stored          []types.Ticket
 		accBalance      sdk.Coins
 		msg             *types.MsgBuyTickets
@@ -33,19 +41,68 @@ func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets() {
 	}{
 		{
 			name:      "invalid address",
-			msg:       types.NewMsgBuyTickets(10, "address"),
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 10, "", "address", "", nil),
 			shouldErr: true,
 		},
 		{
 			name:      "insufficient balance",
-			msg:       types.NewMsgBuyTickets(1, addr.String()),
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 1, "", addr.String(), "", nil),
 			shouldErr: true,
 		},
+		{
+			name:        "draw closed",
+			drawEndTime: time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
+			accBalance:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)),
+			msg:         types.NewMsgBuyTickets(types.DefaultPoolID, 10, "", addr.String(), "", nil),
+			shouldErr:   true,
+		},
+		{
+			name:       "non existing pool",
+			accBalance: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)),
+			msg:        types.NewMsgBuyTickets(1, 10, "", addr.String(), "", nil),
+			shouldErr:  true,
+		},
+		{
+			name:        "sales halted",
+			salesParams: types.NewSalesParams(true, "Wrong ticket price"),
+			accBalance:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)),
+			msg:         types.NewMsgBuyTickets(types.DefaultPoolID, 10, "", addr.String(), "", nil),
+			shouldErr:   true,
+		},
+		{
+			name: "buyer self excluded",
+			exclusions: []types.Exclusion{
+				types.NewExclusion(addr.String(), time.Date(2021, 1, 1, 00, 00, 01, 000, time.UTC)),
+			},
+			accBalance: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)),
+			msg:        types.NewMsgBuyTickets(types.DefaultPoolID, 10, "", addr.String(), "", nil),
+			shouldErr:  true,
+		},
+		{
+			name: "buying after the self exclusion expired",
+			exclusions: []types.Exclusion{
+				types.NewExclusion(addr.String(), time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)),
+			},
+			accBalance: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)),
+			msg:        types.NewMsgBuyTickets(types.DefaultPoolID, 10, "", addr.String(), "", nil),
+			shouldErr:  false,
+			expParticipants: []string{
+				addr.String(),
+			},
+			expTicketsSold: 10,
+		},
+		{
+			name:        "sales paused",
+			salesPaused: true,
+			accBalance:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)),
+			msg:         types.NewMsgBuyTickets(types.DefaultPoolID, 10, "", addr.String(), "", nil),
+			shouldErr:   true,
+		},
 		{
 			name:       "buying without any stored ticket",
 			stored:     nil,
 			accBalance: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)),
-			msg:        types.NewMsgBuyTickets(10, addr.String()),
+			msg:        types.NewMsgBuyTickets(types.DefaultPoolID, 10, "", addr.String(), "", nil),
 			shouldErr:  false,
 			expParticipants: []string{
 				addr.String(),
@@ -58,16 +115,24 @@ func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets() {
 			stored: []types.Ticket{
 				types.NewTicket(
 					"ticket-1",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					addr.String(),
+					"",
+					sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-2",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					addr.String(),
+					"",
+					sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+					nil,
 				),
 			},
-			msg:       types.NewMsgBuyTickets(5, addr.String()),
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 5, "", addr.String(), "", nil),
 			shouldErr: false,
 			expParticipants: []string{
 				addr.String(),
@@ -80,20 +145,28 @@ func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets() {
 			stored: []types.Ticket{
 				types.NewTicket(
 					"ticket-1",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"user-2",
+					"",
+					sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+					nil,
 				),
 				types.NewTicket(
 					"ticket-2",
+					1,
 					time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 					"user-2",
+					"",
+					sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+					nil,
 				),
 			},
-			msg:       types.NewMsgBuyTickets(5, addr.String()),
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 5, "", addr.String(), "", nil),
 			shouldErr: false,
 			expParticipants: []string{
-				addr.String(),
 				"user-2",
+				addr.String(),
 			},
 			expTicketsSold: 7,
 		},
@@ -102,6 +175,15 @@ func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets() {
 	for _, uc := range usecases {
 		suite.SetupTest()
 		suite.Run(uc.name, func() {
+			if uc.drawEndTime.IsZero() {
+				uc.drawEndTime = suite.ctx.BlockTime().Add(drawParams.Duration)
+			}
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, uc.drawEndTime)
+			suite.keeper.SetSalesParams(suite.ctx, uc.salesParams)
+			suite.keeper.SetPoolSalesPaused(suite.ctx, types.DefaultPoolID, uc.salesPaused)
+			for _, e := range uc.exclusions {
+				suite.keeper.SaveExclusion(suite.ctx, e)
+			}
 			suite.keeper.SaveTickets(suite.ctx, uc.stored)
 			suite.keeper.SetDistributionParams(suite.ctx, distributionParams)
 			suite.keeper.SetDrawParams(suite.ctx, drawParams)
@@ -117,10 +199,1394 @@ func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets() {
 			} else {
 				suite.Require().NoError(err)
 
-				participants, ticketsSold := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx)
+				participants, ticketsSold := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx, 1)
 				suite.Require().Equal(uc.expParticipants, participants)
 				suite.Require().Len(ticketsSold, uc.expTicketsSold)
 			}
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_SalesCutoff() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	distributionParams := types.NewDistributionParams(
+		sdk.NewDecWithPrec(98, 2),
+		sdk.NewDecWithPrec(1, 2),
+		sdk.NewDecWithPrec(1, 2),
+		types.FeeDestinationFeeCollector,
+	)
+	ticketParams := types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0)
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+
+	usecases := []struct {
+		name             string
+		drawParams       types.DrawParams
+		drawEndTime      time.Time
+		shouldErr        bool
+		expDrawID        uint64
+		expPrize         sdk.Coins
+		expUpcomingPrize sdk.Coins
+	}{
+		{
+			name:             "tickets bought before the sales cutoff",
+			drawParams:       types.NewDrawParams(time.Hour, time.Minute, time.Minute*10, false),
+			drawEndTime:      time.Date(2021, 1, 1, 00, 30, 00, 000, time.UTC),
+			shouldErr:        false,
+			expDrawID:        1,
+			expPrize:         sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 98)),
+			expUpcomingPrize: sdk.NewCoins(),
+		},
+		{
+			name:        "tickets rejected during the sales cutoff",
+			drawParams:  types.NewDrawParams(time.Hour, time.Minute, time.Minute*10, false),
+			drawEndTime: time.Date(2021, 1, 1, 00, 10, 00, 000, time.UTC),
+			shouldErr:   true,
+		},
+		{
+			name:             "tickets assigned to the next draw during the sales cutoff",
+			drawParams:       types.NewDrawParams(time.Hour, time.Minute, time.Minute*10, true),
+			drawEndTime:      time.Date(2021, 1, 1, 00, 05, 00, 000, time.UTC),
+			shouldErr:        false,
+			expDrawID:        2,
+			expPrize:         sdk.NewCoins(),
+			expUpcomingPrize: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 98)),
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawID(suite.ctx, types.DefaultPoolID, 1)
+			suite.keeper.SetNextDrawID(suite.ctx, 2)
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, uc.drawEndTime)
+			suite.keeper.SetDistributionParams(suite.ctx, distributionParams)
+			suite.keeper.SetDrawParams(suite.ctx, uc.drawParams)
+			suite.keeper.SetTicketParams(suite.ctx, ticketParams)
+			suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.BuyTickets(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgBuyTickets(types.DefaultPoolID, 10, "", addr.String(), "", nil),
+			)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+				suite.Require().True(types.ErrSalesCutoff.Is(err))
+			} else {
+				suite.Require().NoError(err)
+
+				_, ticketsSold := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx, uc.expDrawID)
+				suite.Require().Len(ticketsSold, 10)
+
+				collector := types.PoolPrizeCollectorAddress(types.DefaultPoolID)
+				suite.Require().Equal(uc.expPrize, suite.bk.GetAllBalances(suite.ctx, collector))
+
+				upcomingCollector := types.PoolUpcomingPrizeCollectorAddress(types.DefaultPoolID)
+				suite.Require().Equal(uc.expUpcomingPrize, suite.bk.GetAllBalances(suite.ctx, upcomingCollector))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_Limits() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+
+	usecases := []struct {
+		name         string
+		ticketParams types.TicketParams
+		limits       []types.SpendingLimit
+		stored       []types.Ticket
+		quantity     uint32
+		expErr       error
+	}{
+		{
+			name:         "spending limit reached",
+			ticketParams: types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0),
+			limits: []types.SpendingLimit{
+				types.NewSpendingLimit(addr.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), time.Hour, nil),
+			},
+			quantity: 3,
+			expErr:   types.ErrSpendingLimitReached,
+		},
+		{
+			name:         "too many tickets per message",
+			ticketParams: types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 5),
+			quantity:     6,
+			expErr:       types.ErrMaxTicketsPerMsg,
+		},
+		{
+			name:         "too many tickets per address",
+			ticketParams: types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 5, 0),
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), addr.String(), "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+				types.NewTicket("ticket-2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), addr.String(), "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+			},
+			quantity: 4,
+			expErr:   types.ErrMaxTicketsPerAddress,
+		},
+		{
+			name:         "tickets of other addresses are not counted",
+			ticketParams: types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 5, 0),
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "user-2", "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+				types.NewTicket("ticket-2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "user-2", "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+			},
+			quantity: 5,
+		},
+		{
+			name:         "tickets within the limits",
+			ticketParams: types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 5, 3),
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), addr.String(), "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+				types.NewTicket("ticket-2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), addr.String(), "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+			},
+			quantity: 3,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+			suite.keeper.SetTicketParams(suite.ctx, uc.ticketParams)
+			for _, limit := range uc.limits {
+				suite.keeper.SaveSpendingLimit(suite.ctx, limit)
+			}
+			suite.keeper.SaveTickets(suite.ctx, uc.stored)
+			suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.BuyTickets(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgBuyTickets(types.DefaultPoolID, uc.quantity, "", addr.String(), "", nil),
+			)
+
+			if uc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, uc.expErr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(
+					uint32(len(uc.stored))+uc.quantity,
+					suite.keeper.GetDrawTicketsCount(suite.ctx, 1),
+				)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_SpendingLimitSetAfterSpending() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+	limitAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+
+	suite.SetupTest()
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+	suite.keeper.SetTicketParams(suite.ctx, types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0))
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+	server := keeper.NewMsgServerImpl(suite.keeper)
+	buy := func(quantity uint32) error {
+		_, err := server.BuyTickets(
+			sdk.WrapSDKContext(suite.ctx),
+			types.NewMsgBuyTickets(types.DefaultPoolID, quantity, "", addr.String(), "", nil),
+		)
+		return err
+	}
+
+	// The spending of addresses without a limit is not recorded
+	suite.Require().NoError(buy(5))
+	suite.Require().Empty(suite.keeper.GetSpendRecords(suite.ctx))
+
+	// The window of the limit starts once it is set, so the tickets bought before do not count against it
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+	_, err = server.SetSpendingLimit(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgSetSpendingLimit(addr.String(), limitAmount, time.Hour),
+	)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+	suite.Require().NoError(buy(5))
+	suite.Require().Equal(limitAmount, suite.keeper.GetSpentAmount(suite.ctx, addr, suite.ctx.BlockTime().Add(-time.Hour)))
+
+	err = buy(1)
+	suite.Require().Error(err)
+	suite.Require().ErrorIs(err, types.ErrSpendingLimitReached)
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_AlternativePrices() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	distributionParams := types.NewDistributionParams(
+		sdk.NewDecWithPrec(98, 2),
+		sdk.NewDecWithPrec(1, 2),
+		sdk.NewDecWithPrec(1, 2),
+		types.FeeDestinationFeeCollector,
+	)
+	ticketParams := types.NewTicketParams(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+		sdk.ZeroDec(),
+		0,
+		0,
+	)
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), sdk.NewInt64Coin("atom", 10000))
+
+	usecases := []struct {
+		name     string
+		denom    string
+		expErr   error
+		expPrice sdk.Coin
+		expPrize sdk.Coins
+	}{
+		{
+			name:   "denom not accepted",
+			denom:  "btc",
+			expErr: types.ErrDenomNotAccepted,
+		},
+		{
+			name:     "empty denom uses the main price",
+			denom:    "",
+			expPrice: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expPrize: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 196)),
+		},
+		{
+			name:     "main price denom",
+			denom:    sdk.DefaultBondDenom,
+			expPrice: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expPrize: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 196)),
+		},
+		{
+			name:     "alternative price denom",
+			denom:    "atom",
+			expPrice: sdk.NewInt64Coin("atom", 50),
+			expPrize: sdk.NewCoins(sdk.NewInt64Coin("atom", 98)),
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+			suite.keeper.SetDistributionParams(suite.ctx, distributionParams)
+			suite.keeper.SetTicketParams(suite.ctx, ticketParams)
+			suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.BuyTickets(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgBuyTickets(types.DefaultPoolID, 2, uc.denom, addr.String(), "", nil),
+			)
+
+			if uc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, uc.expErr)
+			} else {
+				suite.Require().NoError(err)
+
+				_, tickets := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx, 1)
+				suite.Require().Len(tickets, 2)
+				for _, ticket := range tickets {
+					suite.Require().Equal(uc.expPrice, ticket.Price)
+				}
+
+				collector := types.PoolPrizeCollectorAddress(types.DefaultPoolID)
+				suite.Require().Equal(uc.expPrize, suite.bk.GetAllBalances(suite.ctx, collector))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_ReferencePrice() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	ticketParams := types.NewTicketParams(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+		sdk.NewDec(1),
+		0,
+		0,
+	)
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), sdk.NewInt64Coin("atom", 10000))
+
+	usecases := []struct {
+		name     string
+		prices   []types.Price
+		denom    string
+		expErr   error
+		expPrice sdk.Coin
+	}{
+		{
+			name:   "missing price",
+			denom:  sdk.DefaultBondDenom,
+			expErr: types.ErrStalePrice,
+		},
+		{
+			name: "stale price",
+			prices: []types.Price{
+				types.NewPrice(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 2), suite.ctx.BlockTime().Add(-2*time.Hour)),
+			},
+			denom:  sdk.DefaultBondDenom,
+			expErr: types.ErrStalePrice,
+		},
+		{
+			name: "main price denom converted using its price",
+			prices: []types.Price{
+				types.NewPrice(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 2), suite.ctx.BlockTime().Add(-time.Minute)),
+			},
+			denom:    sdk.DefaultBondDenom,
+			expPrice: sdk.NewInt64Coin(sdk.DefaultBondDenom, 4),
+		},
+		{
+			name: "alternative price denom rounded up",
+			prices: []types.Price{
+				types.NewPrice(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 2), suite.ctx.BlockTime().Add(-time.Minute)),
+				types.NewPrice("atom", sdk.NewDec(3), suite.ctx.BlockTime().Add(-time.Minute)),
+			},
+			denom:    "atom",
+			expPrice: sdk.NewInt64Coin("atom", 1),
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+			suite.keeper.SetTicketParams(suite.ctx, ticketParams)
+			suite.keeper.SetOracleParams(suite.ctx, types.NewOracleParams(nil, time.Hour))
+			suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+			for _, price := range uc.prices {
+				suite.keeper.SavePrice(suite.ctx, price)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.BuyTickets(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgBuyTickets(types.DefaultPoolID, 2, uc.denom, addr.String(), "", nil),
+			)
+
+			if uc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, uc.expErr)
+
+				_, tickets := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx, 1)
+				suite.Require().Empty(tickets)
+			} else {
+				suite.Require().NoError(err)
+
+				_, tickets := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx, 1)
+				suite.Require().Len(tickets, 2)
+				for _, ticket := range tickets {
+					suite.Require().Equal(uc.expPrice, ticket.Price)
+				}
+
+				spent := sdk.NewCoin(uc.expPrice.Denom, uc.expPrice.Amount.MulRaw(2))
+				suite.Require().Equal(accBalance.Sub(sdk.NewCoins(spent)), suite.bk.GetAllBalances(suite.ctx, addr))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_Recipient() {
+	buyer, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	recipient, err := sdk.AccAddressFromBech32("cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu")
+	suite.Require().NoError(err)
+
+	ticketParams := types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 5, 0)
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+
+	usecases := []struct {
+		name       string
+		exclusions []types.Exclusion
+		stored     []types.Ticket
+		quantity   uint32
+		expErr     error
+	}{
+		{
+			name: "recipient has excluded itself",
+			exclusions: []types.Exclusion{
+				types.NewExclusion(recipient.String(), suite.ctx.BlockTime().Add(time.Hour)),
+			},
+			quantity: 2,
+			expErr:   types.ErrSelfExcluded,
+		},
+		{
+			name: "recipient exceeding the tickets per address limit",
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), recipient.String(), "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+				types.NewTicket("ticket-2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), recipient.String(), "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+			},
+			quantity: 4,
+			expErr:   types.ErrMaxTicketsPerAddress,
+		},
+		{
+			name: "tickets of the buyer are not counted",
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), buyer.String(), "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+				types.NewTicket("ticket-2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), buyer.String(), "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil),
+			},
+			quantity: 5,
+		},
+		{
+			name:     "tickets owned by the recipient",
+			quantity: 2,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+			suite.keeper.SetTicketParams(suite.ctx, ticketParams)
+			suite.keeper.SaveTickets(suite.ctx, uc.stored)
+			for _, exclusion := range uc.exclusions {
+				suite.keeper.SaveExclusion(suite.ctx, exclusion)
+			}
+			suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, buyer, accBalance))
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.BuyTickets(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgBuyTickets(types.DefaultPoolID, uc.quantity, "", buyer.String(), recipient.String(), nil),
+			)
+
+			if uc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, uc.expErr)
+			} else {
+				suite.Require().NoError(err)
+
+				owned := suite.keeper.GetDrawOwnerTicketsCount(suite.ctx, 1, recipient.String())
+				suite.Require().Equal(uc.quantity, owned)
+
+				for _, ticket := range suite.keeper.GetTickets(suite.ctx) {
+					if ticket.Payer != "" {
+						suite.Require().Equal(recipient.String(), ticket.Owner)
+						suite.Require().Equal(buyer.String(), ticket.Payer)
+					}
+				}
+
+				// The tickets are paid by the buyer
+				spent := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10*int64(uc.quantity)))
+				suite.Require().Equal(accBalance.Sub(spent), suite.bk.GetAllBalances(suite.ctx, buyer))
+				suite.Require().True(suite.bk.GetAllBalances(suite.ctx, recipient).IsZero())
+
+				// Check that the events contain both the buyer and the owner
+				var buyEvents int
+				for _, event := range suite.ctx.EventManager().Events() {
+					if event.Type != types.EventTypeBuyTicket {
+						continue
+					}
+
+					buyEvents++
+					attributes := map[string]string{}
+					for _, attr := range event.Attributes {
+						attributes[string(attr.Key)] = string(attr.Value)
+					}
+					suite.Require().Equal(buyer.String(), attributes[types.AttributeKeyTicketBuyer])
+					suite.Require().Equal(recipient.String(), attributes[types.AttributeKeyTicketOwner])
+				}
+				suite.Require().Equal(int(uc.quantity), buyEvents)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_PrizeShare() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+	suite.SetupTest()
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+	suite.keeper.SetTicketParams(suite.ctx, types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0))
+	suite.keeper.SetDistributionParams(suite.ctx, types.NewDistributionParams(
+		sdk.NewDecWithPrec(55, 2), sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(20, 2), types.FeeDestinationFeeCollector,
+	))
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+	server := keeper.NewMsgServerImpl(suite.keeper)
+	_, err = server.BuyTickets(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgBuyTickets(types.DefaultPoolID, 4, "", addr.String(), "", nil),
+	)
+	suite.Require().NoError(err)
+
+	// The 22stake added to the prize are split among the tickets, assigning the remainder to the first ones
+	var shares []sdk.Coins
+	suite.keeper.IterateDrawTickets(suite.ctx, 1, func(_ uint32, ticket types.Ticket) (stop bool) {
+		shares = append(shares, ticket.PrizeShare)
+		return false
+	})
+	suite.Require().Equal([]sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 6)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 6)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)),
+	}, shares)
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_SameTx() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	pool := types.NewPool(
+		7,
+		types.DefaultDistributionParams(),
+		types.NewDrawParams(time.Minute*1, time.Minute, 0, false),
+		types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0),
+		types.DefaultPrizeParams(),
+		types.DefaultGameParams(),
+		types.DefaultRolloverParams(),
+	)
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+
+	suite.SetupTest()
+	suite.keeper.SetTicketParams(suite.ctx, pool.TicketParams)
+	suite.ctx = suite.ctx.WithTxBytes([]byte("tx_bytes"))
+	suite.keeper.SavePool(suite.ctx, pool)
+	suite.keeper.SaveCurrentDrawID(suite.ctx, pool.Id, 2)
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, pool.Id, suite.ctx.BlockTime().Add(time.Hour))
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+	// All the messages are handled within the same transaction, sharing the same context
+	server := keeper.NewMsgServerImpl(suite.keeper)
+	for _, msg := range []*types.MsgBuyTickets{
+		types.NewMsgBuyTickets(types.DefaultPoolID, 2, "", addr.String(), "", nil),
+		types.NewMsgBuyTickets(pool.Id, 2, "", addr.String(), "", nil),
+		types.NewMsgBuyTickets(types.DefaultPoolID, 2, "", addr.String(), "", nil),
+	} {
+		_, err = server.BuyTickets(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().NoError(err)
+	}
+
+	// Each draw keeps all the tickets bought for it, and no id is shared
+	ids := map[string]bool{}
+	for drawID, expTickets := range map[uint64]int{1: 4, 2: 2} {
+		suite.Require().Equal(uint32(expTickets), suite.keeper.GetDrawTicketsCount(suite.ctx, drawID))
+
+		_, tickets := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx, drawID)
+		suite.Require().Len(tickets, expTickets)
+		for _, ticket := range tickets {
+			suite.Require().Equal(drawID, ticket.DrawId)
+			suite.Require().False(ids[ticket.Id])
+			ids[ticket.Id] = true
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_ExistingID() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithTxBytes([]byte("tx_bytes"))
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+	suite.keeper.SetTicketParams(suite.ctx, types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0))
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+	// Store a ticket of another draw having the id the first bought ticket would get
+	id := make([]byte, 16)
+	types.NewRandFromCtxDrawAndIndex(suite.ctx, 1, 0).Read(id)
+	existing := types.NewTicket(hex.EncodeToString(id), 5, suite.ctx.BlockTime(), "owner", "", sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil)
+	suite.keeper.SaveTickets(suite.ctx, []types.Ticket{existing})
+
+	server := keeper.NewMsgServerImpl(suite.keeper)
+	_, err = server.BuyTickets(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgBuyTickets(types.DefaultPoolID, 1, "", addr.String(), "", nil),
+	)
+	suite.Require().ErrorIs(err, types.ErrTicketExists)
+
+	// The existing ticket is left untouched
+	stored, found := suite.keeper.GetTicket(suite.ctx, existing.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(existing, stored)
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_BuyTickets_Lotto() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	pool := types.NewPool(
+		1,
+		types.DefaultDistributionParams(),
+		types.NewDrawParams(time.Minute*1, time.Minute, 0, false),
+		types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0),
+		types.DefaultPrizeParams(),
+		types.NewGameParams(types.GameTypeLotto, 3, 10, []types.MatchTier{
+			types.NewMatchTier(3, sdk.NewDecWithPrec(80, 2)),
+			types.NewMatchTier(2, sdk.NewDecWithPrec(20, 2)),
+		}),
+		types.DefaultRolloverParams(),
+	)
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+
+	usecases := []struct {
+		name       string
+		msg        *types.MsgBuyTickets
+		shouldErr  bool
+		expNumbers [][]uint32
+	}{
+		{
+			name:      "picks sent to a random pool",
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 1, "", addr.String(), "", []types.NumbersPick{types.NewNumbersPick(1, 2, 3)}),
+			shouldErr: true,
+		},
+		{
+			name:      "missing picks",
+			msg:       types.NewMsgBuyTickets(pool.Id, 1, "", addr.String(), "", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "wrong amount of picked numbers",
+			msg:       types.NewMsgBuyTickets(pool.Id, 1, "", addr.String(), "", []types.NumbersPick{types.NewNumbersPick(1, 2)}),
+			shouldErr: true,
+		},
+		{
+			name:      "picked number out of range",
+			msg:       types.NewMsgBuyTickets(pool.Id, 1, "", addr.String(), "", []types.NumbersPick{types.NewNumbersPick(1, 2, 11)}),
+			shouldErr: true,
+		},
+		{
+			name: "valid picks",
+			msg: types.NewMsgBuyTickets(pool.Id, 2, "", addr.String(), "", []types.NumbersPick{
+				types.NewNumbersPick(10, 1, 5),
+				types.NewNumbersPick(2, 3, 4),
+			}),
+			shouldErr:  false,
+			expNumbers: [][]uint32{{1, 5, 10}, {2, 3, 4}},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SavePool(suite.ctx, pool)
+			suite.keeper.SaveCurrentDrawID(suite.ctx, pool.Id, 2)
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, pool.Id, suite.ctx.BlockTime().Add(time.Minute))
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Minute))
+			suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, addr, accBalance))
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.BuyTickets(sdk.WrapSDKContext(suite.ctx), uc.msg)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				_, tickets := suite.keeper.GetDrawParticipantsAndTickets(suite.ctx, 2)
+				suite.Require().Len(tickets, len(uc.expNumbers))
+
+				// Tickets are returned sorted by id, so the numbers are compared regardless of their order
+				numbers := make([][]uint32, len(tickets))
+				for i, ticket := range tickets {
+					numbers[i] = ticket.Numbers
+				}
+				suite.Require().ElementsMatch(uc.expNumbers, numbers)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_CommitEntropy() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+	valAddr := sdk.ValAddress(addr)
+
+	commitment := types.ComputeEntropyCommitment(valAddr, []byte("entropy"))
+
+	usecases := []struct {
+		name        string
+		validator   *stakingtypes.Validator
+		drawEndTime time.Time
+		existing    *types.EntropyCommitment
+		msg         *types.MsgCommitEntropy
+		shouldErr   bool
+	}{
+		{
+			name:        "invalid address",
+			drawEndTime: time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+			msg:         types.NewMsgCommitEntropy(types.DefaultPoolID, commitment, "address"),
+			shouldErr:   true,
+		},
+		{
+			name:        "not a validator",
+			drawEndTime: time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+			msg:         types.NewMsgCommitEntropy(types.DefaultPoolID, commitment, valAddr.String()),
+			shouldErr:   true,
+		},
+		{
+			name:        "unbonded validator",
+			validator:   &stakingtypes.Validator{OperatorAddress: valAddr.String(), Status: stakingtypes.Unbonded},
+			drawEndTime: time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+			msg:         types.NewMsgCommitEntropy(types.DefaultPoolID, commitment, valAddr.String()),
+			shouldErr:   true,
+		},
+		{
+			name:        "draw closed",
+			validator:   &stakingtypes.Validator{OperatorAddress: valAddr.String(), Status: stakingtypes.Bonded},
+			drawEndTime: time.Date(2020, 12, 31, 00, 00, 00, 000, time.UTC),
+			msg:         types.NewMsgCommitEntropy(types.DefaultPoolID, commitment, valAddr.String()),
+			shouldErr:   true,
+		},
+		{
+			name:        "already committed",
+			validator:   &stakingtypes.Validator{OperatorAddress: valAddr.String(), Status: stakingtypes.Bonded},
+			drawEndTime: time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+			existing: &types.EntropyCommitment{
+				Validator:  valAddr.String(),
+				Commitment: commitment,
+			},
+			msg:       types.NewMsgCommitEntropy(types.DefaultPoolID, commitment, valAddr.String()),
+			shouldErr: true,
+		},
+		{
+			name:        "valid commitment",
+			validator:   &stakingtypes.Validator{OperatorAddress: valAddr.String(), Status: stakingtypes.Bonded},
+			drawEndTime: time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+			msg:         types.NewMsgCommitEntropy(types.DefaultPoolID, commitment, valAddr.String()),
+			shouldErr:   false,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, uc.drawEndTime)
+			if uc.validator != nil {
+				suite.sk.SetValidator(suite.ctx, *uc.validator)
+			}
+			if uc.existing != nil {
+				suite.keeper.SaveEntropyCommitment(suite.ctx, *uc.existing)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.CommitEntropy(sdk.WrapSDKContext(suite.ctx), uc.msg)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				stored, found := suite.keeper.GetEntropyCommitment(suite.ctx, types.DefaultPoolID, valAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewEntropyCommitment(types.DefaultPoolID, valAddr.String(), commitment, nil), stored)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_RevealEntropy() {
+	addr, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+	valAddr := sdk.ValAddress(addr)
+
+	entropy := []byte("entropy")
+	commitment := types.NewEntropyCommitment(types.DefaultPoolID, valAddr.String(), types.ComputeEntropyCommitment(valAddr, entropy), nil)
+
+	usecases := []struct {
+		name        string
+		drawEndTime time.Time
+		existing    *types.EntropyCommitment
+		msg         *types.MsgRevealEntropy
+		shouldErr   bool
+	}{
+		{
+			name:        "draw still open",
+			drawEndTime: time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+			existing:    &commitment,
+			msg:         types.NewMsgRevealEntropy(types.DefaultPoolID, entropy, valAddr.String()),
+			shouldErr:   true,
+		},
+		{
+			name:        "commitment not found",
+			drawEndTime: time.Date(2020, 12, 31, 23, 59, 00, 000, time.UTC),
+			msg:         types.NewMsgRevealEntropy(types.DefaultPoolID, entropy, valAddr.String()),
+			shouldErr:   true,
+		},
+		{
+			name:        "already revealed",
+			drawEndTime: time.Date(2020, 12, 31, 23, 59, 00, 000, time.UTC),
+			existing: &types.EntropyCommitment{
+				Validator:  commitment.Validator,
+				Commitment: commitment.Commitment,
+				Entropy:    entropy,
+			},
+			msg:       types.NewMsgRevealEntropy(types.DefaultPoolID, entropy, valAddr.String()),
+			shouldErr: true,
+		},
+		{
+			name:        "entropy not matching the commitment",
+			drawEndTime: time.Date(2020, 12, 31, 23, 59, 00, 000, time.UTC),
+			existing:    &commitment,
+			msg:         types.NewMsgRevealEntropy(types.DefaultPoolID, []byte("another-entropy"), valAddr.String()),
+			shouldErr:   true,
+		},
+		{
+			name:        "valid reveal",
+			drawEndTime: time.Date(2020, 12, 31, 23, 59, 00, 000, time.UTC),
+			existing:    &commitment,
+			msg:         types.NewMsgRevealEntropy(types.DefaultPoolID, entropy, valAddr.String()),
+			shouldErr:   false,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, uc.drawEndTime)
+			if uc.existing != nil {
+				suite.keeper.SaveEntropyCommitment(suite.ctx, *uc.existing)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.RevealEntropy(sdk.WrapSDKContext(suite.ctx), uc.msg)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				stored, found := suite.keeper.GetEntropyCommitment(suite.ctx, types.DefaultPoolID, valAddr)
+				suite.Require().True(found)
+				suite.Require().True(stored.IsRevealed())
+				suite.Require().Equal(entropy, stored.Entropy)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_SelfExclude() {
+	address := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	addr, err := sdk.AccAddressFromBech32(address)
+	suite.Require().NoError(err)
+
+	usecases := []struct {
+		name         string
+		stored       []types.Exclusion
+		msg          *types.MsgSelfExclude
+		shouldErr    bool
+		expExclusion types.Exclusion
+	}{
+		{
+			name:      "end time not in the future",
+			msg:       types.NewMsgSelfExclude(address, time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)),
+			shouldErr: true,
+		},
+		{
+			name: "active exclusion shortened",
+			stored: []types.Exclusion{
+				types.NewExclusion(address, time.Date(2021, 2, 1, 00, 00, 00, 000, time.UTC)),
+			},
+			msg:       types.NewMsgSelfExclude(address, time.Date(2021, 1, 15, 00, 00, 00, 000, time.UTC)),
+			shouldErr: true,
+		},
+		{
+			name:         "new exclusion",
+			msg:          types.NewMsgSelfExclude(address, time.Date(2021, 2, 1, 00, 00, 00, 000, time.UTC)),
+			shouldErr:    false,
+			expExclusion: types.NewExclusion(address, time.Date(2021, 2, 1, 00, 00, 00, 000, time.UTC)),
+		},
+		{
+			name: "active exclusion extended",
+			stored: []types.Exclusion{
+				types.NewExclusion(address, time.Date(2021, 2, 1, 00, 00, 00, 000, time.UTC)),
+			},
+			msg:          types.NewMsgSelfExclude(address, time.Date(2021, 3, 1, 00, 00, 00, 000, time.UTC)),
+			shouldErr:    false,
+			expExclusion: types.NewExclusion(address, time.Date(2021, 3, 1, 00, 00, 00, 000, time.UTC)),
+		},
+		{
+			name: "expired exclusion replaced",
+			stored: []types.Exclusion{
+				types.NewExclusion(address, time.Date(2020, 6, 1, 00, 00, 00, 000, time.UTC)),
+			},
+			msg:          types.NewMsgSelfExclude(address, time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC)),
+			shouldErr:    false,
+			expExclusion: types.NewExclusion(address, time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC)),
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			for _, e := range uc.stored {
+				suite.keeper.SaveExclusion(suite.ctx, e)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.SelfExclude(sdk.WrapSDKContext(suite.ctx), uc.msg)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				exclusion, found := suite.keeper.GetExclusion(suite.ctx, addr)
+				suite.Require().True(found)
+				suite.Require().Equal(uc.expExclusion, exclusion)
+				suite.Require().True(suite.keeper.IsExcluded(suite.ctx, addr))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_SetSpendingLimit() {
+	address := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	blockTime := time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)
+	lowAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	highAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+
+	usecases := []struct {
+		name          string
+		stored        []types.SpendingLimit
+		storedRecords []types.SpendRecord
+		msg           *types.MsgSetSpendingLimit
+		shouldErr     bool
+		expLimits     []types.SpendingLimit
+		expRecords    []types.SpendRecord
+	}{
+		{
+			name:      "invalid address",
+			msg:       types.NewMsgSetSpendingLimit("address", lowAmount, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "new limit applied immediately",
+			msg:       types.NewMsgSetSpendingLimit(address, highAmount, time.Hour),
+			shouldErr: false,
+			expLimits: []types.SpendingLimit{
+				types.NewSpendingLimit(address, highAmount, time.Hour, nil),
+			},
+		},
+		{
+			name: "lowered limit applied immediately",
+			stored: []types.SpendingLimit{
+				types.NewSpendingLimit(address, highAmount, time.Hour, types.NewPendingSpendingLimit(
+					sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), time.Hour, blockTime.Add(time.Hour),
+				)),
+			},
+			msg:       types.NewMsgSetSpendingLimit(address, lowAmount, time.Hour*2),
+			shouldErr: false,
+			expLimits: []types.SpendingLimit{
+				types.NewSpendingLimit(address, lowAmount, time.Hour*2, nil),
+			},
+		},
+		{
+			name: "raised limit delayed",
+			stored: []types.SpendingLimit{
+				types.NewSpendingLimit(address, lowAmount, time.Hour, nil),
+			},
+			msg:       types.NewMsgSetSpendingLimit(address, highAmount, time.Hour),
+			shouldErr: false,
+			expLimits: []types.SpendingLimit{
+				types.NewSpendingLimit(address, lowAmount, time.Hour, types.NewPendingSpendingLimit(
+					highAmount, time.Hour, blockTime.Add(types.DefaultSpendingLimitIncreaseDelay),
+				)),
+			},
+		},
+		{
+			name: "removed limit delayed",
+			stored: []types.SpendingLimit{
+				types.NewSpendingLimit(address, lowAmount, time.Hour, nil),
+			},
+			msg:       types.NewMsgSetSpendingLimit(address, sdk.NewCoins(), 0),
+			shouldErr: false,
+			expLimits: []types.SpendingLimit{
+				types.NewSpendingLimit(address, lowAmount, time.Hour, types.NewPendingSpendingLimit(
+					nil, 0, blockTime.Add(types.DefaultSpendingLimitIncreaseDelay),
+				)),
+			},
+		},
+		{
+			name: "limit removed after the delay",
+			stored: []types.SpendingLimit{
+				types.NewSpendingLimit(address, lowAmount, time.Hour, types.NewPendingSpendingLimit(
+					sdk.NewCoins(), 0, blockTime.Add(-time.Minute),
+				)),
+			},
+			storedRecords: []types.SpendRecord{
+				types.NewSpendRecord(address, blockTime.Add(-time.Minute), lowAmount),
+			},
+			msg:        types.NewMsgSetSpendingLimit(address, sdk.NewCoins(), 0),
+			shouldErr:  false,
+			expLimits:  nil,
+			expRecords: nil,
+		},
+		{
+			name: "limit set again after the removal of the previous one",
+			stored: []types.SpendingLimit{
+				types.NewSpendingLimit(address, lowAmount, time.Hour, types.NewPendingSpendingLimit(
+					sdk.NewCoins(), 0, blockTime.Add(-time.Minute),
+				)),
+			},
+			storedRecords: []types.SpendRecord{
+				types.NewSpendRecord(address, blockTime.Add(-time.Minute), lowAmount),
+			},
+			msg:       types.NewMsgSetSpendingLimit(address, highAmount, time.Hour),
+			shouldErr: false,
+			expLimits: []types.SpendingLimit{
+				types.NewSpendingLimit(address, highAmount, time.Hour, nil),
+			},
+			expRecords: nil,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.ctx = suite.ctx.WithBlockTime(blockTime)
+			for _, l := range uc.stored {
+				suite.keeper.SaveSpendingLimit(suite.ctx, l)
+			}
+			for _, r := range uc.storedRecords {
+				suite.keeper.SaveSpendRecord(suite.ctx, r)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.SetSpendingLimit(sdk.WrapSDKContext(suite.ctx), uc.msg)
+
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expLimits, suite.keeper.GetSpendingLimits(suite.ctx))
+				suite.Require().Equal(uc.expRecords, suite.keeper.GetSpendRecords(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_PostPrice() {
+	oracleParams := types.NewOracleParams([]string{
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		"cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu",
+		"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+	}, time.Hour)
+
+	usecases := []struct {
+		name     string
+		posts    []types.PricePost
+		msg      *types.MsgPostPrice
+		expErr   error
+		expPrice sdk.Dec
+	}{
+		{
+			name:   "sender is not an oracle",
+			msg:    types.NewMsgPostPrice("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn", "stake", sdk.NewDec(1)),
+			expErr: types.ErrInvalidOracle,
+		},
+		{
+			name:     "first price post",
+			msg:      types.NewMsgPostPrice("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "stake", sdk.NewDec(2)),
+			expPrice: sdk.NewDec(2),
+		},
+		{
+			name: "median of the oracles prices",
+			posts: []types.PricePost{
+				types.NewPricePost("stake", "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu", sdk.NewDec(1), suite.ctx.BlockTime()),
+				types.NewPricePost("stake", "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", sdk.NewDec(10), suite.ctx.BlockTime()),
+			},
+			msg:      types.NewMsgPostPrice("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "stake", sdk.NewDec(2)),
+			expPrice: sdk.NewDec(2),
+		},
+		{
+			name: "previous price of the same oracle is replaced",
+			posts: []types.PricePost{
+				types.NewPricePost("stake", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewDec(100), suite.ctx.BlockTime()),
+				types.NewPricePost("stake", "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu", sdk.NewDec(4), suite.ctx.BlockTime()),
+			},
+			msg:      types.NewMsgPostPrice("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "stake", sdk.NewDec(2)),
+			expPrice: sdk.NewDec(3),
+		},
+		{
+			name: "stale posts are ignored",
+			posts: []types.PricePost{
+				types.NewPricePost("stake", "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu", sdk.NewDec(10), suite.ctx.BlockTime().Add(-2*time.Hour)),
+			},
+			msg:      types.NewMsgPostPrice("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "stake", sdk.NewDec(2)),
+			expPrice: sdk.NewDec(2),
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SetOracleParams(suite.ctx, oracleParams)
+			for _, post := range uc.posts {
+				suite.keeper.SavePricePost(suite.ctx, post)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.PostPrice(sdk.WrapSDKContext(suite.ctx), uc.msg)
+
+			if uc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, uc.expErr)
+
+				_, found := suite.keeper.GetPrice(suite.ctx, uc.msg.Denom)
+				suite.Require().False(found)
+			} else {
+				suite.Require().NoError(err)
+
+				price, found := suite.keeper.GetPrice(suite.ctx, uc.msg.Denom)
+				suite.Require().True(found)
+				suite.Require().True(uc.expPrice.Equal(price.Price), "expected %s, got %s", uc.expPrice, price.Price)
+				suite.Require().Equal(suite.ctx.BlockTime(), price.Timestamp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_TransferTickets() {
+	owner, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	recipient, err := sdk.AccAddressFromBech32("cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu")
+	suite.Require().NoError(err)
+
+	other, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	suite.Require().NoError(err)
+
+	drawParams := types.NewDrawParams(time.Hour, time.Minute, time.Minute*10, true)
+	ticketParams := types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 3, 0)
+	price := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	usecases := []struct {
+		name        string
+		drawEndTime time.Time
+		upcomingID  uint64
+		exclusions  []types.Exclusion
+		stored      []types.Ticket
+		ticketIDs   []string
+		expErr      error
+		expTickets  []types.Ticket
+	}{
+		{
+			name:        "recipient has excluded itself",
+			drawEndTime: suite.ctx.BlockTime().Add(time.Hour),
+			exclusions: []types.Exclusion{
+				types.NewExclusion(recipient.String(), suite.ctx.BlockTime().Add(time.Hour)),
+			},
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, timestamp, owner.String(), "", price, nil),
+			},
+			ticketIDs: []string{"ticket-1"},
+			expErr:    types.ErrSelfExcluded,
+		},
+		{
+			name:        "ticket not found",
+			drawEndTime: suite.ctx.BlockTime().Add(time.Hour),
+			ticketIDs:   []string{"ticket-1"},
+			expErr:      types.ErrTicketNotFound,
+		},
+		{
+			name:        "ticket not owned by the sender",
+			drawEndTime: suite.ctx.BlockTime().Add(time.Hour),
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, timestamp, owner.String(), "", price, nil),
+				types.NewTicket("ticket-2", 1, timestamp, other.String(), "", price, nil),
+			},
+			ticketIDs: []string{"ticket-1", "ticket-2"},
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:        "ticket transferred during the sales cutoff",
+			drawEndTime: suite.ctx.BlockTime().Add(time.Minute * 5),
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, timestamp, owner.String(), "", price, nil),
+			},
+			ticketIDs: []string{"ticket-1"},
+			expErr:    types.ErrSalesCutoff,
+		},
+		{
+			name:        "ticket of a past draw",
+			drawEndTime: suite.ctx.BlockTime().Add(time.Hour),
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 3, timestamp, owner.String(), "", price, nil),
+			},
+			ticketIDs: []string{"ticket-1"},
+			expErr:    types.ErrDrawClosed,
+		},
+		{
+			name:        "recipient exceeding the tickets per address limit",
+			drawEndTime: suite.ctx.BlockTime().Add(time.Hour),
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, timestamp, owner.String(), "", price, nil),
+				types.NewTicket("ticket-2", 1, timestamp, owner.String(), "", price, nil),
+				types.NewTicket("ticket-3", 1, timestamp, recipient.String(), "", price, nil),
+				types.NewTicket("ticket-4", 1, timestamp, recipient.String(), "", price, nil),
+			},
+			ticketIDs: []string{"ticket-1", "ticket-2"},
+			expErr:    types.ErrMaxTicketsPerAddress,
+		},
+		{
+			name:        "tickets transferred correctly",
+			drawEndTime: suite.ctx.BlockTime().Add(time.Hour),
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 1, timestamp, owner.String(), "", price, nil),
+				types.NewTicket("ticket-2", 1, timestamp, owner.String(), other.String(), price, nil),
+				types.NewTicket("ticket-3", 1, timestamp, owner.String(), "", price, nil),
+			},
+			ticketIDs: []string{"ticket-1", "ticket-2"},
+			expTickets: []types.Ticket{
+				asTransferred(types.NewTicket("ticket-1", 1, timestamp, recipient.String(), "", price, nil)),
+				asTransferred(types.NewTicket("ticket-2", 1, timestamp, recipient.String(), other.String(), price, nil)),
+				types.NewTicket("ticket-3", 1, timestamp, owner.String(), "", price, nil),
+			},
+		},
+		{
+			name:        "tickets of the upcoming draw transferred during the sales cutoff",
+			drawEndTime: suite.ctx.BlockTime().Add(time.Minute * 5),
+			upcomingID:  2,
+			stored: []types.Ticket{
+				types.NewTicket("ticket-1", 2, timestamp, owner.String(), "", price, nil),
+			},
+			ticketIDs: []string{"ticket-1"},
+			expTickets: []types.Ticket{
+				asTransferred(types.NewTicket("ticket-1", 2, timestamp, recipient.String(), "", price, nil)),
+			},
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawID(suite.ctx, types.DefaultPoolID, 1)
+			suite.keeper.SetNextDrawID(suite.ctx, 2)
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, uc.drawEndTime)
+			if uc.upcomingID != 0 {
+				suite.keeper.SaveUpcomingDrawID(suite.ctx, types.DefaultPoolID, uc.upcomingID)
+			}
+			suite.keeper.SetDrawParams(suite.ctx, drawParams)
+			suite.keeper.SetTicketParams(suite.ctx, ticketParams)
+			suite.keeper.SaveTickets(suite.ctx, uc.stored)
+			for _, exclusion := range uc.exclusions {
+				suite.keeper.SaveExclusion(suite.ctx, exclusion)
+			}
+
+			server := keeper.NewMsgServerImpl(suite.keeper)
+			_, err := server.TransferTickets(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgTransferTickets(types.DefaultPoolID, uc.ticketIDs, owner.String(), recipient.String()),
+			)
+
+			if uc.expErr != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, uc.expErr)
+				suite.Require().Equal(uc.stored, suite.keeper.GetTickets(suite.ctx))
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uc.expTickets, suite.keeper.GetTickets(suite.ctx))
+
+				// The owners counters and index are updated
+				drawID := uc.expTickets[0].DrawId
+				suite.Require().Equal(uint32(len(uc.ticketIDs)), suite.keeper.GetDrawOwnerTicketsCount(suite.ctx, drawID, recipient.String()))
+				suite.Require().Equal(uint32(len(uc.stored)-len(uc.ticketIDs)), suite.keeper.GetDrawOwnerTicketsCount(suite.ctx, drawID, owner.String()))
+				suite.Require().Equal(uint32(len(uc.stored)), suite.keeper.GetDrawTicketsCount(suite.ctx, drawID))
+
+				querier := keeper.NewQuerierImpl(suite.keeper)
+				res, err := querier.TicketsByOwner(sdk.WrapSDKContext(suite.ctx), types.NewTicketsByOwnerRequest(recipient.String(), nil))
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Tickets, len(uc.ticketIDs))
+
+				// Check that an event is emitted for each transferred ticket
+				var transferEvents int
+				for _, event := range suite.ctx.EventManager().Events() {
+					if event.Type != types.EventTypeTransferTicket {
+						continue
+					}
+
+					transferEvents++
+					attributes := map[string]string{}
+					for _, attr := range event.Attributes {
+						attributes[string(attr.Key)] = string(attr.Value)
+					}
+					suite.Require().Equal(owner.String(), attributes[types.AttributeKeyTicketSender])
+					suite.Require().Equal(recipient.String(), attributes[types.AttributeKeyTicketRecipient])
+				}
+				suite.Require().Equal(len(uc.ticketIDs), transferEvents)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_MsgServer_TransferTickets_Refund() {
+	buyer, err := sdk.AccAddressFromBech32("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl")
+	suite.Require().NoError(err)
+
+	recipient, err := sdk.AccAddressFromBech32("cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu")
+	suite.Require().NoError(err)
+
+	accBalance := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	suite.SetupTest()
+	suite.keeper.SaveCurrentDrawEndTime(suite.ctx, types.DefaultPoolID, suite.ctx.BlockTime().Add(time.Hour))
+	suite.keeper.SetTicketParams(suite.ctx, types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil, sdk.ZeroDec(), 0, 0))
+	suite.keeper.SetDistributionParams(suite.ctx, types.NewDistributionParams(
+		sdk.NewDecWithPrec(50, 2), sdk.NewDecWithPrec(30, 2), sdk.NewDecWithPrec(20, 2), types.FeeDestinationFeeCollector,
+	))
+	suite.bk.SetSupply(suite.ctx, banktypes.NewSupply(accBalance))
+	suite.Require().NoError(suite.bk.SetBalances(suite.ctx, buyer, accBalance))
+
+	server := keeper.NewMsgServerImpl(suite.keeper)
+	_, err = server.BuyTickets(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgBuyTickets(types.DefaultPoolID, 2, "", buyer.String(), "", nil),
+	)
+	suite.Require().NoError(err)
+
+	tickets := suite.keeper.GetTickets(suite.ctx)
+	suite.Require().Len(tickets, 2)
+	_, err = server.TransferTickets(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgTransferTickets(types.DefaultPoolID, []string{tickets[0].Id}, buyer.String(), recipient.String()),
+	)
+	suite.Require().NoError(err)
+
+	// The transferred ticket keeps its payer
+	transferred, found := suite.keeper.GetTicket(suite.ctx, tickets[0].Id)
+	suite.Require().True(found)
+	suite.Require().Equal(buyer.String(), transferred.Payer)
+	suite.Require().Equal(recipient.String(), transferred.Owner)
+	suite.Require().True(transferred.Transferred)
+
+	// The prize share of the transferred ticket is refunded to its new owner, not to the buyer
+	pool, found := suite.keeper.GetPool(suite.ctx, types.DefaultPoolID)
+	suite.Require().True(found)
+	_, err = suite.keeper.RefundDrawTickets(suite.ctx, pool, 1)
+	suite.Require().NoError(err)
+
+	spent := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+	refund := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	suite.Require().Equal(accBalance.Sub(spent).Add(refund...), suite.bk.GetAllBalances(suite.ctx, buyer))
+	suite.Require().Equal(refund, suite.bk.GetAllBalances(suite.ctx, recipient))
+}
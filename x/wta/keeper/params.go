@@ -27,6 +27,48 @@ func (k Keeper) GetTicketParams(ctx sdk.Context) types.TicketParams {
 	return p
 }
 
+// GetPrizeParams returns the current PrizeParams from the global param store
+func (k Keeper) GetPrizeParams(ctx sdk.Context) types.PrizeParams {
+	var p types.PrizeParams
+	k.paramSubspace.Get(ctx, types.ParamStorePrizeParamsKey, &p)
+	return p
+}
+
+// GetRolloverParams returns the current RolloverParams from the global param store
+func (k Keeper) GetRolloverParams(ctx sdk.Context) types.RolloverParams {
+	var p types.RolloverParams
+	k.paramSubspace.Get(ctx, types.ParamStoreRolloverParamsKey, &p)
+	return p
+}
+
+// GetPruningParams returns the current PruningParams from the global param store
+func (k Keeper) GetPruningParams(ctx sdk.Context) types.PruningParams {
+	var p types.PruningParams
+	k.paramSubspace.Get(ctx, types.ParamStorePruningParamsKey, &p)
+	return p
+}
+
+// GetSalesParams returns the current SalesParams from the global param store
+func (k Keeper) GetSalesParams(ctx sdk.Context) types.SalesParams {
+	var p types.SalesParams
+	k.paramSubspace.Get(ctx, types.ParamStoreSalesParamsKey, &p)
+	return p
+}
+
+// GetSpendingLimitParams returns the current SpendingLimitParams from the global param store
+func (k Keeper) GetSpendingLimitParams(ctx sdk.Context) types.SpendingLimitParams {
+	var p types.SpendingLimitParams
+	k.paramSubspace.Get(ctx, types.ParamStoreSpendingLimitParamsKey, &p)
+	return p
+}
+
+// GetOracleParams returns the current OracleParams from the global param store
+func (k Keeper) GetOracleParams(ctx sdk.Context) types.OracleParams {
+	var p types.OracleParams
+	k.paramSubspace.Get(ctx, types.ParamStoreOracleParamsKey, &p)
+	return p
+}
+
 // SetDistributionParams sets DistributionParams to the global param store
 func (k Keeper) SetDistributionParams(ctx sdk.Context, params types.DistributionParams) {
 	k.paramSubspace.Set(ctx, types.ParamStoreDistributionParamsKey, &params)
@@ -41,3 +83,33 @@ func (k Keeper) SetDrawParams(ctx sdk.Context, params types.DrawParams) {
 func (k Keeper) SetTicketParams(ctx sdk.Context, params types.TicketParams) {
 	k.paramSubspace.Set(ctx, types.ParamStoreTicketParamsKey, &params)
 }
+
+// SetPrizeParams sets PrizeParams to the global param store
+func (k Keeper) SetPrizeParams(ctx sdk.Context, params types.PrizeParams) {
+	k.paramSubspace.Set(ctx, types.ParamStorePrizeParamsKey, &params)
+}
+
+// SetRolloverParams sets RolloverParams to the global param store
+func (k Keeper) SetRolloverParams(ctx sdk.Context, params types.RolloverParams) {
+	k.paramSubspace.Set(ctx, types.ParamStoreRolloverParamsKey, &params)
+}
+
+// SetPruningParams sets PruningParams to the global param store
+func (k Keeper) SetPruningParams(ctx sdk.Context, params types.PruningParams) {
+	k.paramSubspace.Set(ctx, types.ParamStorePruningParamsKey, &params)
+}
+
+// SetSalesParams sets SalesParams to the global param store
+func (k Keeper) SetSalesParams(ctx sdk.Context, params types.SalesParams) {
+	k.paramSubspace.Set(ctx, types.ParamStoreSalesParamsKey, &params)
+}
+
+// SetSpendingLimitParams sets SpendingLimitParams to the global param store
+func (k Keeper) SetSpendingLimitParams(ctx sdk.Context, params types.SpendingLimitParams) {
+	k.paramSubspace.Set(ctx, types.ParamStoreSpendingLimitParamsKey, &params)
+}
+
+// SetOracleParams sets OracleParams to the global param store
+func (k Keeper) SetOracleParams(ctx sdk.Context, params types.OracleParams) {
+	k.paramSubspace.Set(ctx, types.ParamStoreOracleParamsKey, &params)
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+// HandleCancelDrawProposal cancels the current draw of the pool specified inside the given proposal,
+// refunding all its tickets to their owners and starting a new draw.
+// The entropy committed for the cancelled draw is discarded, so that it is not used to seed the new one
+func (k Keeper) HandleCancelDrawProposal(ctx sdk.Context, proposal *types.CancelDrawProposal) error {
+	pool, found := k.GetPool(ctx, proposal.PoolId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", proposal.PoolId)
+	}
+
+	drawID := k.GetCurrentDrawID(ctx, pool.Id)
+	refunded, err := k.CancelCurrentDraw(ctx, pool, types.DrawStatusCancelled, k.GetCurrentDrawRollovers(ctx, pool.Id))
+	if err != nil {
+		return err
+	}
+
+	k.DeletePoolEntropyCommitments(ctx, pool.Id)
+	endTime := ctx.BlockTime().Add(pool.DrawParams.Duration)
+	k.SaveCurrentDrawEndTime(ctx, pool.Id, endTime)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDrawCancelled,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(drawID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundedAmount, refunded.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeNewDraw,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDrawClosing, endTime.Format(time.RFC3339)),
+		),
+	})
+
+	return nil
+}
+
+// HandleCreatePoolProposal creates the pool contained inside the given proposal, starting its first draw
+func (k Keeper) HandleCreatePoolProposal(ctx sdk.Context, proposal *types.CreatePoolProposal) error {
+	pool := proposal.Pool
+	if k.HasPool(ctx, pool.Id) {
+		return sdkerrors.Wrapf(types.ErrPoolExists, "%d", pool.Id)
+	}
+
+	k.SavePool(ctx, pool)
+	drawID := k.AssignNextDrawID(ctx, pool.Id)
+	endTime := ctx.BlockTime().Add(pool.DrawParams.Duration)
+	k.SaveCurrentDrawEndTime(ctx, pool.Id, endTime)
+	k.SaveCurrentDrawRollovers(ctx, pool.Id, 0)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePoolCreated,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(drawID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDrawClosing, endTime.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// HandlePauseSalesProposal pauses the ticket sales of the pool specified inside the given proposal
+func (k Keeper) HandlePauseSalesProposal(ctx sdk.Context, proposal *types.PauseSalesProposal) error {
+	if !k.HasPool(ctx, proposal.PoolId) {
+		return sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", proposal.PoolId)
+	}
+
+	k.SetPoolSalesPaused(ctx, proposal.PoolId, true)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSalesPaused,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(proposal.PoolId, 10)),
+		),
+	)
+
+	return nil
+}
+
+// HandleResumeSalesProposal resumes the ticket sales of the pool specified inside the given proposal
+func (k Keeper) HandleResumeSalesProposal(ctx sdk.Context, proposal *types.ResumeSalesProposal) error {
+	if !k.HasPool(ctx, proposal.PoolId) {
+		return sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", proposal.PoolId)
+	}
+
+	k.SetPoolSalesPaused(ctx, proposal.PoolId, false)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSalesResumed,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(proposal.PoolId, 10)),
+		),
+	)
+
+	return nil
+}
+
+// HandleCloseDrawProposal sets the end time of the current draw of the pool specified inside the given proposal
+// to the current block time, so that its winners are drawn as soon as the entropy reveal window ends
+func (k Keeper) HandleCloseDrawProposal(ctx sdk.Context, proposal *types.CloseDrawProposal) error {
+	if !k.HasPool(ctx, proposal.PoolId) {
+		return sdkerrors.Wrapf(types.ErrPoolNotFound, "%d", proposal.PoolId)
+	}
+
+	if !k.GetCurrentDrawEndTime(ctx, proposal.PoolId).After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrDrawClosed, "current draw of pool %d", proposal.PoolId)
+	}
+
+	k.SaveCurrentDrawEndTime(ctx, proposal.PoolId, ctx.BlockTime())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDrawClosed,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(proposal.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDrawID, strconv.FormatUint(k.GetCurrentDrawID(ctx, proposal.PoolId), 10)),
+		),
+	)
+
+	return nil
+}
+
+// HandleSetSalesHaltedProposal halts or restarts the ticket sales of all the pools based on the given proposal
+func (k Keeper) HandleSetSalesHaltedProposal(ctx sdk.Context, proposal *types.SetSalesHaltedProposal) error {
+	params := types.NewSalesParams(proposal.Halted, proposal.HaltReason)
+	err := types.ValidateSalesParams(params)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetSalesParams(ctx, params)
+	k.saveKnownSalesHalted(ctx, params.Halted)
+	k.emitSalesHaltedEvent(ctx, params)
+
+	return nil
+}
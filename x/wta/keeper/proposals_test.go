@@ -0,0 +1,372 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wtatypes "github.com/cosmicbet/ledger/x/wta/types"
+)
+
+func (suite *KeeperTestSuite) Test_HandleCancelDrawProposal() {
+	pool := wtatypes.NewPool(
+		1,
+		wtatypes.NewDistributionParams(sdk.NewDecWithPrec(50, 2), sdk.NewDecWithPrec(30, 2), sdk.NewDecWithPrec(20, 2), wtatypes.FeeDestinationFeeCollector),
+		wtatypes.NewDrawParams(time.Hour, time.Minute, 0, false),
+		wtatypes.NewTicketParams(sdk.NewInt64Coin("stake", 10), nil, sdk.ZeroDec(), 0, 0),
+		wtatypes.DefaultPrizeParams(),
+		wtatypes.DefaultGameParams(),
+		wtatypes.DefaultRolloverParams(),
+	)
+
+	firstOwner := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	secondOwner := "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"
+	endTime := time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC)
+
+	usecases := []struct {
+		name      string
+		proposal  *wtatypes.CancelDrawProposal
+		shouldErr bool
+	}{
+		{
+			name:      "pool not found",
+			proposal:  wtatypes.NewCancelDrawProposal("Title", "Description", 2),
+			shouldErr: true,
+		},
+		{
+			name:      "draw cancelled properly",
+			proposal:  wtatypes.NewCancelDrawProposal("Title", "Description", pool.Id),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SavePool(suite.ctx, pool)
+			suite.keeper.SaveCurrentDrawID(suite.ctx, pool.Id, 5)
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, pool.Id, endTime)
+			suite.keeper.SaveCurrentDrawRollovers(suite.ctx, pool.Id, 2)
+			suite.keeper.SetNextDrawID(suite.ctx, 6)
+			suite.keeper.SaveTickets(suite.ctx, []wtatypes.Ticket{
+				withPrizeShare(wtatypes.NewTicket("1", 5, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), firstOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+				withPrizeShare(wtatypes.NewTicket("2", 5, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), firstOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+				withPrizeShare(wtatypes.NewTicket("3", 5, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), secondOwner, "", sdk.NewInt64Coin("stake", 10), nil), sdk.NewInt64Coin("stake", 5)),
+			})
+
+			collector := wtatypes.PoolPrizeCollectorAddress(pool.Id)
+			suite.Require().NoError(suite.bk.SetBalances(suite.ctx, collector, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))))
+
+			valAddr := sdk.ValAddress("validator-address---")
+			poolCommitment := wtatypes.NewEntropyCommitment(
+				pool.Id, valAddr.String(), wtatypes.ComputeEntropyCommitment(valAddr, []byte("entropy")), nil,
+			)
+			defaultPoolCommitment := wtatypes.NewEntropyCommitment(
+				wtatypes.DefaultPoolID, valAddr.String(), wtatypes.ComputeEntropyCommitment(valAddr, []byte("entropy")), nil,
+			)
+			suite.keeper.SaveEntropyCommitment(suite.ctx, poolCommitment)
+			suite.keeper.SaveEntropyCommitment(suite.ctx, defaultPoolCommitment)
+
+			err := suite.keeper.HandleCancelDrawProposal(suite.ctx, uc.proposal)
+			if uc.shouldErr {
+				suite.Require().Error(err)
+				suite.Require().Len(suite.keeper.GetTickets(suite.ctx), 3)
+				suite.Require().Equal(uint64(5), suite.keeper.GetCurrentDrawID(suite.ctx, pool.Id))
+				suite.Require().Equal(endTime, suite.keeper.GetCurrentDrawEndTime(suite.ctx, pool.Id))
+				suite.Require().Len(suite.keeper.GetEntropyCommitments(suite.ctx), 2)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			// Check the tickets removal and the new draw
+			suite.Require().Equal(
+				[]wtatypes.PruningDraw{wtatypes.NewPruningDraw(5, 3)},
+				suite.keeper.GetPruningQueue(suite.ctx),
+			)
+			suite.keeper.PruneTickets(suite.ctx)
+			suite.Require().Empty(suite.keeper.GetTickets(suite.ctx))
+			suite.Require().Equal(uint64(6), suite.keeper.GetCurrentDrawID(suite.ctx, pool.Id))
+			suite.Require().Equal(uint64(7), suite.keeper.GetNextDrawID(suite.ctx))
+			suite.Require().Equal(uint32(0), suite.keeper.GetCurrentDrawRollovers(suite.ctx, pool.Id))
+			suite.Require().Equal(suite.ctx.BlockTime().Add(time.Hour), suite.keeper.GetCurrentDrawEndTime(suite.ctx, pool.Id))
+
+			// Check that only the commitments of the cancelled draw have been deleted, without counting missed reveals
+			suite.Require().Equal(
+				[]wtatypes.EntropyCommitment{defaultPoolCommitment},
+				suite.keeper.GetEntropyCommitments(suite.ctx),
+			)
+			suite.Require().Zero(suite.keeper.GetMissedReveals(suite.ctx, valAddr))
+
+			// Check the past draw
+			data, found := suite.keeper.GetHistoricalDraw(suite.ctx, 5)
+			suite.Require().True(found)
+			suite.Require().Equal(wtatypes.DrawStatusCancelled, data.Status)
+			suite.Require().Equal(uint32(2), data.Rollovers)
+			suite.Require().Equal(uint32(2), data.Draw.Participants)
+			suite.Require().Equal(uint32(3), data.Draw.TicketsSold)
+
+			// Check the refunds
+			firstAddr, err := sdk.AccAddressFromBech32(firstOwner)
+			suite.Require().NoError(err)
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, firstAddr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+
+			secondAddr, err := sdk.AccAddressFromBech32(secondOwner)
+			suite.Require().NoError(err)
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, secondAddr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 5))))
+
+			suite.Require().True(suite.bk.GetAllBalances(suite.ctx, collector).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 5))))
+
+			// Check the events
+			var refundEvents, cancelEvents, newDrawEvents int
+			for _, event := range suite.ctx.EventManager().Events() {
+				switch event.Type {
+				case wtatypes.EventTypeTicketsRefund:
+					refundEvents++
+				case wtatypes.EventTypeDrawCancelled:
+					cancelEvents++
+				case wtatypes.EventTypeNewDraw:
+					newDrawEvents++
+				}
+			}
+			suite.Require().Equal(2, refundEvents)
+			suite.Require().Equal(1, cancelEvents)
+			suite.Require().Equal(1, newDrawEvents)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_HandleCreatePoolProposal() {
+	pool := wtatypes.NewPool(
+		1,
+		wtatypes.NewDistributionParams(sdk.NewDecWithPrec(50, 2), sdk.NewDecWithPrec(30, 2), sdk.NewDecWithPrec(20, 2), wtatypes.FeeDestinationFeeCollector),
+		wtatypes.NewDrawParams(time.Hour, time.Minute, 0, false),
+		wtatypes.NewTicketParams(sdk.NewInt64Coin("stake", 10), nil, sdk.ZeroDec(), 0, 0),
+		wtatypes.DefaultPrizeParams(),
+		wtatypes.DefaultGameParams(),
+		wtatypes.DefaultRolloverParams(),
+	)
+
+	usecases := []struct {
+		name      string
+		stored    bool
+		shouldErr bool
+	}{
+		{
+			name:      "pool already exists",
+			stored:    true,
+			shouldErr: true,
+		},
+		{
+			name:      "pool created properly",
+			stored:    false,
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SetNextDrawID(suite.ctx, 2)
+			if uc.stored {
+				suite.keeper.SavePool(suite.ctx, pool)
+				suite.keeper.SaveCurrentDrawID(suite.ctx, pool.Id, 1)
+			}
+
+			proposal := wtatypes.NewCreatePoolProposal("Title", "Description", pool)
+			err := suite.keeper.HandleCreatePoolProposal(suite.ctx, proposal)
+			if uc.shouldErr {
+				suite.Require().Error(err)
+				suite.Require().Equal(uint64(2), suite.keeper.GetNextDrawID(suite.ctx))
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			stored, found := suite.keeper.GetPool(suite.ctx, pool.Id)
+			suite.Require().True(found)
+			suite.Require().Equal(pool, stored)
+
+			suite.Require().Equal(uint64(2), suite.keeper.GetCurrentDrawID(suite.ctx, pool.Id))
+			suite.Require().Equal(uint64(3), suite.keeper.GetNextDrawID(suite.ctx))
+			suite.Require().Equal(uint32(0), suite.keeper.GetCurrentDrawRollovers(suite.ctx, pool.Id))
+			suite.Require().Equal(
+				suite.ctx.BlockTime().Add(time.Hour),
+				suite.keeper.GetCurrentDrawEndTime(suite.ctx, pool.Id),
+			)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_HandlePauseSalesProposal() {
+	usecases := []struct {
+		name      string
+		proposal  *wtatypes.PauseSalesProposal
+		shouldErr bool
+	}{
+		{
+			name:      "pool not found",
+			proposal:  wtatypes.NewPauseSalesProposal("Title", "Description", 2),
+			shouldErr: true,
+		},
+		{
+			name:      "sales paused properly",
+			proposal:  wtatypes.NewPauseSalesProposal("Title", "Description", wtatypes.DefaultPoolID),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			err := suite.keeper.HandlePauseSalesProposal(suite.ctx, uc.proposal)
+			if uc.shouldErr {
+				suite.Require().Error(err)
+				suite.Require().Empty(suite.keeper.GetPausedPools(suite.ctx))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().True(suite.keeper.IsPoolSalesPaused(suite.ctx, uc.proposal.PoolId))
+			suite.Require().Equal([]uint64{uc.proposal.PoolId}, suite.keeper.GetPausedPools(suite.ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_HandleResumeSalesProposal() {
+	usecases := []struct {
+		name      string
+		proposal  *wtatypes.ResumeSalesProposal
+		shouldErr bool
+	}{
+		{
+			name:      "pool not found",
+			proposal:  wtatypes.NewResumeSalesProposal("Title", "Description", 2),
+			shouldErr: true,
+		},
+		{
+			name:      "sales resumed properly",
+			proposal:  wtatypes.NewResumeSalesProposal("Title", "Description", wtatypes.DefaultPoolID),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SetPoolSalesPaused(suite.ctx, wtatypes.DefaultPoolID, true)
+
+			err := suite.keeper.HandleResumeSalesProposal(suite.ctx, uc.proposal)
+			if uc.shouldErr {
+				suite.Require().Error(err)
+				suite.Require().True(suite.keeper.IsPoolSalesPaused(suite.ctx, wtatypes.DefaultPoolID))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().False(suite.keeper.IsPoolSalesPaused(suite.ctx, wtatypes.DefaultPoolID))
+			suite.Require().Empty(suite.keeper.GetPausedPools(suite.ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_HandleCloseDrawProposal() {
+	usecases := []struct {
+		name        string
+		drawEndTime time.Time
+		proposal    *wtatypes.CloseDrawProposal
+		shouldErr   bool
+	}{
+		{
+			name:        "pool not found",
+			drawEndTime: time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+			proposal:    wtatypes.NewCloseDrawProposal("Title", "Description", 2),
+			shouldErr:   true,
+		},
+		{
+			name:        "draw already closed",
+			drawEndTime: time.Date(2020, 12, 31, 00, 00, 00, 000, time.UTC),
+			proposal:    wtatypes.NewCloseDrawProposal("Title", "Description", wtatypes.DefaultPoolID),
+			shouldErr:   true,
+		},
+		{
+			name:        "draw closed properly",
+			drawEndTime: time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+			proposal:    wtatypes.NewCloseDrawProposal("Title", "Description", wtatypes.DefaultPoolID),
+			shouldErr:   false,
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SaveCurrentDrawEndTime(suite.ctx, wtatypes.DefaultPoolID, uc.drawEndTime)
+
+			err := suite.keeper.HandleCloseDrawProposal(suite.ctx, uc.proposal)
+			if uc.shouldErr {
+				suite.Require().Error(err)
+				suite.Require().Equal(uc.drawEndTime, suite.keeper.GetCurrentDrawEndTime(suite.ctx, wtatypes.DefaultPoolID))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.ctx.BlockTime(), suite.keeper.GetCurrentDrawEndTime(suite.ctx, wtatypes.DefaultPoolID))
+			suite.Require().False(suite.keeper.IsCurrentDrawOpen(suite.ctx, wtatypes.DefaultPoolID))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_HandleSetSalesHaltedProposal() {
+	usecases := []struct {
+		name      string
+		stored    wtatypes.SalesParams
+		proposal  *wtatypes.SetSalesHaltedProposal
+		shouldErr bool
+		expEvent  string
+		expParams wtatypes.SalesParams
+	}{
+		{
+			name:      "invalid halt reason",
+			stored:    wtatypes.DefaultSalesParams(),
+			proposal:  wtatypes.NewSetSalesHaltedProposal("Title", "Description", true, ""),
+			shouldErr: true,
+			expParams: wtatypes.DefaultSalesParams(),
+		},
+		{
+			name:      "sales halted properly",
+			stored:    wtatypes.DefaultSalesParams(),
+			proposal:  wtatypes.NewSetSalesHaltedProposal("Title", "Description", true, "Wrong ticket price"),
+			shouldErr: false,
+			expEvent:  wtatypes.EventTypeSalesHalted,
+			expParams: wtatypes.NewSalesParams(true, "Wrong ticket price"),
+		},
+		{
+			name:      "sales unhalted properly",
+			stored:    wtatypes.NewSalesParams(true, "Wrong ticket price"),
+			proposal:  wtatypes.NewSetSalesHaltedProposal("Title", "Description", false, ""),
+			shouldErr: false,
+			expEvent:  wtatypes.EventTypeSalesUnhalted,
+			expParams: wtatypes.DefaultSalesParams(),
+		},
+	}
+
+	for _, uc := range usecases {
+		suite.SetupTest()
+		suite.Run(uc.name, func() {
+			suite.keeper.SetSalesParams(suite.ctx, uc.stored)
+
+			err := suite.keeper.HandleSetSalesHaltedProposal(suite.ctx, uc.proposal)
+			if uc.shouldErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				events := suite.ctx.EventManager().Events()
+				suite.Require().Len(events, 1)
+				suite.Require().Equal(uc.expEvent, events[0].Type)
+			}
+
+			suite.Require().Equal(uc.expParams, suite.keeper.GetSalesParams(suite.ctx))
+		})
+	}
+}
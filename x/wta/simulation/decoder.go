@@ -22,19 +22,121 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &ticketB)
 			return fmt.Sprintf("TicketA: %s\nTicketB: %s\n", &ticketA, &ticketB)
 
+		case bytes.HasPrefix(kvA.Key, types.OwnerTicketsStorePrefix):
+			return fmt.Sprintf("OwnerTicketIDA: %s\nOwnerTicketIDB: %s\n", kvA.Value, kvB.Value)
+
 		case bytes.HasPrefix(kvA.Key, types.HistoricalDrawStorePrefix):
 			var dataA, dataB types.HistoricalDrawData
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &dataA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &dataB)
 			return fmt.Sprintf("HistoricalDataA: %s\nHistoricalDataB: %s\n", &dataA, &dataB)
 
-		case bytes.Equal(kvA.Key, types.CurrentDrawEndTimeStoreKey):
+		case bytes.HasPrefix(kvA.Key, types.EntropyCommitmentsStorePrefix):
+			var commitmentA, commitmentB types.EntropyCommitment
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &commitmentA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &commitmentB)
+			return fmt.Sprintf("EntropyCommitmentA: %s\nEntropyCommitmentB: %s\n", &commitmentA, &commitmentB)
+
+		case bytes.HasPrefix(kvA.Key, types.MissedRevealsStorePrefix):
+			return fmt.Sprintf("MissedRevealsA: %d\nMissedRevealsB: %d\n",
+				types.MustUnmarshalMissedRevealsCount(kvA.Value), types.MustUnmarshalMissedRevealsCount(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.PoolsStorePrefix):
+			var poolA, poolB types.Pool
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &poolA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &poolB)
+			return fmt.Sprintf("PoolA: %s\nPoolB: %s\n", &poolA, &poolB)
+
+		case bytes.HasPrefix(kvA.Key, types.CurrentDrawEndTimeStorePrefix):
 			var drawA, drawB time.Time
 			drawA = types.MustUnmarshalDrawEndTime(kvA.Value)
 			drawB = types.MustUnmarshalDrawEndTime(kvB.Value)
 			return fmt.Sprintf("CurrentDrawEndTimeA: %s\nCurrentDrawEndTimeB: %s\n",
 				drawA.Format(time.RFC3339), drawB.Format(time.RFC3339))
 
+		case bytes.HasPrefix(kvA.Key, types.CurrentDrawIDStorePrefix):
+			return fmt.Sprintf("CurrentDrawIDA: %d\nCurrentDrawIDB: %d\n",
+				types.MustUnmarshalDrawID(kvA.Value), types.MustUnmarshalDrawID(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.CurrentDrawRolloversStorePrefix):
+			return fmt.Sprintf("CurrentDrawRolloversA: %d\nCurrentDrawRolloversB: %d\n",
+				types.MustUnmarshalDrawRollovers(kvA.Value), types.MustUnmarshalDrawRollovers(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.DrawTicketsCountStorePrefix):
+			return fmt.Sprintf("DrawTicketsCountA: %d\nDrawTicketsCountB: %d\n",
+				types.MustUnmarshalTicketsCount(kvA.Value), types.MustUnmarshalTicketsCount(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.DrawParticipantsStorePrefix):
+			return fmt.Sprintf("DrawParticipantsA: %d\nDrawParticipantsB: %d\n",
+				types.MustUnmarshalTicketsCount(kvA.Value), types.MustUnmarshalTicketsCount(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.DrawOwnerTicketsStorePrefix):
+			return fmt.Sprintf("DrawOwnerTicketsA: %d\nDrawOwnerTicketsB: %d\n",
+				types.MustUnmarshalTicketsCount(kvA.Value), types.MustUnmarshalTicketsCount(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.DrawTicketsStorePrefix):
+			return fmt.Sprintf("DrawTicketIDA: %s\nDrawTicketIDB: %s\n", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.TicketPositionsStorePrefix):
+			drawA, positionA := types.MustUnmarshalTicketDrawPosition(kvA.Value)
+			drawB, positionB := types.MustUnmarshalTicketDrawPosition(kvB.Value)
+			return fmt.Sprintf("TicketPositionA: %d/%d\nTicketPositionB: %d/%d\n", drawA, positionA, drawB, positionB)
+
+		case bytes.HasPrefix(kvA.Key, types.DrawTicketsTreeStorePrefix):
+			return fmt.Sprintf("DrawTicketsTreeNodeA: %X\nDrawTicketsTreeNodeB: %X\n", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.PruningQueueStorePrefix):
+			return fmt.Sprintf("PruningQueueA: %d\nPruningQueueB: %d\n",
+				types.MustUnmarshalTicketsCount(kvA.Value), types.MustUnmarshalTicketsCount(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.TicketsMoveQueueStorePrefix):
+			return fmt.Sprintf("TicketsMoveQueueA: %d\nTicketsMoveQueueB: %d\n",
+				types.MustUnmarshalDrawID(kvA.Value), types.MustUnmarshalDrawID(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.PausedPoolsStorePrefix):
+			return fmt.Sprintf("PausedPoolA: %X\nPausedPoolB: %X\n", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key, types.SalesHaltedStoreKey):
+			return fmt.Sprintf("SalesHaltedA: %X\nSalesHaltedB: %X\n", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.ExclusionsStorePrefix):
+			exclusionA := types.MustUnmarshalExclusion(cdc, kvA.Value)
+			exclusionB := types.MustUnmarshalExclusion(cdc, kvB.Value)
+			return fmt.Sprintf("ExclusionA: %s\nExclusionB: %s\n", &exclusionA, &exclusionB)
+
+		case bytes.HasPrefix(kvA.Key, types.SpendingLimitsStorePrefix):
+			limitA := types.MustUnmarshalSpendingLimit(cdc, kvA.Value)
+			limitB := types.MustUnmarshalSpendingLimit(cdc, kvB.Value)
+			return fmt.Sprintf("SpendingLimitA: %s\nSpendingLimitB: %s\n", &limitA, &limitB)
+
+		case bytes.HasPrefix(kvA.Key, types.SpendRecordsStorePrefix):
+			recordA := types.MustUnmarshalSpendRecord(cdc, kvA.Value)
+			recordB := types.MustUnmarshalSpendRecord(cdc, kvB.Value)
+			return fmt.Sprintf("SpendRecordA: %s\nSpendRecordB: %s\n", &recordA, &recordB)
+
+		case bytes.HasPrefix(kvA.Key, types.DrawFeesStorePrefix):
+			feesA := types.MustUnmarshalDrawFees(cdc, kvA.Value)
+			feesB := types.MustUnmarshalDrawFees(cdc, kvB.Value)
+			return fmt.Sprintf("DrawFeesA: %s\nDrawFeesB: %s\n", &feesA, &feesB)
+
+		case bytes.HasPrefix(kvA.Key, types.PricePostsStorePrefix):
+			postA := types.MustUnmarshalPricePost(cdc, kvA.Value)
+			postB := types.MustUnmarshalPricePost(cdc, kvB.Value)
+			return fmt.Sprintf("PricePostA: %s\nPricePostB: %s\n", &postA, &postB)
+
+		case bytes.HasPrefix(kvA.Key, types.PricesStorePrefix):
+			priceA := types.MustUnmarshalPrice(cdc, kvA.Value)
+			priceB := types.MustUnmarshalPrice(cdc, kvB.Value)
+			return fmt.Sprintf("PriceA: %s\nPriceB: %s\n", &priceA, &priceB)
+
+		case bytes.HasPrefix(kvA.Key, types.UpcomingDrawIDStorePrefix):
+			return fmt.Sprintf("UpcomingDrawIDA: %d\nUpcomingDrawIDB: %d\n",
+				types.MustUnmarshalDrawID(kvA.Value), types.MustUnmarshalDrawID(kvB.Value))
+
+		case bytes.Equal(kvA.Key, types.NextDrawIDStoreKey):
+			return fmt.Sprintf("NextDrawIDA: %d\nNextDrawIDB: %d\n",
+				types.MustUnmarshalDrawID(kvA.Value), types.MustUnmarshalDrawID(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
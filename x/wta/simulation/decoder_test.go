@@ -25,12 +25,18 @@ func TestDecodeStore(t *testing.T) {
 
 	ticket := types.NewTicket(
 		"ticket-1",
+		1,
 		time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC),
 		"owner-1",
+		"",
+		sdk.NewInt64Coin("stake", 10),
+		nil,
 	)
 
 	historicalDraw := types.NewHistoricalDrawData(
 		types.NewDraw(
+			1,
+			types.DefaultPoolID,
 			1,
 			1,
 			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
@@ -38,24 +44,183 @@ func TestDecodeStore(t *testing.T) {
 		),
 		types.NewTicket(
 			"ticket-n",
+			1,
 			time.Date(2020, 1, 5, 00, 00, 00, 000, time.UTC),
 			"owner-n",
+			"",
+			sdk.NewInt64Coin("stake", 10),
+			nil,
 		),
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
+		nil,
+		0,
+		0,
+	)
+
+	valAddr := sdk.ValAddress("validator-address___")
+	commitment := types.NewEntropyCommitment(
+		types.DefaultPoolID,
+		valAddr.String(),
+		types.ComputeEntropyCommitment(valAddr, []byte("entropy")),
+		nil,
+	)
+
+	pool := types.NewPool(1, types.DefaultDistributionParams(), types.DefaultDrawParams(), types.DefaultTicketParams(), types.DefaultPrizeParams(), types.DefaultGameParams(), types.DefaultRolloverParams())
+
+	exclusion := types.NewExclusion(
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+	)
+
+	spendingLimit := types.NewSpendingLimit(
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)),
+		time.Hour*24*7,
+		nil,
+	)
+
+	spendRecord := types.NewSpendRecord(
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	)
+
+	drawFees := types.NewDrawFees(
+		1,
+		types.FeeDestinationCommunityPool,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+	)
+
+	pricePost := types.NewPricePost(
+		sdk.DefaultBondDenom,
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		sdk.NewDecWithPrec(25, 2),
+		time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+	)
+
+	price := types.NewPrice(
+		sdk.DefaultBondDenom,
+		sdk.NewDecWithPrec(25, 2),
+		time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
 	)
 
 	kvPairs := kv.Pairs{Pairs: []kv.Pair{
 		{
-			Key:   types.CurrentDrawEndTimeStoreKey,
+			Key:   types.CurrentDrawEndTimeStoreKey(types.DefaultPoolID),
 			Value: types.MustMarshalDrawEndTime(drawEndTime),
 		},
 		{
-			Key:   types.TicketsStoreKey(ticket.Id),
+			Key:   types.CurrentDrawIDStoreKey(types.DefaultPoolID),
+			Value: types.MustMarshalDrawID(1),
+		},
+		{
+			Key:   types.NextDrawIDStoreKey,
+			Value: types.MustMarshalDrawID(2),
+		},
+		{
+			Key:   types.CurrentDrawRolloversStoreKey(types.DefaultPoolID),
+			Value: types.MustMarshalDrawRollovers(3),
+		},
+		{
+			Key:   types.TicketsStoreKey(1, ticket.Id),
 			Value: cdc.MustMarshalBinaryBare(&ticket),
 		},
 		{
-			Key:   types.HistoricalDataStoreKey(historicalDraw.Draw.EndTime),
+			Key:   types.HistoricalDataStoreKey(historicalDraw.Draw.Id),
 			Value: cdc.MustMarshalBinaryBare(&historicalDraw),
 		},
+		{
+			Key:   types.EntropyCommitmentStoreKey(types.DefaultPoolID, valAddr),
+			Value: cdc.MustMarshalBinaryBare(&commitment),
+		},
+		{
+			Key:   types.MissedRevealsStoreKey(valAddr),
+			Value: types.MustMarshalMissedRevealsCount(5),
+		},
+		{
+			Key:   types.PoolStoreKey(pool.Id),
+			Value: cdc.MustMarshalBinaryBare(&pool),
+		},
+		{
+			Key:   types.OwnerTicketStoreKey(ticket.Owner, ticket.Id),
+			Value: []byte(ticket.Id),
+		},
+		{
+			Key:   types.DrawTicketsCountStoreKey(1),
+			Value: types.MustMarshalTicketsCount(10),
+		},
+		{
+			Key:   types.DrawParticipantsStoreKey(1),
+			Value: types.MustMarshalTicketsCount(4),
+		},
+		{
+			Key:   types.DrawOwnerTicketsStoreKey(1, ticket.Owner),
+			Value: types.MustMarshalTicketsCount(2),
+		},
+		{
+			Key:   types.DrawTicketStoreKey(1, 0),
+			Value: []byte(ticket.Id),
+		},
+		{
+			Key:   types.TicketPositionStoreKey(ticket.Id),
+			Value: types.MustMarshalTicketDrawPosition(1, 3),
+		},
+		{
+			Key:   types.DrawTicketsTreeNodeStoreKey(1, 0, 0),
+			Value: []byte{0x01, 0x02},
+		},
+		{
+			Key:   types.PruningQueueStoreKey(1),
+			Value: types.MustMarshalTicketsCount(7),
+		},
+		{
+			Key:   types.TicketsMoveQueueStoreKey(1),
+			Value: types.MustMarshalDrawID(2),
+		},
+		{
+			Key:   types.PausedPoolStoreKey(1),
+			Value: []byte{0x1},
+		},
+		{
+			Key:   types.SalesHaltedStoreKey,
+			Value: []byte{0x1},
+		},
+		{
+			Key:   types.UpcomingDrawIDStoreKey(1),
+			Value: types.MustMarshalDrawID(3),
+		},
+		{
+			Key:   types.ExclusionStoreKey(sdk.AccAddress(valAddr)),
+			Value: types.MustMarshalExclusion(cdc, exclusion),
+		},
+		{
+			Key:   types.SpendingLimitStoreKey(sdk.AccAddress(valAddr)),
+			Value: types.MustMarshalSpendingLimit(cdc, spendingLimit),
+		},
+		{
+			Key:   types.SpendRecordStoreKey(sdk.AccAddress(valAddr), spendRecord.Time),
+			Value: types.MustMarshalSpendRecord(cdc, spendRecord),
+		},
+		{
+			Key:   types.DrawFeesStoreKey(drawFees.DrawId, drawFees.Destination),
+			Value: types.MustMarshalDrawFees(cdc, drawFees),
+		},
+		{
+			Key:   types.PricePostStoreKey(pricePost.Denom, sdk.AccAddress(valAddr)),
+			Value: types.MustMarshalPricePost(cdc, pricePost),
+		},
+		{
+			Key:   types.PriceStoreKey(price.Denom),
+			Value: types.MustMarshalPrice(cdc, price),
+		},
+		{
+			Key:   []byte("unknown"),
+			Value: []byte("unknown"),
+		},
 	}}
 
 	tests := []struct {
@@ -64,8 +229,32 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Draw end time", fmt.Sprintf("CurrentDrawEndTimeA: %s\nCurrentDrawEndTimeB: %s\n",
 			drawEndTime.Format(time.RFC3339), drawEndTime.Format(time.RFC3339))},
+		{"Draw id", "CurrentDrawIDA: 1\nCurrentDrawIDB: 1\n"},
+		{"Next draw id", "NextDrawIDA: 2\nNextDrawIDB: 2\n"},
+		{"Draw rollovers", "CurrentDrawRolloversA: 3\nCurrentDrawRolloversB: 3\n"},
 		{"Ticket", fmt.Sprintf("TicketA: %s\nTicketB: %s\n", &ticket, &ticket)},
 		{"Historical draw", fmt.Sprintf("HistoricalDataA: %s\nHistoricalDataB: %s\n", &historicalDraw, &historicalDraw)},
+		{"Entropy commitment", fmt.Sprintf("EntropyCommitmentA: %s\nEntropyCommitmentB: %s\n", &commitment, &commitment)},
+		{"Missed reveals", "MissedRevealsA: 5\nMissedRevealsB: 5\n"},
+		{"Pool", fmt.Sprintf("PoolA: %s\nPoolB: %s\n", &pool, &pool)},
+		{"Owner ticket", "OwnerTicketIDA: ticket-1\nOwnerTicketIDB: ticket-1\n"},
+		{"Draw tickets count", "DrawTicketsCountA: 10\nDrawTicketsCountB: 10\n"},
+		{"Draw participants", "DrawParticipantsA: 4\nDrawParticipantsB: 4\n"},
+		{"Draw owner tickets", "DrawOwnerTicketsA: 2\nDrawOwnerTicketsB: 2\n"},
+		{"Draw ticket", "DrawTicketIDA: ticket-1\nDrawTicketIDB: ticket-1\n"},
+		{"Ticket position", "TicketPositionA: 1/3\nTicketPositionB: 1/3\n"},
+		{"Draw tickets tree node", "DrawTicketsTreeNodeA: 0102\nDrawTicketsTreeNodeB: 0102\n"},
+		{"Pruning queue", "PruningQueueA: 7\nPruningQueueB: 7\n"},
+		{"Tickets move queue", "TicketsMoveQueueA: 2\nTicketsMoveQueueB: 2\n"},
+		{"Paused pool", "PausedPoolA: 01\nPausedPoolB: 01\n"},
+		{"Sales halted", "SalesHaltedA: 01\nSalesHaltedB: 01\n"},
+		{"Upcoming draw id", "UpcomingDrawIDA: 3\nUpcomingDrawIDB: 3\n"},
+		{"Exclusion", fmt.Sprintf("ExclusionA: %s\nExclusionB: %s\n", &exclusion, &exclusion)},
+		{"Spending limit", fmt.Sprintf("SpendingLimitA: %s\nSpendingLimitB: %s\n", &spendingLimit, &spendingLimit)},
+		{"Spend record", fmt.Sprintf("SpendRecordA: %s\nSpendRecordB: %s\n", &spendRecord, &spendRecord)},
+		{"Draw fees", fmt.Sprintf("DrawFeesA: %s\nDrawFeesB: %s\n", &drawFees, &drawFees)},
+		{"Price post", fmt.Sprintf("PricePostA: %s\nPricePostB: %s\n", &pricePost, &pricePost)},
+		{"Price", fmt.Sprintf("PriceA: %s\nPriceB: %s\n", &price, &price)},
 		{"other", ""},
 	}
 
@@ -17,13 +17,37 @@ import (
 // RandomizedGenState sets into the given simState a randomly generated genesis state
 func RandomizedGenState(simState *module.SimulationState) {
 	// Create a random genesis state and serialize that
+	pastDraws := RandHistoricalDrawsData(simState.Rand, 50, simState.Accounts)
+	drawID := uint64(len(pastDraws) + 1)
+	pools := RandomPoolStates(simState.Rand, drawID+1, simState.Rand.Intn(3))
+	drawEndTime := RandDate(simState.Rand, time.Now().Add(time.Minute*1))
+
 	genesisState := types.NewGenesisState(
-		RandDate(simState.Rand, time.Now().Add(time.Minute*1)),
-		RandTicketsSlice(simState.Rand, 20, simState.Accounts),
-		RandHistoricalDrawsData(simState.Rand, 50, simState.Accounts),
+		drawID,
+		drawEndTime,
+		0,
+		RandTicketsSlice(simState.Rand, drawID, drawEndTime, 20, simState.Accounts),
+		pastDraws,
 		RandomDistributionParams(simState.Rand),
 		RandomDrawParams(simState.Rand),
 		RandomTicketParams(simState.Rand),
+		RandomPrizeParams(simState.Rand),
+		RandomRolloverParams(simState.Rand),
+		RandomPruningParams(simState.Rand),
+		[]types.EntropyCommitment{},
+		[]types.MissedReveals{},
+		pools,
+		[]uint64{},
+		types.DefaultSalesParams(),
+		0,
+		[]types.Exclusion{},
+		RandomSpendingLimitParams(simState.Rand),
+		[]types.SpendingLimit{},
+		[]types.SpendRecord{},
+		[]types.DrawFees{},
+		RandomOracleParams(simState.Rand, simState.Accounts),
+		[]types.PricePost{},
+		[]types.Price{},
 	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
 
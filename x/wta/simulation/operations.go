@@ -4,6 +4,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
@@ -18,16 +19,18 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 	sim "github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
 // Simulation operation weights constants
 const (
-	OpWeightBuyTickets = "op_weight_buy_tickets"
-	DefaultGasValue    = 200000
+	OpWeightBuyTickets       = "op_weight_buy_tickets"
+	OpWeightSelfExclude      = "op_weight_self_exclude"
+	OpWeightSetSpendingLimit = "op_weight_set_spending_limit"
+	OpWeightPostPrice        = "op_weight_post_price"
+	OpWeightTransferTickets  = "op_weight_transfer_tickets"
+	DefaultGasValue          = 400000
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -42,11 +45,55 @@ func WeightedOperations(
 		},
 	)
 
+	var weightSelfExclude int
+	appParams.GetOrGenerate(cdc, OpWeightSelfExclude, &weightSelfExclude, nil,
+		func(_ *rand.Rand) {
+			weightSelfExclude = params.DefaultWeightMsgSelfExclude
+		},
+	)
+
+	var weightSetSpendingLimit int
+	appParams.GetOrGenerate(cdc, OpWeightSetSpendingLimit, &weightSetSpendingLimit, nil,
+		func(_ *rand.Rand) {
+			weightSetSpendingLimit = params.DefaultWeightMsgSetSpendingLimit
+		},
+	)
+
+	var weightPostPrice int
+	appParams.GetOrGenerate(cdc, OpWeightPostPrice, &weightPostPrice, nil,
+		func(_ *rand.Rand) {
+			weightPostPrice = params.DefaultWeightMsgPostPrice
+		},
+	)
+
+	var weightTransferTickets int
+	appParams.GetOrGenerate(cdc, OpWeightTransferTickets, &weightTransferTickets, nil,
+		func(_ *rand.Rand) {
+			weightTransferTickets = params.DefaultWeightMsgTransferTickets
+		},
+	)
+
 	return sim.WeightedOperations{
 		sim.NewWeightedOperation(
 			weightBuyTickets,
 			SimulateMsgBuyTickets(k, ak, bk),
 		),
+		sim.NewWeightedOperation(
+			weightSelfExclude,
+			SimulateMsgSelfExclude(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightSetSpendingLimit,
+			SimulateMsgSetSpendingLimit(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightPostPrice,
+			SimulateMsgPostPrice(k, ak, bk),
+		),
+		sim.NewWeightedOperation(
+			weightTransferTickets,
+			SimulateMsgTransferTickets(k, ak, bk),
+		),
 	}
 }
 
@@ -58,14 +105,14 @@ func SimulateMsgBuyTickets(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bank
 	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
 
 		// Get random message data and build the message
-		acc, ticketsQuantity, ticketsCost, skip := randomBuyTicketsData(r, ctx, accounts, k, bk)
+		acc, recipient, poolID, ticketsQuantity, picks, ticketsCost, skip := randomBuyTicketsData(r, ctx, accounts, k, bk)
 		if skip {
 			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, "skipping after generating data"), nil, nil
 		}
-		msg := types.NewMsgBuyTickets(ticketsQuantity, acc.Address.String())
+		msg := types.NewMsgBuyTickets(poolID, ticketsQuantity, ticketsCost.Denom, acc.Address.String(), recipient, picks)
 
 		// Send the message
-		err = sendMsgBuyTickets(r, app, ak, bk, msg, ticketsCost, ctx, chainID, []cryptotypes.PrivKey{acc.PrivKey})
+		err = sendMsg(r, app, ak, bk, msg, acc, sdk.NewCoins(ticketsCost), ctx, chainID)
 		if err != nil {
 			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, ""), nil, err
 		}
@@ -75,41 +122,273 @@ func SimulateMsgBuyTickets(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bank
 }
 
 // randomBuyTicketsData generates random parameters that can be used to create a types.MsgBuyTickets.
-// It returns a random amount of tickets to buy, as well as the account that should buy them and
-// the overall cost of the operation
+// It returns a random pool and amount of tickets to buy along with their picks if the pool is a lotto one,
+// as well as the account that should buy them, the recipient that should own them if they are bought on behalf
+// of another account and the overall cost of the operation
 func randomBuyTicketsData(
 	r *rand.Rand, ctx sdk.Context, accounts []simtypes.Account, k keeper.Keeper, bk bankkeeper.Keeper,
-) (account simtypes.Account, ticketsAmt uint32, ticketsCost sdk.Coin, skip bool) {
-	// Get a random account
+) (
+	account simtypes.Account, recipient string, poolID uint64, ticketsAmt uint32, picks []types.NumbersPick,
+	ticketsCost sdk.Coin, skip bool,
+) {
+	// Get a random account, and a random recipient 20% of the times
 	account, _ = simtypes.RandomAcc(r, accounts)
+	owner := account.Address
+	if r.Intn(5) == 0 {
+		recipientAcc, _ := simtypes.RandomAcc(r, accounts)
+		owner = recipientAcc.Address
+		recipient = owner.String()
+	}
+
+	// Get a random pool
+	pools := k.GetPools(ctx)
+	pool := pools[r.Intn(len(pools))]
+
+	// Skip if the account or the recipient have excluded themselves from buying tickets
+	if k.IsExcluded(ctx, account.Address) || k.IsExcluded(ctx, owner) {
+		return simtypes.Account{}, "", 0, 0, nil, sdk.Coin{}, true
+	}
+
+	// Skip if the ticket sales are halted or the ones of the pool are paused
+	if k.GetSalesParams(ctx).Halted || k.IsPoolSalesPaused(ctx, pool.Id) {
+		return simtypes.Account{}, "", 0, 0, nil, sdk.Coin{}, true
+	}
+
+	// Skip if the current draw of the pool no longer sells tickets and they are not assigned to the next one
+	if !k.IsCurrentDrawOpen(ctx, pool.Id) ||
+		(k.IsCurrentDrawSalesCutoff(ctx, pool) && !pool.DrawParams.AssignToNextDraw) {
+		return simtypes.Account{}, "", 0, 0, nil, sdk.Coin{}, true
+	}
 
 	// Get a random number of tickets (min 1, max 10 tickets)
 	ticketsAmt = uint32(r.Int31n(10)) + 1
 
-	// Compute the ticket cost based on the params
-	ticketParams := k.GetTicketParams(ctx)
-	ticketsCost = sdk.NewCoin(ticketParams.Price.Denom, ticketParams.Price.Amount.MulRaw(int64(ticketsAmt)))
+	// Make sure the tickets limits of the pool are not exceeded
+	if maxTicketsPerMsg := pool.TicketParams.MaxTicketsPerMsg; maxTicketsPerMsg != 0 && ticketsAmt > maxTicketsPerMsg {
+		ticketsAmt = maxTicketsPerMsg
+	}
+
+	if maxTicketsPerAddress := pool.TicketParams.MaxTicketsPerAddress; maxTicketsPerAddress != 0 {
+		drawID := k.GetCurrentDrawID(ctx, pool.Id)
+		if k.IsCurrentDrawSalesCutoff(ctx, pool) {
+			drawID, _ = k.GetUpcomingDrawID(ctx, pool.Id)
+		}
+
+		owned := k.GetDrawOwnerTicketsCount(ctx, drawID, owner.String())
+		if owned+ticketsAmt > maxTicketsPerAddress {
+			return simtypes.Account{}, "", 0, 0, nil, sdk.Coin{}, true
+		}
+	}
+
+	// Compute the ticket cost based on a random price among the ones accepted by the pool,
+	// skipping if it cannot be determined because the price of its denom is stale
+	prices := pool.TicketParams.Prices()
+	ticketPrice, err := k.GetTicketPrice(ctx, pool, prices[r.Intn(len(prices))].Denom)
+	if err != nil {
+		return simtypes.Account{}, "", 0, 0, nil, sdk.Coin{}, true
+	}
+	ticketsCost = sdk.NewCoin(ticketPrice.Denom, ticketPrice.Amount.MulRaw(int64(ticketsAmt)))
 
 	// Make sure the account has enough balance to pay for the tickets
 	balance := bk.SpendableCoins(ctx, account.Address)
 	if balance.IsZero() || sdk.NewCoins(ticketsCost).IsAnyGT(balance) {
-		return simtypes.Account{}, 0, sdk.Coin{}, true
+		return simtypes.Account{}, "", 0, 0, nil, sdk.Coin{}, true
+	}
+
+	// Skip if the account would exceed its spending limit
+	if k.CheckSpendingLimit(ctx, account.Address, sdk.NewCoins(ticketsCost)) != nil {
+		return simtypes.Account{}, "", 0, 0, nil, sdk.Coin{}, true
+	}
+
+	if pool.GameParams.GameType == types.GameTypeLotto {
+		picks = RandomNumbersPicks(r, pool.GameParams, ticketsAmt)
+	}
+
+	return account, recipient, pool.Id, ticketsAmt, picks, ticketsCost, false
+}
+
+// SimulateMsgSelfExclude generates a random types.MsgSelfExclude and sends it to the chain.
+func SimulateMsgSelfExclude(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accounts []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+
+		// Get a random account and exclusion end time (min 1 minute, max 10 minutes)
+		acc, _ := simtypes.RandomAcc(r, accounts)
+		until := ctx.BlockTime().Add(time.Minute * time.Duration(r.Intn(10)+1))
+
+		// Skip if the exclusion would shorten an existing one
+		if exclusion, found := k.GetExclusion(ctx, acc.Address); found && until.Before(exclusion.Until) {
+			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, "skipping exclusion shortening"), nil, nil
+		}
+
+		msg := types.NewMsgSelfExclude(acc.Address.String(), until)
+
+		// Send the message
+		err = sendMsg(r, app, ak, bk, msg, acc, nil, ctx, chainID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, ""), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgSetSpendingLimit generates a random types.MsgSetSpendingLimit and sends it to the chain.
+func SimulateMsgSetSpendingLimit(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accounts []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+
+		// Get a random account, and a limit removal 10% of the times
+		acc, _ := simtypes.RandomAcc(r, accounts)
+		msg := types.NewMsgSetSpendingLimit(acc.Address.String(), sdk.NewCoins(), 0)
+
+		if r.Intn(10) != 0 {
+			// Get a random amount based on the ticket price of a random pool (min 1, max 50 tickets)
+			// and a random window (min 1 minute, max 60 minutes)
+			pools := k.GetPools(ctx)
+			price := pools[r.Intn(len(pools))].TicketParams.Price
+			amount := sdk.NewCoin(price.Denom, price.Amount.MulRaw(int64(r.Intn(50)+1)))
+			window := time.Minute * time.Duration(r.Intn(60)+1)
+
+			msg = types.NewMsgSetSpendingLimit(acc.Address.String(), sdk.NewCoins(amount), window)
+		}
+
+		// Send the message
+		err = sendMsg(r, app, ak, bk, msg, acc, nil, ctx, chainID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, ""), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgPostPrice generates a random types.MsgPostPrice and sends it to the chain.
+func SimulateMsgPostPrice(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accounts []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+
+		// Get a random oracle among the whitelisted ones
+		oracles := k.GetOracleParams(ctx).Oracles
+		if len(oracles) == 0 {
+			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, "no oracle whitelisted"), nil, nil
+		}
+
+		addr, _ := sdk.AccAddressFromBech32(oracles[r.Intn(len(oracles))])
+		acc, found := simtypes.FindAccount(accounts, addr)
+		if !found {
+			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, "oracle account not found"), nil, nil
+		}
+
+		// Get a random denom among the ones accepted by a random pool, and a random price (min 0.01, max 10)
+		pools := k.GetPools(ctx)
+		prices := pools[r.Intn(len(pools))].TicketParams.Prices()
+		denom := prices[r.Intn(len(prices))].Denom
+		price := sdk.NewDecWithPrec(int64(r.Intn(1000)+1), 2)
+
+		msg := types.NewMsgPostPrice(acc.Address.String(), denom, price)
+
+		// Send the message
+		err = sendMsg(r, app, ak, bk, msg, acc, nil, ctx, chainID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, ""), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgTransferTickets generates a random types.MsgTransferTickets and sends it to the chain.
+func SimulateMsgTransferTickets(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accounts []simtypes.Account, chainID string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+
+		// Get random message data and build the message
+		acc, recipient, poolID, ticketID, skip := randomTransferTicketsData(r, ctx, accounts, k)
+		if skip {
+			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, "skipping after generating data"), nil, nil
+		}
+		msg := types.NewMsgTransferTickets(poolID, []string{ticketID}, acc.Address.String(), recipient.String())
+
+		// Send the message
+		err = sendMsg(r, app, ak, bk, msg, acc, nil, ctx, chainID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.RouterKey, types.ModuleName, ""), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomTransferTicketsData generates random parameters that can be used to create a types.MsgTransferTickets.
+// It returns a random ticket of the current draw of a random pool along with the account owning it,
+// and the address of a random recipient
+func randomTransferTicketsData(
+	r *rand.Rand, ctx sdk.Context, accounts []simtypes.Account, k keeper.Keeper,
+) (account simtypes.Account, recipient sdk.AccAddress, poolID uint64, ticketID string, skip bool) {
+	// Get a random pool
+	pools := k.GetPools(ctx)
+	pool := pools[r.Intn(len(pools))]
+
+	// Skip if the tickets of the current draw of the pool can no longer be transferred
+	if !k.IsCurrentDrawOpen(ctx, pool.Id) || k.IsCurrentDrawSalesCutoff(ctx, pool) {
+		return simtypes.Account{}, nil, 0, "", true
+	}
+
+	// Get a random ticket of the current draw
+	drawID := k.GetCurrentDrawID(ctx, pool.Id)
+	ticketsCount := k.GetDrawTicketsCount(ctx, drawID)
+	if ticketsCount == 0 {
+		return simtypes.Account{}, nil, 0, "", true
+	}
+
+	ticket, found := k.GetDrawTicketAt(ctx, drawID, uint32(r.Int63n(int64(ticketsCount))))
+	if !found {
+		return simtypes.Account{}, nil, 0, "", true
+	}
+
+	// Get the account owning the ticket
+	owner, _ := sdk.AccAddressFromBech32(ticket.Owner)
+	account, found = simtypes.FindAccount(accounts, owner)
+	if !found {
+		return simtypes.Account{}, nil, 0, "", true
+	}
+
+	// Get a random recipient, skipping if it has excluded itself from buying tickets
+	recipientAcc, _ := simtypes.RandomAcc(r, accounts)
+	recipient = recipientAcc.Address
+	if recipient.Equals(owner) || k.IsExcluded(ctx, recipient) {
+		return simtypes.Account{}, nil, 0, "", true
+	}
+
+	// Make sure the recipient does not exceed the tickets limit of the draw
+	if maxTicketsPerAddress := pool.TicketParams.MaxTicketsPerAddress; maxTicketsPerAddress != 0 {
+		if k.GetDrawOwnerTicketsCount(ctx, drawID, recipient.String())+1 > maxTicketsPerAddress {
+			return simtypes.Account{}, nil, 0, "", true
+		}
 	}
 
-	return account, ticketsAmt, ticketsCost, false
+	return account, recipient, pool.Id, ticket.Id, false
 }
 
-// sendMsgBuyTickets sends a transaction with a types.MsgBuyTickets from a provided random profile.
-func sendMsgBuyTickets(
+// sendMsg sends a transaction with the given message signed by the provided account. The fees are randomly chosen
+// among the spendable coins of the account that are left after paying the given amount required by the message
+func sendMsg(
 	r *rand.Rand, app *baseapp.BaseApp, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
-	msg *types.MsgBuyTickets, ticketsCost sdk.Coin, ctx sdk.Context, chainID string, privkeys []cryptotypes.PrivKey,
+	msg sdk.Msg, signer simtypes.Account, spent sdk.Coins, ctx sdk.Context, chainID string,
 ) error {
-	addr, _ := sdk.AccAddressFromBech32(msg.Buyer)
-	account := ak.GetAccount(ctx, addr)
+	account := ak.GetAccount(ctx, signer.Address)
 
-	// Compute the amount of fees that the account can spend based on the amount of money it will spend on tickets
 	coins := bk.SpendableCoins(ctx, account.GetAddress())
-	fees, err := simtypes.RandomFees(r, ctx, coins.Sub(sdk.NewCoins(ticketsCost)))
+	fees, err := simtypes.RandomFees(r, ctx, coins.Sub(spent))
 	if err != nil {
 		return err
 	}
@@ -123,7 +402,7 @@ func sendMsgBuyTickets(
 		chainID,
 		[]uint64{account.GetAccountNumber()},
 		[]uint64{account.GetSequence()},
-		privkeys...,
+		signer.PrivKey,
 	)
 	if err != nil {
 		return err
@@ -26,7 +26,8 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreDrawParamsKey),
 			func(r *rand.Rand) string {
 				params := RandomDrawParams(r)
-				return fmt.Sprintf(`{"duration":"%d"}`, params.Duration)
+				return fmt.Sprintf(`{"duration":"%d","reveal_duration":"%d","sales_cutoff":"%d","assign_to_next_draw":%t}`,
+					params.Duration, params.RevealDuration, params.SalesCutoff, params.AssignToNextDraw)
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreTicketParamsKey),
@@ -36,5 +37,39 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return string(bz)
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStorePrizeParamsKey),
+			func(r *rand.Rand) string {
+				params := RandomPrizeParams(r)
+				bz, _ := json.Marshal(&params)
+				return string(bz)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreRolloverParamsKey),
+			func(r *rand.Rand) string {
+				params := RandomRolloverParams(r)
+				bz, _ := json.Marshal(&params)
+				return string(bz)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStorePruningParamsKey),
+			func(r *rand.Rand) string {
+				params := RandomPruningParams(r)
+				bz, _ := json.Marshal(&params)
+				return string(bz)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreSalesParamsKey),
+			func(r *rand.Rand) string {
+				params := RandomSalesParams(r)
+				bz, _ := json.Marshal(&params)
+				return string(bz)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreSpendingLimitParamsKey),
+			func(r *rand.Rand) string {
+				params := RandomSpendingLimitParams(r)
+				return fmt.Sprintf(`{"increase_delay":"%d"}`, params.IncreaseDelay)
+			},
+		),
 	}
 }
@@ -0,0 +1,177 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmicbet/ledger/app/params"
+	"github.com/cosmicbet/ledger/x/wta/keeper"
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+// Simulation proposal weights constants
+const (
+	OpWeightSubmitCreatePoolProposal     = "op_weight_submit_create_pool_proposal"
+	OpWeightSubmitPauseSalesProposal     = "op_weight_submit_pause_sales_proposal"
+	OpWeightSubmitResumeSalesProposal    = "op_weight_submit_resume_sales_proposal"
+	OpWeightSubmitCloseDrawProposal      = "op_weight_submit_close_draw_proposal"
+	OpWeightSubmitCancelDrawProposal     = "op_weight_submit_cancel_draw_proposal"
+	OpWeightSubmitSetSalesHaltedProposal = "op_weight_submit_set_sales_halted_proposal"
+)
+
+// maxCancelledTickets is the max number of tickets of a draw that can be refunded within the gas
+// used to submit a simulated proposal
+const maxCancelledTickets = 100
+
+// ProposalContents returns all the wta governance proposals content functions used in the simulation
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		sim.NewWeightedProposalContent(
+			OpWeightSubmitCreatePoolProposal,
+			params.DefaultWeightCreatePoolProposal,
+			SimulateCreatePoolProposalContent(k),
+		),
+		sim.NewWeightedProposalContent(
+			OpWeightSubmitPauseSalesProposal,
+			params.DefaultWeightPauseSalesProposal,
+			SimulatePauseSalesProposalContent(k),
+		),
+		sim.NewWeightedProposalContent(
+			OpWeightSubmitResumeSalesProposal,
+			params.DefaultWeightResumeSalesProposal,
+			SimulateResumeSalesProposalContent(k),
+		),
+		sim.NewWeightedProposalContent(
+			OpWeightSubmitCloseDrawProposal,
+			params.DefaultWeightCloseDrawProposal,
+			SimulateCloseDrawProposalContent(k),
+		),
+		sim.NewWeightedProposalContent(
+			OpWeightSubmitCancelDrawProposal,
+			params.DefaultWeightCancelDrawProposal,
+			SimulateCancelDrawProposalContent(k),
+		),
+		sim.NewWeightedProposalContent(
+			OpWeightSubmitSetSalesHaltedProposal,
+			params.DefaultWeightSetSalesHaltedProposal,
+			SimulateSetSalesHaltedProposalContent(),
+		),
+	}
+}
+
+// SimulateCancelDrawProposalContent generates a random types.CancelDrawProposal for one of the existing pools
+func SimulateCancelDrawProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		pools := k.GetPools(ctx)
+		pool := pools[r.Intn(len(pools))]
+
+		// Since no entropy is revealed during the simulation, draws are rolled over and can grow past the
+		// number of tickets that can be refunded within the proposal gas, counting the ones still being moved
+		drawID := k.GetCurrentDrawID(ctx, pool.Id)
+		ticketsCount := k.GetDrawTicketsCount(ctx, drawID)
+		for _, move := range k.GetTicketsMoveQueue(ctx) {
+			if move.NewDrawId == drawID {
+				ticketsCount += k.GetDrawTicketsCount(ctx, move.DrawId)
+			}
+		}
+		if ticketsCount > maxCancelledTickets {
+			return nil
+		}
+
+		return types.NewCancelDrawProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			pool.Id,
+		)
+	}
+}
+
+// SimulateCreatePoolProposalContent generates a random types.CreatePoolProposal for a pool
+// having an id greater than the ones of all the existing pools
+func SimulateCreatePoolProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		var maxID uint64
+		for _, pool := range k.GetPools(ctx) {
+			if pool.Id > maxID {
+				maxID = pool.Id
+			}
+		}
+
+		return types.NewCreatePoolProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			RandomPool(r, maxID+1),
+		)
+	}
+}
+
+// SimulatePauseSalesProposalContent generates a random types.PauseSalesProposal for one of the existing pools
+func SimulatePauseSalesProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		pools := k.GetPools(ctx)
+		pool := pools[r.Intn(len(pools))]
+
+		return types.NewPauseSalesProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			pool.Id,
+		)
+	}
+}
+
+// SimulateResumeSalesProposalContent generates a random types.ResumeSalesProposal for one of the existing pools
+func SimulateResumeSalesProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		pools := k.GetPools(ctx)
+		pool := pools[r.Intn(len(pools))]
+
+		return types.NewResumeSalesProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			pool.Id,
+		)
+	}
+}
+
+// SimulateCloseDrawProposalContent generates a random types.CloseDrawProposal for one of the existing pools
+// whose current draw is still open
+func SimulateCloseDrawProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		// Draws waiting for the tickets of a rolled over draw are held only once all of them have been moved,
+		// so they can stay closed for a few blocks after their end time
+		var pools []types.Pool
+		for _, pool := range k.GetPools(ctx) {
+			if k.IsCurrentDrawOpen(ctx, pool.Id) {
+				pools = append(pools, pool)
+			}
+		}
+		if len(pools) == 0 {
+			return nil
+		}
+		pool := pools[r.Intn(len(pools))]
+
+		return types.NewCloseDrawProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			pool.Id,
+		)
+	}
+}
+
+// SimulateSetSalesHaltedProposalContent generates a random types.SetSalesHaltedProposal
+func SimulateSetSalesHaltedProposalContent() simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+		params := RandomSalesParams(r)
+		return types.NewSetSalesHaltedProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			params.Halted,
+			params.HaltReason,
+		)
+	}
+}
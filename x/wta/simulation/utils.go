@@ -37,11 +37,14 @@ func RandCoin(r *rand.Rand, amountLimit int64) sdk.Coin {
 
 // -------------------------------------------------------------------------------------------------------------------
 
-// RandomDraw generates a new random types.Draw object that has an ending not going above the limit provided
-func RandomDraw(r *rand.Rand, limitTime time.Time) types.Draw {
+// RandomDraw generates a new random types.Draw object having the given id and an ending not going above the limit provided
+func RandomDraw(r *rand.Rand, id uint64, limitTime time.Time) types.Draw {
+	participants := r.Uint32() / 2
 	return types.NewDraw(
-		r.Uint32(),
-		r.Uint32(),
+		id,
+		types.DefaultPoolID,
+		participants,
+		participants+r.Uint32()/2,
 		sdk.NewCoins(RandCoin(r, 1000000)),
 		RandDate(r, limitTime),
 	)
@@ -49,46 +52,89 @@ func RandomDraw(r *rand.Rand, limitTime time.Time) types.Draw {
 
 // -------------------------------------------------------------------------------------------------------------------
 
-// RandTicket generates a random ticket for the given address
-func RandTicket(r *rand.Rand, owner string) types.Ticket {
+// RandTicket generates a random ticket of the given draw for the given address, bought before the provided time
+func RandTicket(r *rand.Rand, drawID uint64, limitTime time.Time, owner string) types.Ticket {
 	return types.NewTicket(
 		RandHexString(r, 20),
-		RandDate(r, time.Now()),
+		drawID,
+		RandDate(r, limitTime),
 		owner,
+		"",
+		RandCoin(r, 1000),
+		nil,
 	)
 }
 
-// RandTicketsSlice generates a slice of random tickets of the given length
-func RandTicketsSlice(r *rand.Rand, length int, accounts []simtypes.Account) []types.Ticket {
+// RandTicketsSlice generates a slice of random tickets of the given draw having the given length,
+// all bought before the provided time
+func RandTicketsSlice(
+	r *rand.Rand, drawID uint64, limitTime time.Time, length int, accounts []simtypes.Account,
+) []types.Ticket {
 	tickets := make([]types.Ticket, length)
 	for i := range tickets {
 		owner := accounts[r.Intn(len(accounts))]
-		tickets[i] = RandTicket(r, owner.Address.String())
+		tickets[i] = RandTicket(r, drawID, limitTime, owner.Address.String())
 	}
 	return tickets
 }
 
 // -------------------------------------------------------------------------------------------------------------------
 
-// RandHistoricalDrawData returns a randomly generated HistoricalDrawData
-func RandHistoricalDrawData(r *rand.Rand, accounts []simtypes.Account) types.HistoricalDrawData {
+// RandHistoricalDrawData returns a randomly generated HistoricalDrawData for the draw having the given id
+func RandHistoricalDrawData(r *rand.Rand, drawID uint64, accounts []simtypes.Account) types.HistoricalDrawData {
+	draw := RandomDraw(r, drawID, time.Now().Add(-time.Minute*10))
 	return types.NewHistoricalDrawData(
-		RandomDraw(r, time.Now().Add(-time.Minute*10)),
-		RandTicket(r, accounts[r.Intn(len(accounts))].Address.String()),
+		draw,
+		RandTicket(r, drawID, draw.EndTime, accounts[r.Intn(len(accounts))].Address.String()),
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
+		nil,
+		0,
+		0,
 	)
 }
 
-// RandHistoricalDrawsData returns a randomly generated slice of types.HistoricalDrawData of the given length
+// RandHistoricalDrawsData returns a randomly generated slice of types.HistoricalDrawData of the given length.
+// The returned draws have sequential ids starting from 1
 func RandHistoricalDrawsData(r *rand.Rand, length int, accounts []simtypes.Account) []types.HistoricalDrawData {
 	data := make([]types.HistoricalDrawData, length)
 	for i := range data {
-		data[i] = RandHistoricalDrawData(r, accounts)
+		data[i] = RandHistoricalDrawData(r, uint64(i+1), accounts)
 	}
 	return data
 }
 
 // -------------------------------------------------------------------------------------------------------------------
 
+// RandomPoolStates returns a randomly generated slice of types.PoolState of the given length.
+// The returned pools have sequential ids starting from 1, and their draws have sequential ids starting from firstDrawID
+func RandomPoolStates(r *rand.Rand, firstDrawID uint64, length int) []types.PoolState {
+	states := make([]types.PoolState, length)
+	for i := range states {
+		pool := RandomPool(r, uint64(i+1))
+		states[i] = types.NewPoolState(pool, firstDrawID+uint64(i), RandDate(r, time.Now().Add(time.Minute*1)), 0, 0)
+	}
+	return states
+}
+
+// RandomPool returns a randomly generated types.Pool having the given id
+func RandomPool(r *rand.Rand, id uint64) types.Pool {
+	return types.NewPool(
+		id,
+		RandomDistributionParams(r),
+		RandomDrawParams(r),
+		RandomTicketParams(r),
+		RandomPrizeParams(r),
+		RandomGameParams(r),
+		RandomRolloverParams(r),
+	)
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
 // RandomDistributionParams returns a randomly generated DistributionParams
 func RandomDistributionParams(r *rand.Rand) types.DistributionParams {
 	prizePercentage := r.Int63n(97) + 1               // Minimum 1%, max 98%
@@ -99,19 +145,133 @@ func RandomDistributionParams(r *rand.Rand) types.DistributionParams {
 		sdk.NewDecWithPrec(prizePercentage, 2),
 		sdk.NewDecWithPrec(feePercentage, 2),
 		sdk.NewDecWithPrec(burnPercentage, 2),
+		types.FeeDestination(r.Int31n(int32(len(types.FeeDestination_name)))),
 	)
 }
 
 // RandomDrawParams returns a randomly generated DrawParams
 func RandomDrawParams(r *rand.Rand) types.DrawParams {
 	return types.NewDrawParams(
-		time.Minute * time.Duration(r.Int63n(3)+1), // Minimum 1 minute, max 3 minutes
+		time.Minute*time.Duration(r.Int63n(3)+1), // Minimum 1 minute, max 3 minutes
+		time.Second*time.Duration(r.Int63n(60)),  // Minimum 0 seconds, max 59 seconds
+		time.Second*time.Duration(r.Int63n(30)),  // Minimum 0 seconds, max 29 seconds
+		r.Intn(2) == 0,
 	)
 }
 
 // RandomTicketParams returns a randomly generated TicketParams
 func RandomTicketParams(r *rand.Rand) types.TicketParams {
+	var maxTicketsPerAddress, maxTicketsPerMsg uint32
+	if r.Intn(2) == 0 {
+		maxTicketsPerAddress = uint32(r.Intn(100)) + 20 // Minimum 20 tickets, max 119 tickets
+	}
+	if r.Intn(2) == 0 {
+		maxTicketsPerMsg = uint32(r.Intn(10)) + 1 // Minimum 1 ticket, max 10 tickets
+	}
+
 	return types.NewTicketParams(
 		RandCoin(r, 1000),
+		nil,
+		sdk.ZeroDec(),
+		maxTicketsPerAddress,
+		maxTicketsPerMsg,
 	)
 }
+
+// RandomPrizeParams returns a randomly generated PrizeParams
+func RandomPrizeParams(r *rand.Rand) types.PrizeParams {
+	percentages := randomPercentages(r, r.Intn(3)+1) // Minimum 1 tier, max 3 tiers
+
+	tiers := make([]types.PrizeTier, len(percentages))
+	for i, percentage := range percentages {
+		tiers[i] = types.NewPrizeTier(
+			uint32(r.Intn(5)+1), // Minimum 1 winner, max 5 winners
+			sdk.NewDecWithPrec(percentage, 2),
+		)
+	}
+
+	return types.NewPrizeParams(tiers)
+}
+
+// RandomRolloverParams returns randomly generated RolloverParams
+func RandomRolloverParams(r *rand.Rand) types.RolloverParams {
+	return types.NewRolloverParams(
+		uint32(r.Intn(4)),                        // Minimum 0 (unlimited), max 3 rollovers
+		sdk.NewDecWithPrec(int64(r.Intn(11)), 2), // Minimum 0%, max 10%
+	)
+}
+
+// RandomPruningParams returns randomly generated PruningParams
+func RandomPruningParams(r *rand.Rand) types.PruningParams {
+	return types.NewPruningParams(uint32(r.Intn(100) + 1)) // Minimum 1, max 100 tickets per block
+}
+
+// RandomSalesParams returns randomly generated SalesParams, halting the ticket sales 10% of the times
+func RandomSalesParams(r *rand.Rand) types.SalesParams {
+	if r.Intn(10) != 0 {
+		return types.DefaultSalesParams()
+	}
+	return types.NewSalesParams(true, simtypes.RandStringOfLength(r, 20))
+}
+
+// RandomSpendingLimitParams returns randomly generated SpendingLimitParams
+func RandomSpendingLimitParams(r *rand.Rand) types.SpendingLimitParams {
+	return types.NewSpendingLimitParams(time.Duration(r.Intn(49)) * time.Hour) // Minimum 0, max 48 hours
+}
+
+// RandomOracleParams returns randomly generated OracleParams, whitelisting some of the given accounts as oracles
+func RandomOracleParams(r *rand.Rand, accs []simtypes.Account) types.OracleParams {
+	var oracles []string
+	for _, acc := range accs[:r.Intn(3)+1] { // Minimum 1, max 3 oracles
+		oracles = append(oracles, acc.Address.String())
+	}
+	return types.NewOracleParams(oracles, time.Duration(r.Intn(24)+1)*time.Hour) // Minimum 1, max 24 hours
+}
+
+// RandomGameParams returns randomly generated GameParams, representing either a random or a lotto game
+func RandomGameParams(r *rand.Rand) types.GameParams {
+	if r.Intn(2) == 0 {
+		return types.DefaultGameParams()
+	}
+
+	picks := uint32(r.Intn(4) + 3)                     // Minimum 3 picks, max 6 picks
+	maxNumber := picks + uint32(r.Intn(int(50-picks))) // Max 49
+	percentages := randomPercentages(r, r.Intn(int(picks))+1)
+
+	// Tiers are won by matching all the picks, then one less, and so on
+	tiers := make([]types.MatchTier, len(percentages))
+	for i, percentage := range percentages {
+		tiers[i] = types.NewMatchTier(picks-uint32(i), sdk.NewDecWithPrec(percentage, 2))
+	}
+
+	return types.NewGameParams(types.GameTypeLotto, picks, maxNumber, tiers)
+}
+
+// RandomNumbersPicks returns n randomly generated picks that are valid inside a lotto game having the given params
+func RandomNumbersPicks(r *rand.Rand, params types.GameParams, n uint32) []types.NumbersPick {
+	picks := make([]types.NumbersPick, n)
+	for i := range picks {
+		numbers := make([]uint32, params.Picks)
+		for j, index := range r.Perm(int(params.MaxNumber))[:params.Picks] {
+			numbers[j] = uint32(index + 1)
+		}
+		picks[i] = types.NewNumbersPick(numbers...)
+	}
+	return picks
+}
+
+// randomPercentages returns count random percentages, each one of at least 1%, that sum to 100%
+func randomPercentages(r *rand.Rand, count int) []int64 {
+	percentages := make([]int64, count)
+	remaining := int64(100)
+	for i := range percentages {
+		// Make sure each of the following values can get at least 1%
+		percentage := remaining
+		if i < count-1 {
+			percentage = r.Int63n(remaining-int64(count-i-1)) + 1
+		}
+		remaining -= percentage
+		percentages[i] = percentage
+	}
+	return percentages
+}
@@ -0,0 +1,135 @@
+package testutil
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/cosmicbet/ledger/app"
+	"github.com/cosmicbet/ledger/x/wta/keeper"
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+// TestInput contains the context and the keepers used while testing the wta module
+type TestInput struct {
+	Cdc      codec.BinaryMarshaler
+	Ctx      sdk.Context
+	StoreKey sdk.StoreKey
+	Keeper   keeper.Keeper
+	AK       authkeeper.AccountKeeper
+	BK       bankkeeper.Keeper
+	DK       distrkeeper.Keeper
+	SK       stakingkeeper.Keeper
+	PK       paramskeeper.Keeper
+}
+
+// NewTestInput builds a new TestInput backed by an in-memory database.
+// The wta keeper uses the default parameters, and the current draw of the default pool has id 1
+func NewTestInput() TestInput {
+	var input TestInput
+
+	// Store keys
+	keys := sdk.NewKVStoreKeys(
+		authtypes.StoreKey, banktypes.StoreKey, distrtypes.StoreKey, paramstypes.StoreKey, stakingtypes.StoreKey,
+		types.StoreKey,
+	)
+	input.StoreKey = keys[types.StoreKey]
+
+	// Transient keys
+	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+
+	// Create an in-memory db
+	memDB := db.NewMemDB()
+	ms := store.NewCommitMultiStore(memDB)
+
+	// Mount keys
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, memDB)
+	}
+
+	// Mount transient keys
+	for _, key := range tKeys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeTransient, memDB)
+	}
+
+	// Load the database
+	err := ms.LoadLatestVersion()
+	if err != nil {
+		panic(err)
+	}
+
+	// Create a custom ctx with custom time
+	blockTime, _ := time.Parse(time.RFC3339, "2021-01-01T00:00:00.000Z")
+	input.Ctx = sdk.NewContext(
+		ms,
+		tmproto.Header{ChainID: "test-chain-id", Time: blockTime},
+		false,
+		log.NewNopLogger(),
+	)
+
+	encodingConfig := app.MakeEncodingConfig()
+	input.Cdc = encodingConfig.Marshaler
+
+	// Build the keepers
+	input.PK = paramskeeper.NewKeeper(input.Cdc, encodingConfig.Amino, keys[paramstypes.StoreKey], tKeys[paramstypes.TStoreKey])
+
+	input.AK = authkeeper.NewAccountKeeper(
+		input.Cdc, keys[authtypes.StoreKey], input.PK.Subspace(authtypes.ModuleName),
+		authtypes.ProtoBaseAccount, app.GetMaccPerms(),
+	)
+
+	input.BK = bankkeeper.NewBaseKeeper(
+		input.Cdc, keys[banktypes.StoreKey], input.AK, input.PK.Subspace(banktypes.ModuleName), app.BlockedAddrs(),
+	)
+
+	input.SK = stakingkeeper.NewKeeper(
+		input.Cdc, keys[stakingtypes.StoreKey], input.AK, input.BK, input.PK.Subspace(stakingtypes.ModuleName),
+	)
+
+	input.DK = distrkeeper.NewKeeper(
+		input.Cdc, keys[distrtypes.StoreKey], input.PK.Subspace(distrtypes.ModuleName),
+		input.AK, input.BK, &input.SK,
+		authtypes.FeeCollectorName, app.BlockedAddrs(),
+	)
+
+	// Default fees to avoid errors
+	input.DK.SetFeePool(input.Ctx, distrtypes.InitialFeePool())
+
+	input.Keeper = keeper.NewKeeper(
+		input.Cdc,
+		keys[types.StoreKey],
+		input.PK.Subspace(types.ModuleName),
+		input.AK,
+		input.BK,
+		input.DK,
+		input.SK,
+		authtypes.FeeCollectorName,
+	)
+	input.Keeper.SetDistributionParams(input.Ctx, types.DefaultDistributionParams())
+	input.Keeper.SetDrawParams(input.Ctx, types.DefaultDrawParams())
+	input.Keeper.SetTicketParams(input.Ctx, types.DefaultTicketParams())
+	input.Keeper.SetPrizeParams(input.Ctx, types.DefaultPrizeParams())
+	input.Keeper.SetRolloverParams(input.Ctx, types.DefaultRolloverParams())
+	input.Keeper.SetPruningParams(input.Ctx, types.DefaultPruningParams())
+	input.Keeper.SetSalesParams(input.Ctx, types.DefaultSalesParams())
+	input.Keeper.SetSpendingLimitParams(input.Ctx, types.DefaultSpendingLimitParams())
+	input.Keeper.SetOracleParams(input.Ctx, types.DefaultOracleParams())
+	input.Keeper.SaveCurrentDrawID(input.Ctx, types.DefaultPoolID, 1)
+
+	return input
+}
@@ -8,15 +8,37 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgBuyTickets{}, "cosmicbet/MsgBuyTickets", nil)
+	cdc.RegisterConcrete(MsgCommitEntropy{}, "cosmicbet/MsgCommitEntropy", nil)
+	cdc.RegisterConcrete(MsgRevealEntropy{}, "cosmicbet/MsgRevealEntropy", nil)
+	cdc.RegisterConcrete(MsgSelfExclude{}, "cosmicbet/MsgSelfExclude", nil)
+	cdc.RegisterConcrete(MsgSetSpendingLimit{}, "cosmicbet/MsgSetSpendingLimit", nil)
+	cdc.RegisterConcrete(MsgPostPrice{}, "cosmicbet/MsgPostPrice", nil)
+	cdc.RegisterConcrete(MsgTransferTickets{}, "cosmicbet/MsgTransferTickets", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgBuyTickets{},
+		&MsgCommitEntropy{},
+		&MsgRevealEntropy{},
+		&MsgSelfExclude{},
+		&MsgSetSpendingLimit{},
+		&MsgPostPrice{},
+		&MsgTransferTickets{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&CancelDrawProposal{},
+		&CreatePoolProposal{},
+		&PauseSalesProposal{},
+		&ResumeSalesProposal{},
+		&CloseDrawProposal{},
+		&SetSalesHaltedProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
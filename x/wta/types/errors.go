@@ -0,0 +1,35 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/wta module sentinel errors
+var (
+	ErrDrawClosed           = sdkerrors.Register(ModuleName, 2, "draw closed")
+	ErrNotValidator         = sdkerrors.Register(ModuleName, 3, "not a bonded validator")
+	ErrCommitmentExists     = sdkerrors.Register(ModuleName, 4, "entropy commitment already exists")
+	ErrCommitmentNotFound   = sdkerrors.Register(ModuleName, 5, "entropy commitment not found")
+	ErrRevealNotAllowed     = sdkerrors.Register(ModuleName, 6, "entropy reveal not allowed")
+	ErrInvalidReveal        = sdkerrors.Register(ModuleName, 7, "invalid entropy reveal")
+	ErrPoolNotFound         = sdkerrors.Register(ModuleName, 8, "pool not found")
+	ErrInvalidPick          = sdkerrors.Register(ModuleName, 9, "invalid numbers pick")
+	ErrPoolExists           = sdkerrors.Register(ModuleName, 10, "pool already exists")
+	ErrSalesPaused          = sdkerrors.Register(ModuleName, 11, "ticket sales paused")
+	ErrSalesHalted          = sdkerrors.Register(ModuleName, 12, "ticket sales halted")
+	ErrSalesCutoff          = sdkerrors.Register(ModuleName, 13, "ticket sales cut off")
+	ErrMaxTicketsPerMsg     = sdkerrors.Register(ModuleName, 14, "too many tickets per message")
+	ErrMaxTicketsPerAddress = sdkerrors.Register(ModuleName, 15, "too many tickets per address")
+	ErrSelfExcluded         = sdkerrors.Register(ModuleName, 16, "address self excluded")
+	ErrInvalidExclusion     = sdkerrors.Register(ModuleName, 17, "invalid self exclusion")
+	ErrSpendingLimitReached = sdkerrors.Register(ModuleName, 18, "spending limit exceeded")
+	ErrInvalidSpendingLimit = sdkerrors.Register(ModuleName, 19, "invalid spending limit")
+	ErrDenomNotAccepted     = sdkerrors.Register(ModuleName, 20, "denom not accepted")
+	ErrInvalidOracle        = sdkerrors.Register(ModuleName, 21, "invalid oracle")
+	ErrInvalidPrice         = sdkerrors.Register(ModuleName, 22, "invalid price")
+	ErrStalePrice           = sdkerrors.Register(ModuleName, 23, "stale price")
+	ErrTicketNotFound       = sdkerrors.Register(ModuleName, 24, "ticket not found")
+	ErrTicketExists         = sdkerrors.Register(ModuleName, 25, "ticket already exists")
+)
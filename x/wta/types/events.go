@@ -3,16 +3,60 @@ package types
 // DONTCOVER
 
 const (
-	EventTypeBuyTicket     = "buy_ticket"
-	EventTypePrizeIncrease = "prize_increase"
-	EventTypeWinnerDrawn   = "winner_drawn"
-	EventTypeNewDraw       = "new_draw"
+	EventTypeBuyTicket      = "buy_ticket"
+	EventTypePrizeIncrease  = "prize_increase"
+	EventTypeWinnerDrawn    = "winner_drawn"
+	EventTypeNewDraw        = "new_draw"
+	EventTypeCommitEntropy  = "commit_entropy"
+	EventTypeRevealEntropy  = "reveal_entropy"
+	EventTypeMissedReveal   = "missed_reveal"
+	EventTypeDrawRolledOver = "draw_rolled_over"
+	EventTypeDrawRefunded   = "draw_refunded"
+	EventTypeDrawCancelled  = "draw_cancelled"
+	EventTypeTicketsRefund  = "tickets_refund"
+	EventTypeDrawPruned     = "draw_pruned"
+	EventTypeTicketsMoved   = "tickets_moved"
+	EventTypePoolCreated    = "pool_created"
+	EventTypeSalesPaused    = "sales_paused"
+	EventTypeSalesResumed   = "sales_resumed"
+	EventTypeDrawClosed     = "draw_closed"
+	EventTypeSalesHalted    = "sales_halted"
+	EventTypeSalesUnhalted  = "sales_unhalted"
+	EventTypeSelfExclude    = "self_exclude"
+	EventTypeSpendingLimit  = "spending_limit"
+	EventTypePostPrice      = "post_price"
+	EventTypeTransferTicket = "transfer_ticket"
 
+	AttributeKeyPoolID          = "pool_id"
 	AttributeKeyTicketID        = "ticket_id"
 	AttributeKeyTicketBuyer     = "ticket_buyer"
+	AttributeKeyTicketOwner     = "ticket_owner"
 	AttributeKeyTicketTimestamp = "ticket_timestamp"
 	AttributeKeyPrizeAmount     = "prize_amount"
 	AttributeKeyWinnerAddress   = "winner_address"
 	AttributeKeyWonAmount       = "won_amount"
+	AttributeKeyPrizeTier       = "prize_tier"
 	AttributeKeyDrawClosing     = "draw_closing"
+	AttributeKeyValidator       = "validator"
+	AttributeKeyCommitment      = "commitment"
+	AttributeKeyMissedReveals   = "missed_reveals"
+	AttributeKeyDrawID          = "draw_id"
+	AttributeKeyNewDrawID       = "new_draw_id"
+	AttributeKeyRollovers       = "rollovers"
+	AttributeKeyTreasuryAmount  = "treasury_amount"
+	AttributeKeyRefundedAmount  = "refunded_amount"
+	AttributeKeyTicketsPayer    = "tickets_payer"
+	AttributeKeyTicketsCount    = "tickets_count"
+	AttributeKeyHaltReason      = "halt_reason"
+	AttributeKeyAddress         = "address"
+	AttributeKeyExcludedUntil   = "excluded_until"
+	AttributeKeyLimitAmount     = "limit_amount"
+	AttributeKeyLimitWindow     = "limit_window"
+	AttributeKeyEffectiveTime   = "effective_time"
+	AttributeKeyOracle          = "oracle"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyPrice           = "price"
+	AttributeKeyMedianPrice     = "median_price"
+	AttributeKeyTicketSender    = "ticket_sender"
+	AttributeKeyTicketRecipient = "ticket_recipient"
 )
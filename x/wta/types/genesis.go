@@ -7,38 +7,157 @@ import (
 
 // NewGenesisState returns a new GenesisState containing the provided data
 func NewGenesisState(
-	drawEndTime time.Time, tickets []Ticket, pastDraws []HistoricalDrawData,
-	distributionParams DistributionParams, drawParams DrawParams, ticketParams TicketParams,
+	drawID uint64, drawEndTime time.Time, drawRollovers uint32, tickets []Ticket, pastDraws []HistoricalDrawData,
+	distributionParams DistributionParams, drawParams DrawParams, ticketParams TicketParams, prizeParams PrizeParams,
+	rolloverParams RolloverParams, pruningParams PruningParams, entropyCommitments []EntropyCommitment,
+	missedReveals []MissedReveals, pools []PoolState, pausedPools []uint64, salesParams SalesParams,
+	upcomingDrawID uint64, exclusions []Exclusion, spendingLimitParams SpendingLimitParams,
+	spendingLimits []SpendingLimit, spendRecords []SpendRecord, drawFees []DrawFees, oracleParams OracleParams,
+	pricePosts []PricePost, prices []Price,
 ) *GenesisState {
 	return &GenesisState{
-		DrawEndTime:        drawEndTime,
-		Tickets:            tickets,
-		PastDraws:          pastDraws,
-		DistributionParams: distributionParams,
-		DrawParams:         drawParams,
-		TicketParams:       ticketParams,
+		DrawId:              drawID,
+		DrawEndTime:         drawEndTime,
+		DrawRollovers:       drawRollovers,
+		Tickets:             tickets,
+		PastDraws:           pastDraws,
+		DistributionParams:  distributionParams,
+		DrawParams:          drawParams,
+		TicketParams:        ticketParams,
+		PrizeParams:         prizeParams,
+		RolloverParams:      rolloverParams,
+		PruningParams:       pruningParams,
+		EntropyCommitments:  entropyCommitments,
+		MissedReveals:       missedReveals,
+		Pools:               pools,
+		PausedPools:         pausedPools,
+		SalesParams:         salesParams,
+		UpcomingDrawId:      upcomingDrawID,
+		Exclusions:          exclusions,
+		SpendingLimitParams: spendingLimitParams,
+		SpendingLimits:      spendingLimits,
+		SpendRecords:        spendRecords,
+		DrawFees:            drawFees,
+		OracleParams:        oracleParams,
+		PricePosts:          pricePosts,
+		Prices:              prices,
+	}
+}
+
+// NewPoolState returns a new PoolState containing the provided data
+func NewPoolState(
+	pool Pool, drawID uint64, drawEndTime time.Time, drawRollovers uint32, upcomingDrawID uint64,
+) PoolState {
+	return PoolState{
+		Pool:           pool,
+		DrawId:         drawID,
+		DrawEndTime:    drawEndTime,
+		DrawRollovers:  drawRollovers,
+		UpcomingDrawId: upcomingDrawID,
 	}
 }
 
 // DefaultGenesisState returns a default GenesisState
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
+		1,
 		time.Now().Add(time.Hour*24),
+		0,
 		[]Ticket{},
 		[]HistoricalDrawData{},
 		DefaultDistributionParams(),
 		DefaultDrawParams(),
 		DefaultTicketParams(),
+		DefaultPrizeParams(),
+		DefaultRolloverParams(),
+		DefaultPruningParams(),
+		[]EntropyCommitment{},
+		[]MissedReveals{},
+		[]PoolState{},
+		[]uint64{},
+		DefaultSalesParams(),
+		0,
+		[]Exclusion{},
+		DefaultSpendingLimitParams(),
+		[]SpendingLimit{},
+		[]SpendRecord{},
+		[]DrawFees{},
+		DefaultOracleParams(),
+		[]PricePost{},
+		[]Price{},
 	)
 }
 
 // ValidateGenesis validates the given genesis state and returns an error if something is invalid
 func ValidateGenesis(state *GenesisState) error {
 	// Validate the draw
+	if state.DrawId == 0 {
+		return fmt.Errorf("invalid draw id: %d", state.DrawId)
+	}
+
 	if state.DrawEndTime.IsZero() || time.Now().After(state.DrawEndTime) {
 		return fmt.Errorf("invalid draw end time: %s", state.DrawEndTime.Format(time.RFC3339))
 	}
 
+	// Validate the pools, collecting the end time of each current draw.
+	// Tickets of an upcoming draw are bought before the current draw of the same pool ends
+	drawsEndTimes := map[uint64]time.Time{state.DrawId: state.DrawEndTime}
+	if state.UpcomingDrawId != 0 {
+		if state.UpcomingDrawId == state.DrawId {
+			return fmt.Errorf("upcoming draw id %d is used by the current draw", state.UpcomingDrawId)
+		}
+		drawsEndTimes[state.UpcomingDrawId] = state.DrawEndTime
+	}
+
+	for _, p := range state.Pools {
+		if p.Pool.Id == DefaultPoolID {
+			return fmt.Errorf("pool id %d is reserved to the default pool", DefaultPoolID)
+		}
+
+		if IsPoolIDDuplicated(p.Pool.Id, state.Pools) {
+			return fmt.Errorf("pool id duplicated: %d", p.Pool.Id)
+		}
+
+		err := p.Pool.Validate()
+		if err != nil {
+			return err
+		}
+
+		if p.DrawId == 0 {
+			return fmt.Errorf("invalid draw id of pool %d: %d", p.Pool.Id, p.DrawId)
+		}
+
+		if _, found := drawsEndTimes[p.DrawId]; found {
+			return fmt.Errorf("draw id duplicated: %d", p.DrawId)
+		}
+
+		if p.DrawEndTime.IsZero() || time.Now().After(p.DrawEndTime) {
+			return fmt.Errorf("invalid draw end time of pool %d: %s", p.Pool.Id, p.DrawEndTime.Format(time.RFC3339))
+		}
+
+		drawsEndTimes[p.DrawId] = p.DrawEndTime
+
+		if p.UpcomingDrawId != 0 {
+			if _, found := drawsEndTimes[p.UpcomingDrawId]; found {
+				return fmt.Errorf("draw id duplicated: %d", p.UpcomingDrawId)
+			}
+			drawsEndTimes[p.UpcomingDrawId] = p.DrawEndTime
+		}
+	}
+
+	// Validate the paused pools
+	for i, poolID := range state.PausedPools {
+		if poolID != DefaultPoolID && !IsPoolIDPresent(poolID, state.Pools) {
+			return fmt.Errorf("paused pool %d not found", poolID)
+		}
+
+		for _, other := range state.PausedPools[i+1:] {
+			if other == poolID {
+				return fmt.Errorf("paused pool id duplicated: %d", poolID)
+			}
+		}
+	}
+
 	// Validate the tickets
 	for _, t := range state.Tickets {
 		err := t.Validate()
@@ -46,8 +165,15 @@ func ValidateGenesis(state *GenesisState) error {
 			return err
 		}
 
+		// Check that the ticket belongs to one of the current or upcoming draws
+		drawEndTime, found := drawsEndTimes[t.DrawId]
+		if !found {
+			return fmt.Errorf("ticket with id %s has draw id %d different from the current and upcoming ones",
+				t.Id, t.DrawId)
+		}
+
 		// Check that the timestamp is not after the current draw
-		if t.Timestamp.After(state.DrawEndTime) {
+		if t.Timestamp.After(drawEndTime) {
 			return fmt.Errorf("ticket with id %s has creation date after the draw end time ", t.Id)
 		}
 
@@ -68,6 +194,107 @@ func ValidateGenesis(state *GenesisState) error {
 		if err != nil {
 			return err
 		}
+
+		if _, found := drawsEndTimes[data.Draw.Id]; found {
+			return fmt.Errorf("past draw id %d is used by a current or upcoming draw", data.Draw.Id)
+		}
+
+		if IsHistoricalDrawIDDuplicated(data.Draw.Id, state.PastDraws) {
+			return fmt.Errorf("past draw id duplicated: %d", data.Draw.Id)
+		}
+	}
+
+	// Validate the entropy commitments
+	for _, c := range state.EntropyCommitments {
+		err := c.Validate()
+		if err != nil {
+			return err
+		}
+
+		if IsEntropyCommitmentDuplicated(c.PoolId, c.Validator, state.EntropyCommitments) {
+			return fmt.Errorf("entropy commitment duplicated for validator: %s", c.Validator)
+		}
+	}
+
+	// Validate the missed reveals
+	for _, m := range state.MissedReveals {
+		err := m.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate the self exclusions
+	for _, e := range state.Exclusions {
+		err := e.Validate()
+		if err != nil {
+			return err
+		}
+
+		if IsExclusionDuplicated(e.Address, state.Exclusions) {
+			return fmt.Errorf("exclusion duplicated for address: %s", e.Address)
+		}
+	}
+
+	// Validate the spending limits
+	for _, l := range state.SpendingLimits {
+		err := l.Validate()
+		if err != nil {
+			return err
+		}
+
+		if IsSpendingLimitDuplicated(l.Address, state.SpendingLimits) {
+			return fmt.Errorf("spending limit duplicated for address: %s", l.Address)
+		}
+	}
+
+	// Validate the spend records
+	for _, r := range state.SpendRecords {
+		err := r.Validate()
+		if err != nil {
+			return err
+		}
+
+		if IsSpendRecordDuplicated(r.Address, r.Time, state.SpendRecords) {
+			return fmt.Errorf("spend record duplicated for address %s and time %s",
+				r.Address, r.Time.Format(time.RFC3339))
+		}
+	}
+
+	// Validate the draw fees
+	for _, f := range state.DrawFees {
+		err := f.Validate()
+		if err != nil {
+			return err
+		}
+
+		if IsDrawFeesDuplicated(f.DrawId, f.Destination, state.DrawFees) {
+			return fmt.Errorf("draw fees duplicated for draw %d and destination %s", f.DrawId, f.Destination)
+		}
+	}
+
+	// Validate the price posts
+	for _, p := range state.PricePosts {
+		err := p.Validate()
+		if err != nil {
+			return err
+		}
+
+		if IsPricePostDuplicated(p.Denom, p.Oracle, state.PricePosts) {
+			return fmt.Errorf("price post duplicated for denom %s and oracle %s", p.Denom, p.Oracle)
+		}
+	}
+
+	// Validate the prices
+	for _, p := range state.Prices {
+		err := p.Validate()
+		if err != nil {
+			return err
+		}
+
+		if IsPriceDuplicated(p.Denom, state.Prices) {
+			return fmt.Errorf("price duplicated for denom: %s", p.Denom)
+		}
 	}
 
 	// Validate the params
@@ -86,5 +313,35 @@ func ValidateGenesis(state *GenesisState) error {
 		return err
 	}
 
+	err = ValidatePrizeParams(state.PrizeParams)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateRolloverParams(state.RolloverParams)
+	if err != nil {
+		return err
+	}
+
+	err = ValidatePruningParams(state.PruningParams)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateSalesParams(state.SalesParams)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateSpendingLimitParams(state.SpendingLimitParams)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateOracleParams(state.OracleParams)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
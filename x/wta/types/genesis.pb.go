@@ -41,6 +41,45 @@ type GenesisState struct {
 	DrawParams DrawParams `protobuf:"bytes,5,opt,name=draw_params,json=drawParams,proto3" json:"draw_params"`
 	// Represents the parameters related to each ticket
 	TicketParams TicketParams `protobuf:"bytes,6,opt,name=ticket_params,json=ticketParams,proto3" json:"ticket_params"`
+	// Defines the entropy commitments present at genesis time
+	EntropyCommitments []EntropyCommitment `protobuf:"bytes,7,rep,name=entropy_commitments,json=entropyCommitments,proto3" json:"entropy_commitments"`
+	// Defines the missed reveals of each validator present at genesis time
+	MissedReveals []MissedReveals `protobuf:"bytes,8,rep,name=missed_reveals,json=missedReveals,proto3" json:"missed_reveals"`
+	// Defines the id of the next draw
+	DrawId uint64 `protobuf:"varint,9,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	// Defines the additional pools present at genesis time
+	Pools []PoolState `protobuf:"bytes,10,rep,name=pools,proto3" json:"pools"`
+	// Represents the parameters related to the prize payout table
+	PrizeParams PrizeParams `protobuf:"bytes,11,opt,name=prize_params,json=prizeParams,proto3" json:"prize_params"`
+	// Represents the parameters related to the draws rollovers
+	RolloverParams RolloverParams `protobuf:"bytes,12,opt,name=rollover_params,json=rolloverParams,proto3" json:"rollover_params"`
+	// Defines the number of consecutive rollovers of the next draw
+	DrawRollovers uint32 `protobuf:"varint,13,opt,name=draw_rollovers,json=drawRollovers,proto3" json:"draw_rollovers,omitempty"`
+	// Represents the parameters related to the removal of past draws tickets
+	PruningParams PruningParams `protobuf:"bytes,14,opt,name=pruning_params,json=pruningParams,proto3" json:"pruning_params"`
+	// Defines the ids of the pools whose ticket sales are paused
+	PausedPools []uint64 `protobuf:"varint,15,rep,packed,name=paused_pools,json=pausedPools,proto3" json:"paused_pools,omitempty"`
+	// Represents the parameters related to the halt of the ticket sales
+	SalesParams SalesParams `protobuf:"bytes,16,opt,name=sales_params,json=salesParams,proto3" json:"sales_params"`
+	// Defines the id reserved to the draw following the next one, if any ticket
+	// has already been bought for it during the sales cutoff window
+	UpcomingDrawId uint64 `protobuf:"varint,17,opt,name=upcoming_draw_id,json=upcomingDrawId,proto3" json:"upcoming_draw_id,omitempty"`
+	// Defines the self exclusions of the addresses that cannot buy tickets
+	Exclusions []Exclusion `protobuf:"bytes,18,rep,name=exclusions,proto3" json:"exclusions"`
+	// Represents the parameters related to the spending limits of the addresses
+	SpendingLimitParams SpendingLimitParams `protobuf:"bytes,19,opt,name=spending_limit_params,json=spendingLimitParams,proto3" json:"spending_limit_params"`
+	// Defines the spending limits set by the addresses
+	SpendingLimits []SpendingLimit `protobuf:"bytes,20,rep,name=spending_limits,json=spendingLimits,proto3" json:"spending_limits"`
+	// Defines the amounts spent by the addresses having a spending limit
+	SpendRecords []SpendRecord `protobuf:"bytes,21,rep,name=spend_records,json=spendRecords,proto3" json:"spend_records"`
+	// Defines the fees of the draws that have been sent to each destination
+	DrawFees []DrawFees `protobuf:"bytes,22,rep,name=draw_fees,json=drawFees,proto3" json:"draw_fees"`
+	// Represents the parameters related to the price feed
+	OracleParams OracleParams `protobuf:"bytes,23,opt,name=oracle_params,json=oracleParams,proto3" json:"oracle_params"`
+	// Defines the prices posted by the oracles
+	PricePosts []PricePost `protobuf:"bytes,24,rep,name=price_posts,json=pricePosts,proto3" json:"price_posts"`
+	// Defines the median prices of the denominations
+	Prices []Price `protobuf:"bytes,25,rep,name=prices,proto3" json:"prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -118,8 +157,224 @@ func (m *GenesisState) GetTicketParams() TicketParams {
 	return TicketParams{}
 }
 
+func (m *GenesisState) GetEntropyCommitments() []EntropyCommitment {
+	if m != nil {
+		return m.EntropyCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedReveals() []MissedReveals {
+	if m != nil {
+		return m.MissedReveals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPools() []PoolState {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrizeParams() PrizeParams {
+	if m != nil {
+		return m.PrizeParams
+	}
+	return PrizeParams{}
+}
+
+func (m *GenesisState) GetRolloverParams() RolloverParams {
+	if m != nil {
+		return m.RolloverParams
+	}
+	return RolloverParams{}
+}
+
+func (m *GenesisState) GetDrawRollovers() uint32 {
+	if m != nil {
+		return m.DrawRollovers
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPruningParams() PruningParams {
+	if m != nil {
+		return m.PruningParams
+	}
+	return PruningParams{}
+}
+
+func (m *GenesisState) GetPausedPools() []uint64 {
+	if m != nil {
+		return m.PausedPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSalesParams() SalesParams {
+	if m != nil {
+		return m.SalesParams
+	}
+	return SalesParams{}
+}
+
+func (m *GenesisState) GetUpcomingDrawId() uint64 {
+	if m != nil {
+		return m.UpcomingDrawId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetExclusions() []Exclusion {
+	if m != nil {
+		return m.Exclusions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpendingLimitParams() SpendingLimitParams {
+	if m != nil {
+		return m.SpendingLimitParams
+	}
+	return SpendingLimitParams{}
+}
+
+func (m *GenesisState) GetSpendingLimits() []SpendingLimit {
+	if m != nil {
+		return m.SpendingLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpendRecords() []SpendRecord {
+	if m != nil {
+		return m.SpendRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDrawFees() []DrawFees {
+	if m != nil {
+		return m.DrawFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleParams() OracleParams {
+	if m != nil {
+		return m.OracleParams
+	}
+	return OracleParams{}
+}
+
+func (m *GenesisState) GetPricePosts() []PricePost {
+	if m != nil {
+		return m.PricePosts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrices() []Price {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+// PoolState contains the genesis data of a single additional pool
+type PoolState struct {
+	Pool Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+	// Defines the id of the next draw of the pool
+	DrawId uint64 `protobuf:"varint,2,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	// Defines the end time of the next draw of the pool
+	DrawEndTime time.Time `protobuf:"bytes,3,opt,name=draw_end_time,json=drawEndTime,proto3,stdtime" json:"draw_end_time"`
+	// Defines the number of consecutive rollovers of the next draw of the pool
+	DrawRollovers uint32 `protobuf:"varint,4,opt,name=draw_rollovers,json=drawRollovers,proto3" json:"draw_rollovers,omitempty"`
+	// Defines the id reserved to the draw of the pool following the next one, if
+	// any ticket has already been bought for it during the sales cutoff window
+	UpcomingDrawId uint64 `protobuf:"varint,5,opt,name=upcoming_draw_id,json=upcomingDrawId,proto3" json:"upcoming_draw_id,omitempty"`
+}
+
+func (m *PoolState) Reset()         { *m = PoolState{} }
+func (m *PoolState) String() string { return proto.CompactTextString(m) }
+func (*PoolState) ProtoMessage()    {}
+func (*PoolState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a535c905d1a534c, []int{1}
+}
+func (m *PoolState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolState.Merge(m, src)
+}
+func (m *PoolState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolState proto.InternalMessageInfo
+
+func (m *PoolState) GetPool() Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return Pool{}
+}
+
+func (m *PoolState) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
+	}
+	return 0
+}
+
+func (m *PoolState) GetDrawEndTime() time.Time {
+	if m != nil {
+		return m.DrawEndTime
+	}
+	return time.Time{}
+}
+
+func (m *PoolState) GetDrawRollovers() uint32 {
+	if m != nil {
+		return m.DrawRollovers
+	}
+	return 0
+}
+
+func (m *PoolState) GetUpcomingDrawId() uint64 {
+	if m != nil {
+		return m.UpcomingDrawId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmicbet.wta.v1beta1.GenesisState")
+	proto.RegisterType((*PoolState)(nil), "cosmicbet.wta.v1beta1.PoolState")
 }
 
 func init() {
@@ -127,33 +382,63 @@ func init() {
 }
 
 var fileDescriptor_8a535c905d1a534c = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x13, 0x7a, 0x1c, 0xe0, 0xde, 0x2d, 0x06, 0xa4, 0xa8, 0x12, 0xe9, 0x71, 0x37, 0x70,
-	0x2c, 0xb6, 0xee, 0x98, 0x19, 0x38, 0x15, 0xd1, 0xa9, 0x42, 0xa5, 0x13, 0x4b, 0x70, 0x62, 0x13,
-	0x2c, 0x92, 0x38, 0xb2, 0x5f, 0x09, 0x7c, 0x8b, 0x7e, 0xac, 0x8e, 0x1d, 0x99, 0x00, 0x35, 0x5f,
-	0x04, 0xd9, 0x49, 0x4a, 0x2b, 0xda, 0x6e, 0xc9, 0xf3, 0xef, 0xfd, 0xfc, 0xfc, 0xb7, 0xd1, 0x55,
-	0xa2, 0x4c, 0x2e, 0x93, 0x58, 0x00, 0xad, 0x80, 0xd1, 0x6f, 0x37, 0xb1, 0x00, 0x76, 0x43, 0x53,
-	0x51, 0x08, 0x23, 0x0d, 0x29, 0xb5, 0x02, 0x85, 0x9f, 0x6e, 0x20, 0x52, 0x01, 0x23, 0x2d, 0x34,
-	0x78, 0x92, 0xaa, 0x54, 0x39, 0x82, 0xda, 0xaf, 0x06, 0x1e, 0x0c, 0x53, 0xa5, 0xd2, 0x4c, 0x50,
-	0xf7, 0x17, 0xcf, 0x3f, 0x53, 0x90, 0xb9, 0x30, 0xc0, 0xf2, 0xb2, 0x05, 0x2e, 0xf7, 0x6f, 0x99,
-	0x2b, 0x2e, 0x32, 0x73, 0x9c, 0x29, 0x99, 0x66, 0x79, 0xcb, 0x5c, 0xd6, 0x3d, 0x74, 0xf6, 0xae,
-	0x99, 0xf3, 0x03, 0x30, 0x10, 0x78, 0x8c, 0xce, 0xb9, 0x66, 0x55, 0x24, 0x0a, 0x1e, 0xd9, 0x4d,
-	0x03, 0xff, 0xc2, 0xbf, 0xee, 0xdf, 0x0e, 0x48, 0x33, 0x11, 0xe9, 0x26, 0x22, 0xb3, 0x6e, 0xa2,
-	0xbb, 0x87, 0xcb, 0x5f, 0x43, 0x6f, 0xf1, 0x7b, 0xe8, 0x4f, 0xfb, 0xb6, 0xf5, 0x6d, 0xc1, 0xed,
-	0x1a, 0x7e, 0x8d, 0x1e, 0x80, 0x4c, 0xbe, 0x0a, 0x30, 0xc1, 0xbd, 0x8b, 0xde, 0x75, 0xff, 0xf6,
-	0x19, 0xd9, 0x1b, 0x01, 0x99, 0x39, 0xea, 0xee, 0xc4, 0x6a, 0xa6, 0x5d, 0x0f, 0x9e, 0x20, 0x54,
-	0x32, 0x03, 0x91, 0x55, 0x9a, 0xa0, 0xe7, 0x0c, 0x2f, 0x0f, 0x18, 0xc6, 0xd2, 0x80, 0xd2, 0x32,
-	0x61, 0xd9, 0x48, 0xb3, 0x6a, 0xc4, 0x80, 0xb5, 0xb6, 0x47, 0x56, 0x61, 0x6b, 0x06, 0x7f, 0x42,
-	0x8f, 0xb9, 0x34, 0xa0, 0x65, 0x3c, 0x07, 0xa9, 0x8a, 0xa8, 0x89, 0x21, 0x38, 0x71, 0xc7, 0x3b,
-	0x24, 0x1e, 0x6d, 0x75, 0xbc, 0x77, 0x0d, 0xad, 0x18, 0xf3, 0xff, 0x56, 0xf0, 0x18, 0xb9, 0xf3,
-	0x77, 0xe6, 0xfb, 0xce, 0xfc, 0xfc, 0x90, 0x59, 0xb3, 0x6a, 0xc7, 0x88, 0xf8, 0xa6, 0x82, 0x27,
-	0xe8, 0xbc, 0x89, 0xa1, 0x73, 0x9d, 0x3a, 0xd7, 0xd5, 0xd1, 0x00, 0x77, 0x6c, 0x67, 0xb0, 0x5d,
-	0x7b, 0xb3, 0x5c, 0x87, 0xfe, 0x6a, 0x1d, 0xfa, 0x7f, 0xd6, 0xa1, 0xbf, 0xa8, 0x43, 0x6f, 0x55,
-	0x87, 0xde, 0xcf, 0x3a, 0xf4, 0x3e, 0xbe, 0x48, 0x25, 0x7c, 0x99, 0xc7, 0x24, 0x51, 0x39, 0xfd,
-	0xf7, 0x5c, 0x32, 0xc1, 0x53, 0xa1, 0xe9, 0x77, 0xf7, 0x6e, 0xe0, 0x47, 0x29, 0x4c, 0x7c, 0xea,
-	0x2e, 0xfe, 0xd5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xcf, 0x76, 0x61, 0xec, 0x02, 0x00,
-	0x00,
+	// 883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0xc7, 0xa3, 0xda, 0xf9, 0xb5, 0xfe, 0x91, 0xb2, 0x69, 0xe8, 0x12, 0xc0, 0x51, 0x53, 0x3a,
+	0x18, 0x0e, 0xf2, 0xb4, 0x0c, 0x17, 0x06, 0x0e, 0x84, 0xa4, 0x0d, 0x03, 0x2d, 0xc6, 0xc9, 0x89,
+	0x8b, 0x58, 0x4b, 0x5b, 0xb3, 0x83, 0xa4, 0xdd, 0xd9, 0xb7, 0x8e, 0x5b, 0xfe, 0x8a, 0xfc, 0x59,
+	0x3d, 0xf6, 0xc8, 0x09, 0x98, 0xe4, 0x7f, 0xe0, 0xcc, 0xec, 0x4a, 0x6b, 0x5b, 0xd8, 0xd2, 0xa1,
+	0x37, 0xeb, 0xed, 0xf7, 0x7d, 0xde, 0xee, 0x77, 0xfd, 0x9e, 0x84, 0x1e, 0x46, 0x02, 0x52, 0x1e,
+	0x8d, 0x99, 0x1e, 0xcc, 0x34, 0x1d, 0x5c, 0x3d, 0x1e, 0x33, 0x4d, 0x1f, 0x0f, 0x26, 0x2c, 0x63,
+	0xc0, 0x21, 0x90, 0x4a, 0x68, 0x81, 0x0f, 0xe6, 0xa2, 0x60, 0xa6, 0x69, 0x50, 0x88, 0x0e, 0xef,
+	0x4d, 0xc4, 0x44, 0x58, 0xc5, 0xc0, 0xfc, 0xca, 0xc5, 0x87, 0x47, 0x13, 0x21, 0x26, 0x09, 0x1b,
+	0xd8, 0xa7, 0xf1, 0xf4, 0xe5, 0x40, 0xf3, 0x94, 0x81, 0xa6, 0xa9, 0x2c, 0x04, 0xc7, 0xeb, 0x4b,
+	0xa6, 0x22, 0x66, 0x09, 0xd4, 0x6b, 0x24, 0x55, 0x34, 0x2d, 0x34, 0xc7, 0xd7, 0x5d, 0xd4, 0x7e,
+	0x96, 0xef, 0xf3, 0x42, 0x53, 0xcd, 0xf0, 0x39, 0xea, 0xc4, 0x8a, 0xce, 0x42, 0x96, 0xc5, 0xa1,
+	0x29, 0x4a, 0x3c, 0xdf, 0xeb, 0xb7, 0x9e, 0x1c, 0x06, 0xf9, 0x8e, 0x02, 0xb7, 0xa3, 0xe0, 0xd2,
+	0xed, 0xe8, 0x64, 0xe7, 0xcd, 0x5f, 0x47, 0x1b, 0xd7, 0x7f, 0x1f, 0x79, 0xa3, 0x96, 0x49, 0x3d,
+	0xcb, 0x62, 0xb3, 0x86, 0xbf, 0x41, 0xdb, 0x9a, 0x47, 0xbf, 0x33, 0x0d, 0xe4, 0x8e, 0xdf, 0xe8,
+	0xb7, 0x9e, 0x7c, 0x1c, 0xac, 0xb5, 0x20, 0xb8, 0xb4, 0xaa, 0x93, 0xa6, 0xc1, 0x8c, 0x5c, 0x0e,
+	0x7e, 0x81, 0x90, 0xa4, 0xa0, 0x43, 0x83, 0x04, 0xd2, 0xb0, 0x84, 0xcf, 0x2a, 0x08, 0xe7, 0x1c,
+	0xb4, 0x50, 0x3c, 0xa2, 0xc9, 0xa9, 0xa2, 0xb3, 0x53, 0xaa, 0x69, 0x41, 0xdb, 0x35, 0x08, 0x13,
+	0x03, 0xfc, 0x2b, 0xda, 0x8f, 0x39, 0x68, 0xc5, 0xc7, 0x53, 0xcd, 0x45, 0x16, 0xe6, 0x36, 0x90,
+	0xa6, 0xef, 0xd5, 0x80, 0x4f, 0x97, 0x32, 0x86, 0x36, 0xa1, 0x00, 0xe3, 0x78, 0x65, 0x05, 0x9f,
+	0x23, 0x7b, 0x7e, 0x47, 0xde, 0xb4, 0xe4, 0x07, 0x55, 0x64, 0x45, 0x67, 0x25, 0x22, 0x8a, 0xe7,
+	0x11, 0xfc, 0x02, 0x75, 0x72, 0x1b, 0x1c, 0x6b, 0xcb, 0xb2, 0x1e, 0xd6, 0x1a, 0x58, 0xa2, 0xb5,
+	0xf5, 0x52, 0x0c, 0x87, 0x68, 0x9f, 0x65, 0x5a, 0x09, 0xf9, 0x3a, 0x8c, 0x44, 0x9a, 0x72, 0x9d,
+	0xb2, 0x4c, 0x03, 0xd9, 0xb6, 0xa6, 0xf6, 0x2b, 0xa8, 0x67, 0x79, 0xc6, 0x77, 0xf3, 0x04, 0x77,
+	0x74, 0xf6, 0xff, 0x05, 0xc0, 0x3f, 0xa3, 0x6e, 0xca, 0x01, 0x58, 0x1c, 0x2a, 0x76, 0xc5, 0x68,
+	0x02, 0x64, 0xc7, 0xb2, 0x3f, 0xa9, 0x60, 0x3f, 0xb7, 0xe2, 0x51, 0xae, 0x2d, 0xb8, 0x9d, 0x74,
+	0x39, 0x88, 0xef, 0xa3, 0x6d, 0xeb, 0x26, 0x8f, 0xc9, 0xae, 0xef, 0xf5, 0x9b, 0xa3, 0x2d, 0xf3,
+	0xf8, 0x7d, 0x8c, 0xbf, 0x46, 0x9b, 0x52, 0x88, 0x04, 0x08, 0xb2, 0x25, 0xfc, 0x8a, 0x12, 0x43,
+	0x21, 0x12, 0xfb, 0x97, 0x2e, 0xf0, 0x79, 0x12, 0xfe, 0x01, 0xb5, 0xa5, 0xe2, 0x7f, 0x30, 0xe7,
+	0x6c, 0xcb, 0x3a, 0x7b, 0x5c, 0x05, 0x31, 0xd2, 0x92, 0xb1, 0x2d, 0xb9, 0x08, 0xe1, 0x4b, 0xb4,
+	0xa7, 0x44, 0x92, 0x88, 0x2b, 0xa6, 0x1c, 0xaf, 0x6d, 0x79, 0x8f, 0x2a, 0x78, 0xa3, 0x42, 0x5d,
+	0x42, 0x76, 0x55, 0x29, 0x8a, 0x1f, 0xa1, 0xae, 0x3d, 0xb9, 0x0b, 0x03, 0xe9, 0xf8, 0x5e, 0xbf,
+	0x33, 0xb2, 0x8d, 0xe9, 0x08, 0xd6, 0x73, 0xa9, 0xa6, 0x19, 0xcf, 0x26, 0xae, 0x76, 0xd7, 0xf7,
+	0x6a, 0x3c, 0x1f, 0xe6, 0xe2, 0x52, 0xe9, 0x8e, 0x5c, 0x0e, 0xe2, 0x07, 0xa8, 0x2d, 0xe9, 0xd4,
+	0x5c, 0x63, 0xee, 0xf0, 0x9e, 0xdf, 0xe8, 0x37, 0x47, 0xad, 0x3c, 0x36, 0x74, 0xfe, 0x01, 0x4d,
+	0x18, 0xb8, 0x9a, 0x77, 0x6b, 0xfd, 0xbb, 0x30, 0xd2, 0xb2, 0x7f, 0xb0, 0x08, 0xe1, 0x3e, 0xba,
+	0x3b, 0x95, 0x91, 0x48, 0xcd, 0x19, 0xdc, 0x65, 0xbf, 0x67, 0x2f, 0xbb, 0xeb, 0xe2, 0xa7, 0xf9,
+	0xa5, 0x3f, 0x45, 0x88, 0xbd, 0x8a, 0x92, 0x29, 0x70, 0x91, 0x01, 0xc1, 0xb5, 0x37, 0x7f, 0xe6,
+	0x84, 0xae, 0xb3, 0x16, 0x99, 0x38, 0x46, 0x07, 0x20, 0x59, 0x16, 0x9b, 0x8a, 0x09, 0x4f, 0xf9,
+	0xbc, 0xc3, 0xf6, 0xed, 0x39, 0x3e, 0xaf, 0x3a, 0x47, 0x91, 0xf3, 0xa3, 0x49, 0x29, 0x9d, 0x67,
+	0x1f, 0x56, 0x97, 0xf0, 0x05, 0xda, 0x2b, 0x57, 0x01, 0x72, 0xaf, 0xb6, 0x1f, 0x4a, 0x7c, 0xf7,
+	0xb7, 0x28, 0x91, 0x01, 0x3f, 0x47, 0x1d, 0x1b, 0x09, 0x15, 0x8b, 0x84, 0x8a, 0x81, 0x1c, 0xf8,
+	0x8d, 0x3a, 0xeb, 0x8d, 0x76, 0x64, 0xa5, 0x6e, 0x26, 0xc0, 0x22, 0x04, 0xf8, 0x04, 0xed, 0x5a,
+	0xcb, 0x5f, 0x32, 0x06, 0xe4, 0x7d, 0x8b, 0x3a, 0xaa, 0x99, 0x55, 0x4f, 0x19, 0x73, 0x47, 0xde,
+	0x89, 0x8b, 0x67, 0x33, 0xa7, 0x84, 0xa2, 0x51, 0x32, 0xef, 0xa6, 0xfb, 0xb5, 0x73, 0xea, 0x27,
+	0xab, 0x2d, 0xcf, 0x29, 0xb1, 0x14, 0xc3, 0xcf, 0x90, 0x69, 0xaf, 0x88, 0x85, 0x52, 0x80, 0x06,
+	0x42, 0xea, 0x1b, 0xdc, 0x28, 0x87, 0x02, 0x9c, 0x5f, 0x48, 0xba, 0x00, 0xe0, 0xaf, 0xd0, 0x96,
+	0x7d, 0x02, 0xf2, 0x81, 0x65, 0x7c, 0x54, 0xc7, 0x28, 0xf2, 0x8b, 0x8c, 0xe3, 0x7f, 0x3d, 0xb4,
+	0x3b, 0x1f, 0x1e, 0xf8, 0x4b, 0xd4, 0x34, 0xbd, 0x50, 0xbc, 0x06, 0x3f, 0xac, 0x19, 0x36, 0x05,
+	0xc6, 0xca, 0x97, 0xa7, 0xd7, 0x9d, 0xd2, 0xf4, 0x5a, 0x79, 0xbf, 0x36, 0xde, 0xf5, 0xfd, 0xba,
+	0x3a, 0x26, 0x9a, 0xeb, 0xc6, 0xc4, 0xba, 0x1e, 0xdb, 0x5c, 0xd7, 0x63, 0x27, 0xdf, 0xbe, 0xb9,
+	0xe9, 0x79, 0x6f, 0x6f, 0x7a, 0xde, 0x3f, 0x37, 0x3d, 0xef, 0xfa, 0xb6, 0xb7, 0xf1, 0xf6, 0xb6,
+	0xb7, 0xf1, 0xe7, 0x6d, 0x6f, 0xe3, 0x97, 0x4f, 0x27, 0x5c, 0xff, 0x36, 0x1d, 0x07, 0x91, 0x48,
+	0x07, 0x8b, 0x8f, 0x8a, 0x84, 0xc5, 0x13, 0xa6, 0x06, 0xaf, 0xec, 0xd7, 0x85, 0x7e, 0x2d, 0x19,
+	0x8c, 0xb7, 0xec, 0xf6, 0xbf, 0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x19, 0x7e, 0x29, 0x10, 0x12,
+	0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,8 +461,40 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.PricePosts) > 0 {
+		for iNdEx := len(m.PricePosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PricePosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
 	{
-		size, err := m.TicketParams.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.OracleParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -185,9 +502,59 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if len(m.DrawFees) > 0 {
+		for iNdEx := len(m.DrawFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DrawFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.SpendRecords) > 0 {
+		for iNdEx := len(m.SpendRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.SpendingLimits) > 0 {
+		for iNdEx := len(m.SpendingLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendingLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
 	{
-		size, err := m.DrawParams.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.SpendingLimitParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -195,9 +562,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.Exclusions) > 0 {
+		for iNdEx := len(m.Exclusions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exclusions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.UpcomingDrawId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpcomingDrawId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	{
-		size, err := m.DistributionParams.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.SalesParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -205,124 +597,1118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.PastDraws) > 0 {
-		for iNdEx := len(m.PastDraws) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.PausedPools) > 0 {
+		dAtA5 := make([]byte, len(m.PausedPools)*10)
+		var j4 int
+		for _, num := range m.PausedPools {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGenesis(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size, err := m.PruningParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.DrawRollovers != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DrawRollovers))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.RolloverParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.PrizeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PastDraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.DrawId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MissedReveals) > 0 {
+		for iNdEx := len(m.MissedReveals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedReveals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.EntropyCommitments) > 0 {
+		for iNdEx := len(m.EntropyCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntropyCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.TicketParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.DrawParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.DistributionParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PastDraws) > 0 {
+		for iNdEx := len(m.PastDraws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PastDraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Tickets) > 0 {
+		for iNdEx := len(m.Tickets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tickets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DrawEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DrawEndTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintGenesis(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpcomingDrawId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpcomingDrawId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DrawRollovers != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DrawRollovers))
+		i--
+		dAtA[i] = 0x20
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DrawEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DrawEndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGenesis(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if m.DrawId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DrawEndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Tickets) > 0 {
+		for _, e := range m.Tickets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PastDraws) > 0 {
+		for _, e := range m.PastDraws {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.DistributionParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DrawParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TicketParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EntropyCommitments) > 0 {
+		for _, e := range m.EntropyCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedReveals) > 0 {
+		for _, e := range m.MissedReveals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DrawId != 0 {
+		n += 1 + sovGenesis(uint64(m.DrawId))
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PrizeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RolloverParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.DrawRollovers != 0 {
+		n += 1 + sovGenesis(uint64(m.DrawRollovers))
+	}
+	l = m.PruningParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PausedPools) > 0 {
+		l = 0
+		for _, e := range m.PausedPools {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	l = m.SalesParams.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.UpcomingDrawId != 0 {
+		n += 2 + sovGenesis(uint64(m.UpcomingDrawId))
+	}
+	if len(m.Exclusions) > 0 {
+		for _, e := range m.Exclusions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.SpendingLimitParams.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.SpendingLimits) > 0 {
+		for _, e := range m.SpendingLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpendRecords) > 0 {
+		for _, e := range m.SpendRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DrawFees) > 0 {
+		for _, e := range m.DrawFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.OracleParams.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.PricePosts) > 0 {
+		for _, e := range m.PricePosts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.DrawId != 0 {
+		n += 1 + sovGenesis(uint64(m.DrawId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DrawEndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.DrawRollovers != 0 {
+		n += 1 + sovGenesis(uint64(m.DrawRollovers))
+	}
+	if m.UpcomingDrawId != 0 {
+		n += 1 + sovGenesis(uint64(m.UpcomingDrawId))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DrawEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickets = append(m.Tickets, Ticket{})
+			if err := m.Tickets[len(m.Tickets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastDraws", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PastDraws = append(m.PastDraws, HistoricalDrawData{})
+			if err := m.PastDraws[len(m.PastDraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DrawParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TicketParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntropyCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntropyCommitments = append(m.EntropyCommitments, EntropyCommitment{})
+			if err := m.EntropyCommitments[len(m.EntropyCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedReveals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedReveals = append(m.MissedReveals, MissedReveals{})
+			if err := m.MissedReveals[len(m.MissedReveals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
+			}
+			m.DrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, PoolState{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrizeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloverParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RolloverParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawRollovers", wireType)
+			}
+			m.DrawRollovers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawRollovers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PruningParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedPools = append(m.PausedPools, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedPools) == 0 {
+					m.PausedPools = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedPools = append(m.PausedPools, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPools", wireType)
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalesParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SalesParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingDrawId", wireType)
+			}
+			m.UpcomingDrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpcomingDrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclusions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclusions = append(m.Exclusions, Exclusion{})
+			if err := m.Exclusions[len(m.Exclusions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingLimitParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendingLimitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Tickets) > 0 {
-		for iNdEx := len(m.Tickets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tickets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendingLimits = append(m.SpendingLimits, SpendingLimit{})
+			if err := m.SpendingLimits[len(m.SpendingLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DrawEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DrawEndTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintGenesis(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DrawEndTime)
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Tickets) > 0 {
-		for _, e := range m.Tickets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.PastDraws) > 0 {
-		for _, e := range m.PastDraws {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	l = m.DistributionParams.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.DrawParams.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.TicketParams.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SpendRecords = append(m.SpendRecords, SpendRecord{})
+			if err := m.SpendRecords[len(m.SpendRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrawEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -349,13 +1735,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DrawEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.DrawFees = append(m.DrawFees, DrawFees{})
+			if err := m.DrawFees[len(m.DrawFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tickets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -382,14 +1769,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tickets = append(m.Tickets, Ticket{})
-			if err := m.Tickets[len(m.Tickets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OracleParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PastDraws", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePosts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -416,14 +1802,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PastDraws = append(m.PastDraws, HistoricalDrawData{})
-			if err := m.PastDraws[len(m.PastDraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PricePosts = append(m.PricePosts, PricePost{})
+			if err := m.PricePosts[len(m.PricePosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -450,13 +1836,64 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrawParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -483,13 +1920,32 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DrawParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
+			}
+			m.DrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TicketParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawEndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -516,10 +1972,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TicketParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DrawEndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawRollovers", wireType)
+			}
+			m.DrawRollovers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawRollovers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingDrawId", wireType)
+			}
+			m.UpcomingDrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpcomingDrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
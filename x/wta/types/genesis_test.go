@@ -19,112 +19,250 @@ func TestValidateGenesis(t *testing.T) {
 		{
 			name: "zero draw end time should error",
 			genesis: types.NewGenesisState(
+				1,
 				time.Time{},
+				0,
 				nil,
 				nil,
 				types.DefaultDistributionParams(),
 				types.DefaultDrawParams(),
 				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
 			),
 			shouldErr: true,
 		},
 		{
 			name: "past draw end time should error",
 			genesis: types.NewGenesisState(
+				1,
 				time.Now().Add(-time.Hour*1),
+				0,
 				nil,
 				nil,
 				types.DefaultDistributionParams(),
 				types.DefaultDrawParams(),
 				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
 			),
 			shouldErr: true,
 		},
 		{
 			name: "invalid ticket data",
 			genesis: types.NewGenesisState(
+				1,
 				time.Now().Add(-time.Hour*1),
+				0,
 				[]types.Ticket{
 					types.NewTicket(
 						"ticket-id",
+						1,
 						time.Time{},
 						"invalid-owner",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
 				},
 				nil,
 				types.DefaultDistributionParams(),
 				types.DefaultDrawParams(),
 				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
 			),
 			shouldErr: true,
 		},
 		{
 			name: "ticket creation time after draw end time",
 			genesis: types.NewGenesisState(
+				1,
 				time.Now().Add(-time.Hour*2),
+				0,
 				[]types.Ticket{
 					types.NewTicket(
 						"ticket-id",
+						1,
 						time.Now().Add(-time.Hour*2+time.Minute),
 						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
 				},
 				nil,
 				types.DefaultDistributionParams(),
 				types.DefaultDrawParams(),
 				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
 			),
 			shouldErr: true,
 		},
 		{
 			name: "ticket creation in the future",
 			genesis: types.NewGenesisState(
+				1,
 				time.Now().Add(time.Hour*48),
+				0,
 				[]types.Ticket{
 					types.NewTicket(
 						"ticket-id",
+						1,
 						time.Now().Add(time.Hour*24),
 						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
 				},
 				nil,
 				types.DefaultDistributionParams(),
 				types.DefaultDrawParams(),
 				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
 			),
 			shouldErr: true,
 		},
 		{
 			name: "duplicated ticket ids",
 			genesis: types.NewGenesisState(
+				1,
 				time.Now().Add(time.Hour),
+				0,
 				[]types.Ticket{
 					types.NewTicket(
 						"ticket-id",
+						1,
 						time.Now(),
 						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
 					types.NewTicket(
 						"ticket-id",
+						1,
 						time.Now().Add(-time.Minute),
 						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
 				},
 				nil,
 				types.DefaultDistributionParams(),
 				types.DefaultDrawParams(),
 				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
 			),
 			shouldErr: true,
 		},
 		{
 			name: "invalid historical data",
 			genesis: types.NewGenesisState(
+				1,
 				time.Now().Add(time.Hour),
+				0,
 				nil,
 				[]types.HistoricalDrawData{
 					types.NewHistoricalDrawData(
 						types.NewDraw(
+							1,
+							types.DefaultPoolID,
 							1,
 							1,
 							sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
@@ -132,70 +270,1354 @@ func TestValidateGenesis(t *testing.T) {
 						),
 						types.NewTicket(
 							"ticket-id",
+							1,
 							time.Time{},
 							"winner",
+							"",
+							sdk.NewInt64Coin("stake", 10),
+							nil,
 						),
+						nil,
+						nil,
+						0,
+						nil,
+						nil,
+						nil,
+						0,
+						0,
 					),
 				},
 				types.DefaultDistributionParams(),
 				types.DefaultDrawParams(),
 				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
 			),
 			shouldErr: true,
 		},
 		{
-			name: "invalid params",
+			name: "ticket of a different draw",
+			genesis: types.NewGenesisState(
+				2,
+				time.Now().Add(time.Hour),
+				0,
+				[]types.Ticket{
+					types.NewTicket(
+						"ticket-id",
+						1,
+						time.Now().Add(-5*time.Minute),
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
+					),
+				},
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "upcoming draw id used by the current draw",
 			genesis: types.NewGenesisState(
+				2,
 				time.Now().Add(time.Hour),
+				0,
+				[]types.Ticket{
+					types.NewTicket(
+						"ticket-id",
+						2,
+						time.Now().Add(-5*time.Minute),
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
+					),
+				},
 				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
 				nil,
-				types.NewDistributionParams(
-					sdk.NewDecWithPrec(98, 2),
-					sdk.NewDecWithPrec(2, 2),
-					sdk.NewDecWithPrec(2, 2),
-				),
-				types.NewDrawParams(time.Minute),
-				types.NewTicketParams(
-					sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
-				),
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				2,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
 			),
 			shouldErr: true,
 		},
 		{
-			name: "valid genesis",
+			name: "ticket of the upcoming draw",
 			genesis: types.NewGenesisState(
+				2,
 				time.Now().Add(time.Hour),
+				0,
 				[]types.Ticket{
 					types.NewTicket(
 						"ticket-id",
+						3,
 						time.Now().Add(-5*time.Minute),
 						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
 					),
 				},
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				3,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: false,
+		},
+		{
+			name: "past draw id used by a current draw",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
 				[]types.HistoricalDrawData{
 					types.NewHistoricalDrawData(
 						types.NewDraw(
+							1,
+							types.DefaultPoolID,
 							1,
 							1,
 							sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
 							time.Now().Add(-7*24*time.Hour),
 						),
 						types.NewTicket(
-							"winning-ticet",
+							"winning-ticket",
+							1,
 							time.Now().Add(-9*25*time.Hour),
 							"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+							"",
+							sdk.NewInt64Coin("stake", 10),
+							nil,
 						),
+						nil,
+						nil,
+						0,
+						nil,
+						nil,
+						nil,
+						0,
+						0,
 					),
 				},
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid params",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
 				types.NewDistributionParams(
-					sdk.NewDecWithPrec(92, 2),
-					sdk.NewDecWithPrec(7, 2),
-					sdk.NewDecWithPrec(1, 2),
+					sdk.NewDecWithPrec(98, 2),
+					sdk.NewDecWithPrec(2, 2),
+					sdk.NewDecWithPrec(2, 2),
+					types.FeeDestinationFeeCollector,
 				),
-				types.NewDrawParams(time.Hour*12),
+				types.NewDrawParams(time.Minute, time.Minute, 0, false),
 				types.NewTicketParams(
-					sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+					sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+					nil,
+					sdk.ZeroDec(),
+					0,
+					0,
 				),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid rollover params",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				2,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.NewRolloverParams(3, sdk.NewDecWithPrec(150, 2)),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid pruning params",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				2,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.NewPruningParams(0),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid exclusion",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				[]types.Exclusion{
+					types.NewExclusion("address", time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)),
+				},
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated exclusions",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				[]types.Exclusion{
+					types.NewExclusion("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)),
+					types.NewExclusion("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", time.Date(2022, 1, 1, 00, 00, 00, 000, time.UTC)),
+				},
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid spending limit params",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.NewSpendingLimitParams(-time.Hour),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid spending limit",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				[]types.SpendingLimit{
+					types.NewSpendingLimit("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewCoins(), time.Hour, nil),
+				},
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated spending limits",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				[]types.SpendingLimit{
+					types.NewSpendingLimit("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), time.Hour, nil),
+					types.NewSpendingLimit("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour, nil),
+				},
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid spend record",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				[]types.SpendRecord{
+					types.NewSpendRecord("address", time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC), sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+				},
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated spend records",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				[]types.SpendRecord{
+					types.NewSpendRecord("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC), sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+					types.NewSpendRecord("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC), sdk.NewCoins(sdk.NewInt64Coin("stake", 200))),
+				},
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid draw fees",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				[]types.DrawFees{
+					types.NewDrawFees(0, types.FeeDestinationTreasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+				},
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated draw fees",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				[]types.DrawFees{
+					types.NewDrawFees(1, types.FeeDestinationTreasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+					types.NewDrawFees(1, types.FeeDestinationTreasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))),
+				},
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid oracle params",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.NewOracleParams(nil, 0),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid price post",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{
+					types.NewPricePost("stake", "oracle", sdk.NewDecWithPrec(25, 2), time.Now()),
+				},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated price posts",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{
+					types.NewPricePost("stake", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewDecWithPrec(25, 2), time.Now()),
+					types.NewPricePost("stake", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewDecWithPrec(30, 2), time.Now()),
+				},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated prices",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{
+					types.NewPrice("stake", sdk.NewDecWithPrec(25, 2), time.Now()),
+					types.NewPrice("stake", sdk.NewDecWithPrec(30, 2), time.Now()),
+				},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid sales params",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				2,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.NewSalesParams(true, ""),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid genesis",
+			genesis: types.NewGenesisState(
+				2,
+				time.Now().Add(time.Hour),
+				0,
+				[]types.Ticket{
+					types.NewTicket(
+						"ticket-id",
+						2,
+						time.Now().Add(-5*time.Minute),
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
+					),
+				},
+				[]types.HistoricalDrawData{
+					types.NewHistoricalDrawData(
+						types.NewDraw(
+							1,
+							types.DefaultPoolID,
+							1,
+							1,
+							sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+							time.Now().Add(-7*24*time.Hour),
+						),
+						types.NewTicket(
+							"winning-ticet",
+							1,
+							time.Now().Add(-9*25*time.Hour),
+							"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+							"",
+							sdk.NewInt64Coin("stake", 10),
+							nil,
+						),
+						nil,
+						nil,
+						0,
+						nil,
+						nil,
+						nil,
+						0,
+						0,
+					),
+				},
+				types.NewDistributionParams(
+					sdk.NewDecWithPrec(92, 2),
+					sdk.NewDecWithPrec(7, 2),
+					sdk.NewDecWithPrec(1, 2),
+					types.FeeDestinationFeeCollector,
+				),
+				types.NewDrawParams(time.Hour*12, time.Minute, 0, false),
+				types.NewTicketParams(
+					sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+					nil,
+					sdk.ZeroDec(),
+					0,
+					0,
+				),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.NewOracleParams([]string{"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"}, time.Hour),
+				[]types.PricePost{
+					types.NewPricePost("stake", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewDecWithPrec(25, 2), time.Now()),
+				},
+				[]types.Price{
+					types.NewPrice("stake", sdk.NewDecWithPrec(25, 2), time.Now()),
+				},
+			),
+			shouldErr: false,
+		},
+		{
+			name: "pool using the default pool id",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				[]types.PoolState{
+					types.NewPoolState(
+						types.NewPool(
+							types.DefaultPoolID,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						2,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+				},
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated pool ids",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				[]types.PoolState{
+					types.NewPoolState(
+						types.NewPool(
+							1,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						2,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+					types.NewPoolState(
+						types.NewPool(
+							1,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						3,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+				},
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid pool params",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				[]types.PoolState{
+					types.NewPoolState(
+						types.NewPool(
+							1,
+							types.NewDistributionParams(
+								sdk.NewDecWithPrec(98, 2),
+								sdk.NewDecWithPrec(2, 2),
+								sdk.NewDecWithPrec(2, 2),
+								types.FeeDestinationFeeCollector,
+							),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						2,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+				},
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "pool draw id used by another draw",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				[]types.PoolState{
+					types.NewPoolState(
+						types.NewPool(
+							1,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						1,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+				},
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "past pool draw end time",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				nil,
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				[]types.PoolState{
+					types.NewPoolState(
+						types.NewPool(
+							1,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						2,
+						time.Now().Add(-time.Hour),
+						0,
+						0,
+					),
+				},
+				nil,
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "paused pool not found",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				[]types.Ticket{
+					types.NewTicket(
+						"ticket-id",
+						3,
+						time.Now().Add(-5*time.Minute),
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
+					),
+				},
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				[]types.PoolState{
+					types.NewPoolState(
+						types.NewPool(
+							1,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						2,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+					types.NewPoolState(
+						types.NewPool(
+							2,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						3,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+				},
+				[]uint64{3},
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated paused pool ids",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				[]types.Ticket{
+					types.NewTicket(
+						"ticket-id",
+						3,
+						time.Now().Add(-5*time.Minute),
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
+					),
+				},
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				[]types.PoolState{
+					types.NewPoolState(
+						types.NewPool(
+							1,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						2,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+					types.NewPoolState(
+						types.NewPool(
+							2,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						3,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+				},
+				[]uint64{2, 2},
+				types.DefaultSalesParams(),
+				0,
+				nil,
+				types.DefaultSpendingLimitParams(),
+				nil,
+				nil,
+				nil,
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid genesis with pools",
+			genesis: types.NewGenesisState(
+				1,
+				time.Now().Add(time.Hour),
+				0,
+				[]types.Ticket{
+					types.NewTicket(
+						"ticket-id",
+						3,
+						time.Now().Add(-5*time.Minute),
+						"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+						"",
+						sdk.NewInt64Coin("stake", 10),
+						nil,
+					),
+				},
+				nil,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultRolloverParams(),
+				types.DefaultPruningParams(),
+				nil,
+				nil,
+				[]types.PoolState{
+					types.NewPoolState(
+						types.NewPool(
+							1,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						2,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+					types.NewPoolState(
+						types.NewPool(
+							2,
+							types.DefaultDistributionParams(),
+							types.DefaultDrawParams(),
+							types.DefaultTicketParams(),
+							types.DefaultPrizeParams(),
+							types.DefaultGameParams(),
+							types.DefaultRolloverParams(),
+						),
+						3,
+						time.Now().Add(time.Hour),
+						0,
+						0,
+					),
+				},
+				[]uint64{types.DefaultPoolID, 2},
+				types.DefaultSalesParams(),
+				0,
+				[]types.Exclusion{
+					types.NewExclusion("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)),
+				},
+				types.DefaultSpendingLimitParams(),
+				[]types.SpendingLimit{
+					types.NewSpendingLimit("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), time.Hour, nil),
+				},
+				[]types.SpendRecord{
+					types.NewSpendRecord("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC), sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+				},
+				[]types.DrawFees{
+					types.NewDrawFees(1, types.FeeDestinationCommunityPool, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+				},
+				types.DefaultOracleParams(),
+				[]types.PricePost{},
+				[]types.Price{},
 			),
 			shouldErr: false,
 		},
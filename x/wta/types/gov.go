@@ -0,0 +1,291 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeCancelDraw defines the type for a CancelDrawProposal
+	ProposalTypeCancelDraw = "CancelDraw"
+
+	// ProposalTypeCreatePool defines the type for a CreatePoolProposal
+	ProposalTypeCreatePool = "CreatePool"
+
+	// ProposalTypePauseSales defines the type for a PauseSalesProposal
+	ProposalTypePauseSales = "PauseSales"
+
+	// ProposalTypeResumeSales defines the type for a ResumeSalesProposal
+	ProposalTypeResumeSales = "ResumeSales"
+
+	// ProposalTypeCloseDraw defines the type for a CloseDrawProposal
+	ProposalTypeCloseDraw = "CloseDraw"
+
+	// ProposalTypeSetSalesHalted defines the type for a SetSalesHaltedProposal
+	ProposalTypeSetSalesHalted = "SetSalesHalted"
+)
+
+// Assert all the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CancelDrawProposal{}
+	_ govtypes.Content = &CreatePoolProposal{}
+	_ govtypes.Content = &PauseSalesProposal{}
+	_ govtypes.Content = &ResumeSalesProposal{}
+	_ govtypes.Content = &CloseDrawProposal{}
+	_ govtypes.Content = &SetSalesHaltedProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCancelDraw)
+	govtypes.RegisterProposalTypeCodec(&CancelDrawProposal{}, "cosmicbet/CancelDrawProposal")
+	govtypes.RegisterProposalType(ProposalTypeCreatePool)
+	govtypes.RegisterProposalTypeCodec(&CreatePoolProposal{}, "cosmicbet/CreatePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypePauseSales)
+	govtypes.RegisterProposalTypeCodec(&PauseSalesProposal{}, "cosmicbet/PauseSalesProposal")
+	govtypes.RegisterProposalType(ProposalTypeResumeSales)
+	govtypes.RegisterProposalTypeCodec(&ResumeSalesProposal{}, "cosmicbet/ResumeSalesProposal")
+	govtypes.RegisterProposalType(ProposalTypeCloseDraw)
+	govtypes.RegisterProposalTypeCodec(&CloseDrawProposal{}, "cosmicbet/CloseDrawProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetSalesHalted)
+	govtypes.RegisterProposalTypeCodec(&SetSalesHaltedProposal{}, "cosmicbet/SetSalesHaltedProposal")
+}
+
+// NewCancelDrawProposal creates a new proposal to cancel the current draw of the given pool
+func NewCancelDrawProposal(title, description string, poolID uint64) *CancelDrawProposal {
+	return &CancelDrawProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolID,
+	}
+}
+
+// GetTitle returns the title of a cancel draw proposal
+func (p *CancelDrawProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a cancel draw proposal
+func (p *CancelDrawProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a cancel draw proposal
+func (p *CancelDrawProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel draw proposal
+func (p *CancelDrawProposal) ProposalType() string { return ProposalTypeCancelDraw }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CancelDrawProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface
+func (p CancelDrawProposal) String() string {
+	return fmt.Sprintf(`Cancel Draw Proposal:
+  Title:       %s
+  Description: %s
+  Pool id:     %d
+`, p.Title, p.Description, p.PoolId)
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewCreatePoolProposal creates a new proposal to create the given pool
+func NewCreatePoolProposal(title, description string, pool Pool) *CreatePoolProposal {
+	return &CreatePoolProposal{
+		Title:       title,
+		Description: description,
+		Pool:        pool,
+	}
+}
+
+// GetTitle returns the title of a create pool proposal
+func (p *CreatePoolProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a create pool proposal
+func (p *CreatePoolProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a create pool proposal
+func (p *CreatePoolProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a create pool proposal
+func (p *CreatePoolProposal) ProposalType() string { return ProposalTypeCreatePool }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CreatePoolProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Pool.Id == DefaultPoolID {
+		return fmt.Errorf("pool id %d is reserved to the default pool", DefaultPoolID)
+	}
+
+	return p.Pool.Validate()
+}
+
+// String implements the Stringer interface
+func (p CreatePoolProposal) String() string {
+	return fmt.Sprintf(`Create Pool Proposal:
+  Title:         %s
+  Description:   %s
+  Pool id:       %d
+  Game type:     %s
+  Ticket prices: %s
+  Draw duration: %s
+`, p.Title, p.Description, p.Pool.Id, p.Pool.GameParams.GameType, p.Pool.TicketParams.Prices(), p.Pool.DrawParams.Duration)
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewPauseSalesProposal creates a new proposal to pause the ticket sales of the given pool
+func NewPauseSalesProposal(title, description string, poolID uint64) *PauseSalesProposal {
+	return &PauseSalesProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolID,
+	}
+}
+
+// GetTitle returns the title of a pause sales proposal
+func (p *PauseSalesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a pause sales proposal
+func (p *PauseSalesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a pause sales proposal
+func (p *PauseSalesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a pause sales proposal
+func (p *PauseSalesProposal) ProposalType() string { return ProposalTypePauseSales }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *PauseSalesProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface
+func (p PauseSalesProposal) String() string {
+	return fmt.Sprintf(`Pause Sales Proposal:
+  Title:       %s
+  Description: %s
+  Pool id:     %d
+`, p.Title, p.Description, p.PoolId)
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewResumeSalesProposal creates a new proposal to resume the ticket sales of the given pool
+func NewResumeSalesProposal(title, description string, poolID uint64) *ResumeSalesProposal {
+	return &ResumeSalesProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolID,
+	}
+}
+
+// GetTitle returns the title of a resume sales proposal
+func (p *ResumeSalesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a resume sales proposal
+func (p *ResumeSalesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a resume sales proposal
+func (p *ResumeSalesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a resume sales proposal
+func (p *ResumeSalesProposal) ProposalType() string { return ProposalTypeResumeSales }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ResumeSalesProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface
+func (p ResumeSalesProposal) String() string {
+	return fmt.Sprintf(`Resume Sales Proposal:
+  Title:       %s
+  Description: %s
+  Pool id:     %d
+`, p.Title, p.Description, p.PoolId)
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewCloseDrawProposal creates a new proposal to close the current draw of the given pool
+func NewCloseDrawProposal(title, description string, poolID uint64) *CloseDrawProposal {
+	return &CloseDrawProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolID,
+	}
+}
+
+// GetTitle returns the title of a close draw proposal
+func (p *CloseDrawProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a close draw proposal
+func (p *CloseDrawProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a close draw proposal
+func (p *CloseDrawProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a close draw proposal
+func (p *CloseDrawProposal) ProposalType() string { return ProposalTypeCloseDraw }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CloseDrawProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface
+func (p CloseDrawProposal) String() string {
+	return fmt.Sprintf(`Close Draw Proposal:
+  Title:       %s
+  Description: %s
+  Pool id:     %d
+`, p.Title, p.Description, p.PoolId)
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewSetSalesHaltedProposal creates a new proposal to halt or restart the ticket sales of all the pools
+func NewSetSalesHaltedProposal(title, description string, halted bool, haltReason string) *SetSalesHaltedProposal {
+	return &SetSalesHaltedProposal{
+		Title:       title,
+		Description: description,
+		Halted:      halted,
+		HaltReason:  haltReason,
+	}
+}
+
+// GetTitle returns the title of a set sales halted proposal
+func (p *SetSalesHaltedProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set sales halted proposal
+func (p *SetSalesHaltedProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set sales halted proposal
+func (p *SetSalesHaltedProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set sales halted proposal
+func (p *SetSalesHaltedProposal) ProposalType() string { return ProposalTypeSetSalesHalted }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetSalesHaltedProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return ValidateSalesParams(NewSalesParams(p.Halted, p.HaltReason))
+}
+
+// String implements the Stringer interface
+func (p SetSalesHaltedProposal) String() string {
+	return fmt.Sprintf(`Set Sales Halted Proposal:
+  Title:       %s
+  Description: %s
+  Halted:      %t
+  Halt reason: %s
+`, p.Title, p.Description, p.Halted, p.HaltReason)
+}
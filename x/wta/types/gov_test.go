@@ -0,0 +1,181 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+func TestCancelDrawProposal_ValidateBasic(t *testing.T) {
+	usecases := []struct {
+		name      string
+		proposal  *types.CancelDrawProposal
+		shouldErr bool
+	}{
+		{
+			name:      "empty title",
+			proposal:  types.NewCancelDrawProposal("", "Description", 1),
+			shouldErr: true,
+		},
+		{
+			name:      "empty description",
+			proposal:  types.NewCancelDrawProposal("Title", "", 1),
+			shouldErr: true,
+		},
+		{
+			name:      "valid proposal",
+			proposal:  types.NewCancelDrawProposal("Title", "Description", types.DefaultPoolID),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.proposal.ValidateBasic()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreatePoolProposal_ValidateBasic(t *testing.T) {
+	pool := types.NewPool(
+		1,
+		types.DefaultDistributionParams(),
+		types.NewDrawParams(time.Hour, time.Minute, 0, false),
+		types.NewTicketParams(sdk.NewInt64Coin("stake", 10), nil, sdk.ZeroDec(), 0, 0),
+		types.DefaultPrizeParams(),
+		types.DefaultGameParams(),
+		types.DefaultRolloverParams(),
+	)
+
+	defaultPool := pool
+	defaultPool.Id = types.DefaultPoolID
+
+	invalidPool := pool
+	invalidPool.DrawParams = types.NewDrawParams(0, time.Minute, 0, false)
+
+	usecases := []struct {
+		name      string
+		proposal  *types.CreatePoolProposal
+		shouldErr bool
+	}{
+		{
+			name:      "empty title",
+			proposal:  types.NewCreatePoolProposal("", "Description", pool),
+			shouldErr: true,
+		},
+		{
+			name:      "default pool id",
+			proposal:  types.NewCreatePoolProposal("Title", "Description", defaultPool),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid pool",
+			proposal:  types.NewCreatePoolProposal("Title", "Description", invalidPool),
+			shouldErr: true,
+		},
+		{
+			name:      "valid proposal",
+			proposal:  types.NewCreatePoolProposal("Title", "Description", pool),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.proposal.ValidateBasic()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPauseSalesProposal_ValidateBasic(t *testing.T) {
+	usecases := []struct {
+		name      string
+		proposal  *types.PauseSalesProposal
+		shouldErr bool
+	}{
+		{
+			name:      "empty title",
+			proposal:  types.NewPauseSalesProposal("", "Description", 1),
+			shouldErr: true,
+		},
+		{
+			name:      "empty description",
+			proposal:  types.NewPauseSalesProposal("Title", "", 1),
+			shouldErr: true,
+		},
+		{
+			name:      "valid proposal",
+			proposal:  types.NewPauseSalesProposal("Title", "Description", types.DefaultPoolID),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.proposal.ValidateBasic()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetSalesHaltedProposal_ValidateBasic(t *testing.T) {
+	usecases := []struct {
+		name      string
+		proposal  *types.SetSalesHaltedProposal
+		shouldErr bool
+	}{
+		{
+			name:      "empty title",
+			proposal:  types.NewSetSalesHaltedProposal("", "Description", true, "Wrong ticket price"),
+			shouldErr: true,
+		},
+		{
+			name:      "halted without reason",
+			proposal:  types.NewSetSalesHaltedProposal("Title", "Description", true, ""),
+			shouldErr: true,
+		},
+		{
+			name:      "valid halt proposal",
+			proposal:  types.NewSetSalesHaltedProposal("Title", "Description", true, "Wrong ticket price"),
+			shouldErr: false,
+		},
+		{
+			name:      "valid restart proposal",
+			proposal:  types.NewSetSalesHaltedProposal("Title", "Description", false, ""),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.proposal.ValidateBasic()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,7 +2,13 @@ package types
 
 // DONTCOVER
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
 
 const (
 	// ModuleName is the name of the wta module
@@ -19,20 +25,243 @@ const (
 
 	PrizeCollectorName = "wta_prize_collector"
 	PrizeBurnerName    = "wta_prize_burner"
+	TreasuryName       = "wta_treasury"
+	StakersFeesName    = "wta_stakers_fees"
+
+	// DefaultPoolID is the id of the default pool, which uses the module parameters
+	DefaultPoolID uint64 = 0
 )
 
 var (
-	CurrentDrawEndTimeStoreKey = []byte{0x1}
-	HistoricalDrawStorePrefix  = []byte("historical_draw")
-	TicketsStorePrefix         = []byte("ticket")
+	CurrentDrawEndTimeStorePrefix   = []byte{0x1}
+	CurrentDrawIDStorePrefix        = []byte{0x2}
+	NextDrawIDStoreKey              = []byte{0x3}
+	CurrentDrawRolloversStorePrefix = []byte{0x4}
+	DrawTicketsCountStorePrefix     = []byte{0x5}
+	DrawParticipantsStorePrefix     = []byte{0x6}
+	DrawOwnerTicketsStorePrefix     = []byte{0x7}
+	DrawTicketsStorePrefix          = []byte{0x8}
+	TicketPositionsStorePrefix      = []byte{0x9}
+	DrawTicketsTreeStorePrefix      = []byte{0xa}
+	PruningQueueStorePrefix         = []byte{0xb}
+	PausedPoolsStorePrefix          = []byte{0xc}
+	UpcomingDrawIDStorePrefix       = []byte{0xd}
+	SpendRecordsStorePrefix         = []byte{0xe}
+	DrawFeesStorePrefix             = []byte{0xf}
+	PricePostsStorePrefix           = []byte{0x10}
+	PricesStorePrefix               = []byte{0x11}
+	TicketsMoveQueueStorePrefix     = []byte{0x12}
+	SalesHaltedStoreKey             = []byte{0x13}
+	HistoricalDrawStorePrefix       = []byte("historical_draw")
+	TicketsStorePrefix              = []byte("ticket")
+	OwnerTicketsStorePrefix         = []byte("owner_ticket")
+	PoolsStorePrefix                = []byte("pool")
+
+	EntropyCommitmentsStorePrefix = []byte("entropy_commitment")
+	MissedRevealsStorePrefix      = []byte("missed_reveals")
+	ExclusionsStorePrefix         = []byte("exclusion")
+	SpendingLimitsStorePrefix     = []byte("spending_limit")
 )
 
-// TicketsStoreKey returns the store key used to save the ticket with the given id
-func TicketsStoreKey(id string) []byte {
-	return append(TicketsStorePrefix, []byte(id)...)
+// CurrentDrawEndTimeStoreKey returns the store key used to save the end time of the current draw of the given pool
+func CurrentDrawEndTimeStoreKey(poolID uint64) []byte {
+	return append(CurrentDrawEndTimeStorePrefix, sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// CurrentDrawIDStoreKey returns the store key used to save the id of the current draw of the given pool
+func CurrentDrawIDStoreKey(poolID uint64) []byte {
+	return append(CurrentDrawIDStorePrefix, sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// CurrentDrawRolloversStoreKey returns the store key used to save the number of consecutive rollovers
+// of the current draw of the given pool
+func CurrentDrawRolloversStoreKey(poolID uint64) []byte {
+	return append(CurrentDrawRolloversStorePrefix, sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// DrawTicketsCountStoreKey returns the store key used to save the number of tickets sold for the draw
+// having the given id
+func DrawTicketsCountStoreKey(drawID uint64) []byte {
+	return append(DrawTicketsCountStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// DrawParticipantsStoreKey returns the store key used to save the number of distinct participants of the draw
+// having the given id
+func DrawParticipantsStoreKey(drawID uint64) []byte {
+	return append(DrawParticipantsStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// DrawOwnersTicketsPrefix returns the store prefix used to save the number of tickets bought by each owner
+// for the draw having the given id
+func DrawOwnersTicketsPrefix(drawID uint64) []byte {
+	return append(DrawOwnerTicketsStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// DrawOwnerTicketsStoreKey returns the store key used to save the number of tickets bought by the given owner
+// for the draw having the given id
+func DrawOwnerTicketsStoreKey(drawID uint64, owner string) []byte {
+	return append(DrawOwnersTicketsPrefix(drawID), []byte(owner)...)
+}
+
+// DrawTicketsPrefix returns the store prefix used to save the ids of the tickets of the draw having the given id,
+// sorted by their position inside the draw
+func DrawTicketsPrefix(drawID uint64) []byte {
+	return append(DrawTicketsStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// DrawTicketStoreKey returns the store key used to save the id of the ticket at the given position
+// of the draw having the provided id
+func DrawTicketStoreKey(drawID uint64, position uint32) []byte {
+	return append(DrawTicketsPrefix(drawID), sdk.Uint64ToBigEndian(uint64(position))...)
+}
+
+// TicketPositionStoreKey returns the store key used to save the draw of the ticket with the given id,
+// along with its position inside such draw
+func TicketPositionStoreKey(id string) []byte {
+	return append(TicketPositionsStorePrefix, []byte(id)...)
+}
+
+// DrawTicketsTreePrefix returns the store prefix used to save the nodes of the tickets Merkle tree
+// of the draw having the given id
+func DrawTicketsTreePrefix(drawID uint64) []byte {
+	return append(DrawTicketsTreeStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// DrawTicketsTreeNodeStoreKey returns the store key used to save the node at the given level and index
+// of the tickets Merkle tree of the draw having the provided id
+func DrawTicketsTreeNodeStoreKey(drawID uint64, level uint8, index uint32) []byte {
+	return append(append(DrawTicketsTreePrefix(drawID), level), sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// PruningQueueStoreKey returns the store key used to save the number of tickets of the past draw having the given id
+// that still need to be removed
+func PruningQueueStoreKey(drawID uint64) []byte {
+	return append(PruningQueueStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// TicketsMoveQueueStoreKey returns the store key used to save the id of the draw to which the tickets of the
+// rolled over draw having the given id are being moved
+func TicketsMoveQueueStoreKey(drawID uint64) []byte {
+	return append(TicketsMoveQueueStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// PausedPoolStoreKey returns the store key used to mark the ticket sales of the pool having the given id as paused
+func PausedPoolStoreKey(poolID uint64) []byte {
+	return append(PausedPoolsStorePrefix, sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// UpcomingDrawIDStoreKey returns the store key used to save the id reserved to the draw following the current one
+// of the given pool
+func UpcomingDrawIDStoreKey(poolID uint64) []byte {
+	return append(UpcomingDrawIDStorePrefix, sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// PoolStoreKey returns the store key used to save the pool with the given id
+func PoolStoreKey(id uint64) []byte {
+	return append(PoolsStorePrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// PoolPrizeCollectorAddress returns the address of the account holding the prize of the given pool.
+// The default pool uses the PrizeCollectorName module account
+func PoolPrizeCollectorAddress(poolID uint64) sdk.AccAddress {
+	if poolID == DefaultPoolID {
+		return authtypes.NewModuleAddress(PrizeCollectorName)
+	}
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s_%d", PrizeCollectorName, poolID))
+}
+
+// PoolUpcomingPrizeCollectorAddress returns the address of the account holding the prize collected for the draw
+// following the current one of the given pool, until such draw starts
+func PoolUpcomingPrizeCollectorAddress(poolID uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s_upcoming_%d", PrizeCollectorName, poolID))
+}
+
+// TicketsPrefix returns the store prefix used to save the tickets of the draw having the given id
+func TicketsPrefix(drawID uint64) []byte {
+	return append(TicketsStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// TicketsStoreKey returns the store key used to save the ticket with the given id of the draw having the provided id
+func TicketsStoreKey(drawID uint64, id string) []byte {
+	return append(TicketsPrefix(drawID), []byte(id)...)
+}
+
+// OwnerTicketsPrefix returns the store prefix used to index the tickets owned by the given address
+func OwnerTicketsPrefix(owner string) []byte {
+	return append(OwnerTicketsStorePrefix, []byte(owner+"/")...)
+}
+
+// OwnerTicketStoreKey returns the store key used to index the ticket with the given id under its owner
+func OwnerTicketStoreKey(owner string, id string) []byte {
+	return append(OwnerTicketsPrefix(owner), []byte(id)...)
+}
+
+// HistoricalDataStoreKey returns the store key used to save a historical data entry of the draw with the given id
+func HistoricalDataStoreKey(drawID uint64) []byte {
+	return append(HistoricalDrawStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// PoolEntropyCommitmentsPrefix returns the store prefix used to save the entropy commitments of the given pool
+func PoolEntropyCommitmentsPrefix(poolID uint64) []byte {
+	return append(EntropyCommitmentsStorePrefix, sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// EntropyCommitmentStoreKey returns the store key used to save the entropy commitment of the given validator
+// for the current draw of the provided pool
+func EntropyCommitmentStoreKey(poolID uint64, validator sdk.ValAddress) []byte {
+	return append(PoolEntropyCommitmentsPrefix(poolID), validator.Bytes()...)
+}
+
+// MissedRevealsStoreKey returns the store key used to save the missed reveals count of the given validator
+func MissedRevealsStoreKey(validator sdk.ValAddress) []byte {
+	return append(MissedRevealsStorePrefix, validator.Bytes()...)
+}
+
+// ExclusionStoreKey returns the store key used to save the self exclusion of the given address
+func ExclusionStoreKey(address sdk.AccAddress) []byte {
+	return append(ExclusionsStorePrefix, address.Bytes()...)
+}
+
+// SpendingLimitStoreKey returns the store key used to save the spending limit of the given address
+func SpendingLimitStoreKey(address sdk.AccAddress) []byte {
+	return append(SpendingLimitsStorePrefix, address.Bytes()...)
+}
+
+// SpendRecordsPrefix returns the store prefix used to save the amounts spent by the given address,
+// sorted by the time of the block in which they have been spent
+func SpendRecordsPrefix(address sdk.AccAddress) []byte {
+	return append(SpendRecordsStorePrefix, address.Bytes()...)
+}
+
+// SpendRecordStoreKey returns the store key used to save the amount spent by the given address
+// inside the block having the provided time
+func SpendRecordStoreKey(address sdk.AccAddress, time time.Time) []byte {
+	return append(SpendRecordsPrefix(address), sdk.FormatTimeBytes(time)...)
+}
+
+// DrawFeesPrefix returns the store prefix used to save the fees of the draw having the given id
+func DrawFeesPrefix(drawID uint64) []byte {
+	return append(DrawFeesStorePrefix, sdk.Uint64ToBigEndian(drawID)...)
+}
+
+// DrawFeesStoreKey returns the store key used to save the fees of the draw having the given id
+// that have been sent to the given destination
+func DrawFeesStoreKey(drawID uint64, destination FeeDestination) []byte {
+	return append(DrawFeesPrefix(drawID), byte(destination))
+}
+
+// PricePostsPrefix returns the store prefix used to save the prices of the given denom posted by each oracle.
+// The denom is prefixed by its length, so that the prefix of a denom never contains the posts of another one
+func PricePostsPrefix(denom string) []byte {
+	return append(append(PricePostsStorePrefix, byte(len(denom))), []byte(denom)...)
+}
+
+// PricePostStoreKey returns the store key used to save the price of the given denom posted by the given oracle
+func PricePostStoreKey(denom string, oracle sdk.AccAddress) []byte {
+	return append(PricePostsPrefix(denom), oracle.Bytes()...)
 }
 
-// HistoricalDataStoreKey returns the store key used to save a historical data entry with the given timestamp
-func HistoricalDataStoreKey(timestamp time.Time) []byte {
-	return append(HistoricalDrawStorePrefix, []byte(timestamp.Format(time.RFC3339))...)
+// PriceStoreKey returns the store key used to save the median price of the given denom
+func PriceStoreKey(denom string) []byte {
+	return append(PricesStorePrefix, []byte(denom)...)
 }
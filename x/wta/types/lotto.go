@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SortNumbers returns a copy of the given numbers sorted in ascending order
+func SortNumbers(numbers []uint32) []uint32 {
+	if numbers == nil {
+		return nil
+	}
+
+	sorted := make([]uint32, len(numbers))
+	copy(sorted, numbers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
+
+// AreNumbersUnique tells whether the given numbers do not contain any duplicate
+func AreNumbersUnique(numbers []uint32) bool {
+	found := map[uint32]bool{}
+	for _, number := range numbers {
+		if found[number] {
+			return false
+		}
+		found[number] = true
+	}
+	return true
+}
+
+// CountMatches returns how many of the given numbers are contained inside the winning ones
+func CountMatches(numbers, winningNumbers []uint32) uint32 {
+	winning := map[uint32]bool{}
+	for _, number := range winningNumbers {
+		winning[number] = true
+	}
+
+	var matches uint32
+	for _, number := range numbers {
+		if winning[number] {
+			matches++
+		}
+	}
+	return matches
+}
+
+// ComputeWinningNumbers returns count distinct winning numbers between 1 and maxNumber, using the provided seed.
+// The numbers are extracted in the same way as the winning indexes, and are returned sorted in ascending order
+func ComputeWinningNumbers(seed []byte, count, maxNumber uint32) []uint32 {
+	indexes := ComputeWinningIndexes(seed, maxNumber, count)
+
+	numbers := make([]uint32, len(indexes))
+	for i, index := range indexes {
+		numbers[i] = index + 1
+	}
+	return SortNumbers(numbers)
+}
+
+// ComputeLottoWinners returns the winners among the given tickets of a lotto game having the provided params.
+// Each ticket matching the amount of numbers of a tier wins it, and the amount of the tier is split evenly among
+// its winners, truncating any remainder. Winners are sorted by tier and then by the order of the given tickets.
+// Along with each ticket, its index inside the draw and the aunts of its inclusion proof must be provided
+func ComputeLottoWinners(
+	params GameParams, prize sdk.Coins, tickets []Ticket, indexes []uint32, aunts [][][]byte, winningNumbers []uint32,
+) []DrawWinner {
+	// Group the winning tickets by tier
+	tiersTickets := make([][]int, len(params.MatchTiers))
+	for i, ticket := range tickets {
+		tier, found := params.MatchTierIndex(CountMatches(ticket.Numbers, winningNumbers))
+		if found {
+			tiersTickets[tier] = append(tiersTickets[tier], i)
+		}
+	}
+
+	decPrize := sdk.NewDecCoinsFromCoins(prize...)
+
+	var winners []DrawWinner
+	for tier, winning := range tiersTickets {
+		if len(winning) == 0 {
+			continue
+		}
+
+		tierAmount := decPrize.MulDecTruncate(params.MatchTiers[tier].Percentage)
+		winnerAmount, _ := tierAmount.QuoDecTruncate(sdk.NewDec(int64(len(winning)))).TruncateDecimal()
+
+		for _, i := range winning {
+			winners = append(winners, NewDrawWinner(
+				uint32(tier), tickets[i], indexes[i], aunts[i], winnerAmount, params.MatchTiers[tier].Matches,
+			))
+		}
+	}
+
+	return winners
+}
+
+// VerifyLottoWinners replays the extraction of the winning numbers of a lotto draw using the given seed, checking
+// that it results in the provided winning numbers. Then, it checks that each winner matches the amount of numbers
+// it declares, and that its ticket is included inside the tickets root at its index
+func VerifyLottoWinners(
+	seed, root []byte, total, maxNumber uint32, winningNumbers []uint32, winners []DrawWinner,
+) error {
+	if len(seed) == 0 {
+		return fmt.Errorf("invalid draw seed")
+	}
+
+	expected := ComputeWinningNumbers(seed, uint32(len(winningNumbers)), maxNumber)
+	if len(expected) != len(winningNumbers) {
+		return fmt.Errorf("invalid winning numbers count: %d", len(winningNumbers))
+	}
+
+	for i, number := range expected {
+		if winningNumbers[i] != number {
+			return fmt.Errorf("winning numbers mismatch: expected %v but got %v", expected, winningNumbers)
+		}
+	}
+
+	for _, winner := range winners {
+		matches := CountMatches(winner.Ticket.Numbers, winningNumbers)
+		if matches == 0 || matches != winner.Matches {
+			return fmt.Errorf("winner %s matches mismatch: expected %d but got %d", winner.Ticket.Id, matches, winner.Matches)
+		}
+
+		err := VerifyTicketInclusion(root, winner.Ticket, winner.Index, total, winner.Proof)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+func TestSortNumbers(t *testing.T) {
+	numbers := []uint32{5, 1, 3}
+	require.Equal(t, []uint32{1, 3, 5}, types.SortNumbers(numbers))
+	require.Equal(t, []uint32{5, 1, 3}, numbers)
+	require.Nil(t, types.SortNumbers(nil))
+}
+
+func TestAreNumbersUnique(t *testing.T) {
+	require.True(t, types.AreNumbersUnique(nil))
+	require.True(t, types.AreNumbersUnique([]uint32{1, 2, 3}))
+	require.False(t, types.AreNumbersUnique([]uint32{1, 2, 1}))
+}
+
+func TestCountMatches(t *testing.T) {
+	require.Equal(t, uint32(0), types.CountMatches(nil, []uint32{1, 2, 3}))
+	require.Equal(t, uint32(0), types.CountMatches([]uint32{4, 5, 6}, []uint32{1, 2, 3}))
+	require.Equal(t, uint32(2), types.CountMatches([]uint32{1, 3, 6}, []uint32{1, 2, 3}))
+	require.Equal(t, uint32(3), types.CountMatches([]uint32{3, 2, 1}, []uint32{1, 2, 3}))
+}
+
+func TestComputeWinningNumbers(t *testing.T) {
+	seed := []byte("seed")
+
+	numbers := types.ComputeWinningNumbers(seed, 6, 49)
+	require.Len(t, numbers, 6)
+	require.Equal(t, numbers, types.ComputeWinningNumbers(seed, 6, 49))
+	require.Equal(t, numbers, types.SortNumbers(numbers))
+	require.True(t, types.AreNumbersUnique(numbers))
+	for _, number := range numbers {
+		require.GreaterOrEqual(t, number, uint32(1))
+		require.LessOrEqual(t, number, uint32(49))
+	}
+
+	// Extracting all the numbers should return the whole range
+	require.Equal(t, []uint32{1, 2, 3}, types.ComputeWinningNumbers(seed, 3, 3))
+}
+
+func TestComputeLottoWinners(t *testing.T) {
+	params := types.NewGameParams(types.GameTypeLotto, 3, 10, []types.MatchTier{
+		types.NewMatchTier(3, sdk.NewDecWithPrec(70, 2)),
+		types.NewMatchTier(2, sdk.NewDecWithPrec(30, 2)),
+	})
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	tickets := []types.Ticket{
+		types.NewTicket("1", 1, timestamp, "owner-1", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 2, 4}),
+		types.NewTicket("2", 1, timestamp, "owner-2", "", sdk.NewInt64Coin("stake", 10), []uint32{4, 5, 6}),
+		types.NewTicket("3", 1, timestamp, "owner-3", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 2, 3}),
+		types.NewTicket("4", 1, timestamp, "owner-4", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 3, 7}),
+		types.NewTicket("5", 1, timestamp, "owner-5", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 8, 9}),
+	}
+	_, proofs := computeTicketsMerkleProofs(tickets)
+	indexes, aunts := []uint32{0, 1, 2, 3, 4}, make([][][]byte, len(proofs))
+	for i, proof := range proofs {
+		aunts[i] = proof.Aunts
+	}
+	prize := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	winners := types.ComputeLottoWinners(params, prize, tickets, indexes, aunts, []uint32{1, 2, 3})
+	require.Equal(t, []types.DrawWinner{
+		types.NewDrawWinner(0, tickets[2], 2, proofs[2].Aunts, sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), 3),
+		types.NewDrawWinner(1, tickets[0], 0, proofs[0].Aunts, sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), 2),
+		types.NewDrawWinner(1, tickets[3], 3, proofs[3].Aunts, sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), 2),
+	}, winners)
+
+	// Nobody matching all the numbers should leave the first tier without winners
+	winners = types.ComputeLottoWinners(params, prize, tickets, indexes, aunts, []uint32{4, 5, 7})
+	require.Equal(t, []types.DrawWinner{
+		types.NewDrawWinner(1, tickets[1], 1, proofs[1].Aunts, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), 2),
+	}, winners)
+}
+
+func TestVerifyLottoWinners(t *testing.T) {
+	params := types.NewGameParams(types.GameTypeLotto, 3, 5, []types.MatchTier{
+		types.NewMatchTier(3, sdk.NewDecWithPrec(50, 2)),
+		types.NewMatchTier(2, sdk.NewDecWithPrec(30, 2)),
+		types.NewMatchTier(1, sdk.NewDecWithPrec(20, 2)),
+	})
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	tickets := []types.Ticket{
+		types.NewTicket("1", 1, timestamp, "owner-1", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 2, 3}),
+		types.NewTicket("2", 1, timestamp, "owner-2", "", sdk.NewInt64Coin("stake", 10), []uint32{3, 4, 5}),
+	}
+	seed := []byte("seed")
+	root, proofs := computeTicketsMerkleProofs(tickets)
+	winningNumbers := types.ComputeWinningNumbers(seed, params.Picks, params.MaxNumber)
+	winners := types.ComputeLottoWinners(
+		params, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), tickets, []uint32{0, 1},
+		[][][]byte{proofs[0].Aunts, proofs[1].Aunts}, winningNumbers,
+	)
+	require.NotEmpty(t, winners)
+
+	wrongMatches := make([]types.DrawWinner, len(winners))
+	copy(wrongMatches, winners)
+	wrongMatches[0].Matches++
+
+	usecases := []struct {
+		name           string
+		seed           []byte
+		winningNumbers []uint32
+		winners        []types.DrawWinner
+		shouldErr      bool
+	}{
+		{
+			name:           "empty seed",
+			seed:           nil,
+			winningNumbers: winningNumbers,
+			winners:        winners,
+			shouldErr:      true,
+		},
+		{
+			name:           "wrong winning numbers",
+			seed:           []byte("other-seed"),
+			winningNumbers: winningNumbers,
+			winners:        winners,
+			shouldErr:      true,
+		},
+		{
+			name:           "wrong winner matches",
+			seed:           seed,
+			winningNumbers: winningNumbers,
+			winners:        wrongMatches,
+			shouldErr:      true,
+		},
+		{
+			name:           "valid winners",
+			seed:           seed,
+			winningNumbers: winningNumbers,
+			winners:        winners,
+			shouldErr:      false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.VerifyLottoWinners(uc.seed, root, uint32(len(tickets)), params.MaxNumber, uc.winningNumbers, uc.winners)
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -9,11 +12,17 @@ import (
 )
 
 // NewTicket allows to build a new Ticket instance.
-func NewTicket(id string, timestamp time.Time, owner string) Ticket {
+func NewTicket(
+	id string, drawID uint64, timestamp time.Time, owner string, payer string, price sdk.Coin, numbers []uint32,
+) Ticket {
 	return Ticket{
 		Id:        id,
 		Owner:     owner,
 		Timestamp: timestamp,
+		DrawId:    drawID,
+		Numbers:   numbers,
+		Price:     price,
+		Payer:     payer,
 	}
 }
 
@@ -23,6 +32,10 @@ func (t *Ticket) Validate() error {
 		return fmt.Errorf("invalid ticket id: %s", t.Id)
 	}
 
+	if t.DrawId == 0 {
+		return fmt.Errorf("invalid ticket draw id: %d", t.DrawId)
+	}
+
 	if t.Timestamp.IsZero() {
 		return fmt.Errorf("invalid ticket creation time: %s", t.Timestamp.Format(time.RFC3339))
 	}
@@ -31,9 +44,51 @@ func (t *Ticket) Validate() error {
 		return fmt.Errorf("invalid ticket owner: %s", t.Owner)
 	}
 
+	if t.Payer != "" {
+		if _, err := sdk.AccAddressFromBech32(t.Payer); err != nil {
+			return fmt.Errorf("invalid ticket payer: %s", t.Payer)
+		}
+	}
+
+	if err := t.Price.Validate(); err != nil || !t.Price.IsPositive() {
+		return fmt.Errorf("invalid ticket price: %s", t.Price)
+	}
+
+	if !t.PrizeShare.IsValid() || !t.PrizeShare.IsAllLTE(sdk.NewCoins(t.Price)) {
+		return fmt.Errorf("invalid ticket prize share: %s", t.PrizeShare)
+	}
+
+	for _, number := range t.Numbers {
+		if number == 0 {
+			return fmt.Errorf("invalid ticket number: %d", number)
+		}
+	}
+
+	if !AreNumbersUnique(t.Numbers) {
+		return fmt.Errorf("ticket with id %s has duplicated numbers", t.Id)
+	}
+
 	return nil
 }
 
+// PaidBy returns the address that paid for the ticket, which is its owner if no payer has been recorded
+func (t Ticket) PaidBy() string {
+	if t.Payer != "" {
+		return t.Payer
+	}
+	return t.Owner
+}
+
+// RefundAddress returns the address to which the prize share of the ticket should be sent if its draw is refunded.
+// This is the owner of transferred tickets, since the sender could have been compensated for them in any way,
+// and the address that paid for the ticket otherwise
+func (t Ticket) RefundAddress() string {
+	if t.Transferred {
+		return t.Owner
+	}
+	return t.PaidBy()
+}
+
 // MarshalTicket marshals the given ticket to a slice of bytes
 func MarshalTicket(cdc codec.BinaryMarshaler, ticket Ticket) ([]byte, error) {
 	return cdc.MarshalBinaryBare(&ticket)
@@ -79,8 +134,10 @@ func IsTicketIDDuplicated(id string, slice []Ticket) bool {
 // ------------------------------------------------------------------------------------------------------------------
 
 // NewDraw allows to build a new Draw instance
-func NewDraw(participants, ticketsSold uint32, prize sdk.Coins, endTime time.Time) Draw {
+func NewDraw(id, poolID uint64, participants, ticketsSold uint32, prize sdk.Coins, endTime time.Time) Draw {
 	return Draw{
+		Id:           id,
+		PoolId:       poolID,
 		Participants: participants,
 		TicketsSold:  ticketsSold,
 		Prize:        prize,
@@ -90,6 +147,10 @@ func NewDraw(participants, ticketsSold uint32, prize sdk.Coins, endTime time.Tim
 
 // Validate returns an error if there is something wrong with the provided Draw
 func (d Draw) Validate() error {
+	if d.Id == 0 {
+		return fmt.Errorf("invalid draw id: %d", d.Id)
+	}
+
 	if d.TicketsSold < d.Participants {
 		return fmt.Errorf("tickets sold cannot be less then the participants")
 	}
@@ -108,7 +169,9 @@ func (d Draw) Validate() error {
 
 // Equal tells whether d and e contain the same data
 func (d Draw) Equal(e Draw) bool {
-	return d.Participants == e.Participants &&
+	return d.Id == e.Id &&
+		d.PoolId == e.PoolId &&
+		d.Participants == e.Participants &&
 		d.TicketsSold == e.TicketsSold &&
 		d.Prize.IsEqual(e.Prize) &&
 		d.EndTime.Equal(e.EndTime)
@@ -128,28 +191,233 @@ func MustUnmarshalDrawEndTime(bz []byte) time.Time {
 	return date
 }
 
+// MustMarshalDrawID marshals the given draw id as a byte array
+func MustMarshalDrawID(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// MustUnmarshalDrawID unmarshals the given byte slice as a draw id
+func MustUnmarshalDrawID(bz []byte) uint64 {
+	return sdk.BigEndianToUint64(bz)
+}
+
+// MustMarshalDrawRollovers marshals the given draw rollovers count as a byte array
+func MustMarshalDrawRollovers(rollovers uint32) []byte {
+	return sdk.Uint64ToBigEndian(uint64(rollovers))
+}
+
+// MustUnmarshalDrawRollovers unmarshals the given byte slice as a draw rollovers count
+func MustUnmarshalDrawRollovers(bz []byte) uint32 {
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// MustMarshalTicketsCount marshals the given tickets or participants count as a byte array
+func MustMarshalTicketsCount(count uint32) []byte {
+	return sdk.Uint64ToBigEndian(uint64(count))
+}
+
+// MustUnmarshalTicketsCount unmarshals the given byte slice as a tickets or participants count
+func MustUnmarshalTicketsCount(bz []byte) uint32 {
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// MustMarshalTicketPosition marshals the given ticket position as a byte array
+func MustMarshalTicketPosition(position uint32) []byte {
+	return sdk.Uint64ToBigEndian(uint64(position))
+}
+
+// MustUnmarshalTicketPosition unmarshals the given byte slice as a ticket position
+func MustUnmarshalTicketPosition(bz []byte) uint32 {
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// MustMarshalTicketDrawPosition marshals the given draw id and ticket position as a byte array
+func MustMarshalTicketDrawPosition(drawID uint64, position uint32) []byte {
+	return append(sdk.Uint64ToBigEndian(drawID), MustMarshalTicketPosition(position)...)
+}
+
+// MustUnmarshalTicketDrawPosition unmarshals the given byte slice as a draw id and a ticket position
+func MustUnmarshalTicketDrawPosition(bz []byte) (drawID uint64, position uint32) {
+	return sdk.BigEndianToUint64(bz[:8]), MustUnmarshalTicketPosition(bz[8:])
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewPruningDraw allows to build a new PruningDraw instance
+func NewPruningDraw(drawID uint64, remainingTickets uint32) PruningDraw {
+	return PruningDraw{
+		DrawId:           drawID,
+		RemainingTickets: remainingTickets,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewTicketsMove allows to build a new TicketsMove instance
+func NewTicketsMove(drawID uint64, newDrawID uint64) TicketsMove {
+	return TicketsMove{
+		DrawId:    drawID,
+		NewDrawId: newDrawID,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewDrawWinner creates a new DrawWinner
+func NewDrawWinner(tier uint32, ticket Ticket, index uint32, proof [][]byte, prize sdk.Coins, matches uint32) DrawWinner {
+	return DrawWinner{
+		Tier:    tier,
+		Ticket:  ticket,
+		Index:   index,
+		Proof:   proof,
+		Prize:   prize,
+		Matches: matches,
+	}
+}
+
+// Validate checks the validity of the DrawWinner
+func (w DrawWinner) Validate() error {
+	err := w.Ticket.Validate()
+	if err != nil {
+		return err
+	}
+
+	if !w.Prize.IsValid() {
+		return fmt.Errorf("invalid winner prize: %s", w.Prize)
+	}
+
+	return nil
+}
+
 // ------------------------------------------------------------------------------------------------------------------
 
 // NewHistoricalDrawData creates a new HistoricalDrawData
-func NewHistoricalDrawData(draw Draw, winningTicket Ticket) HistoricalDrawData {
+func NewHistoricalDrawData(
+	draw Draw, winningTicket Ticket, seed, ticketsRoot []byte, winningIndex uint32, winningTicketProof [][]byte,
+	winners []DrawWinner, winningNumbers []uint32, maxNumber uint32, rollovers uint32,
+) HistoricalDrawData {
 	return HistoricalDrawData{
-		Draw:          draw,
-		WinningTicket: winningTicket,
+		Draw:               draw,
+		WinningTicket:      winningTicket,
+		Seed:               seed,
+		TicketsRoot:        ticketsRoot,
+		WinningIndex:       winningIndex,
+		WinningTicketProof: winningTicketProof,
+		Winners:            winners,
+		WinningNumbers:     winningNumbers,
+		MaxNumber:          maxNumber,
+		Status:             DrawStatusSettled,
+		Rollovers:          rollovers,
 	}
 }
 
+// NewUnsettledHistoricalDrawData creates a new HistoricalDrawData for a draw that has not been settled
+func NewUnsettledHistoricalDrawData(draw Draw, status DrawStatus, rollovers uint32) HistoricalDrawData {
+	return HistoricalDrawData{
+		Draw:      draw,
+		Status:    status,
+		Rollovers: rollovers,
+	}
+}
+
+// IsLotto tells whether h contains the data of a draw of a lotto pool
+func (h *HistoricalDrawData) IsLotto() bool {
+	return len(h.WinningNumbers) != 0
+}
+
 func (h *HistoricalDrawData) Validate() error {
 	err := h.Draw.Validate()
 	if err != nil {
 		return err
 	}
 
+	// Unsettled draws do not have any winner
+	switch h.Status {
+	case DrawStatusSettled:
+		break
+
+	case DrawStatusRolledOver, DrawStatusRefunded, DrawStatusCancelled:
+		// Only cancelled draws can be closed without having been rolled over
+		if h.Rollovers == 0 && h.Status != DrawStatusCancelled {
+			return fmt.Errorf("invalid rollovers of unsettled draw %d: %d", h.Draw.Id, h.Rollovers)
+		}
+
+		if len(h.Winners) != 0 {
+			return fmt.Errorf("unsettled draw %d cannot have winners", h.Draw.Id)
+		}
+
+		return nil
+
+	case DrawStatusEmpty:
+		if h.Draw.TicketsSold != 0 {
+			return fmt.Errorf("invalid tickets sold of empty draw %d: %d", h.Draw.Id, h.Draw.TicketsSold)
+		}
+
+		if len(h.Winners) != 0 {
+			return fmt.Errorf("unsettled draw %d cannot have winners", h.Draw.Id)
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("invalid draw status: %s", h.Status)
+	}
+
+	// Lotto draws do not have a single winning ticket, and their winners are the ones matching the winning numbers
+	if h.IsLotto() {
+		for _, winner := range h.Winners {
+			err = winner.Validate()
+			if err != nil {
+				return err
+			}
+		}
+
+		return VerifyLottoWinners(h.Seed, h.TicketsRoot, h.Draw.TicketsSold, h.MaxNumber, h.WinningNumbers, h.Winners)
+	}
+
 	err = h.WinningTicket.Validate()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	// Draws saved before the proofs were introduced do not have any tickets root
+	if h.TicketsRoot == nil {
+		return nil
+	}
+
+	err = VerifyDrawProof(h.Seed, h.TicketsRoot, h.WinningIndex, h.Draw.TicketsSold, h.WinningTicket, h.WinningTicketProof)
+	if err != nil {
+		return err
+	}
+
+	// Draws saved before the prize tiers were introduced only have the winning ticket
+	if len(h.Winners) == 0 {
+		return nil
+	}
+
+	for _, winner := range h.Winners {
+		err = winner.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if h.Winners[0].Ticket.Id != h.WinningTicket.Id || h.Winners[0].Index != h.WinningIndex {
+		return fmt.Errorf("first winner ticket %s is not the winning ticket", h.Winners[0].Ticket.Id)
+	}
+
+	return VerifyDrawWinners(h.Seed, h.TicketsRoot, h.Draw.TicketsSold, h.Winners)
+}
+
+// IsHistoricalDrawIDDuplicated tells whether or not the given draw id is duplicated inside the provided slice
+func IsHistoricalDrawIDDuplicated(id uint64, slice []HistoricalDrawData) bool {
+	var count = 0
+	for _, data := range slice {
+		if data.Draw.Id == id {
+			count++
+		}
+	}
+	return count > 1
 }
 
 // MarshalHistoricalDraw marshals the given historical draw as a byte array
@@ -186,3 +454,593 @@ func MustUnmarshalHistoricalDrawData(cdc codec.BinaryMarshaler, bz []byte) Histo
 	}
 	return data
 }
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// ComputeEntropyCommitment returns the commitment that the given validator should submit
+// in order to later reveal the provided entropy
+func ComputeEntropyCommitment(validator sdk.ValAddress, entropy []byte) []byte {
+	hash := sha256.Sum256(append(validator.Bytes(), entropy...))
+	return hash[:]
+}
+
+// NewEntropyCommitment allows to build a new EntropyCommitment instance
+func NewEntropyCommitment(poolID uint64, validator string, commitment []byte, entropy []byte) EntropyCommitment {
+	return EntropyCommitment{
+		PoolId:     poolID,
+		Validator:  validator,
+		Commitment: commitment,
+		Entropy:    entropy,
+	}
+}
+
+// IsRevealed tells whether the entropy of c has already been revealed
+func (c EntropyCommitment) IsRevealed() bool {
+	return len(c.Entropy) != 0
+}
+
+// Validate returns an error if there is something wrong inside c
+func (c EntropyCommitment) Validate() error {
+	valAddr, err := sdk.ValAddressFromBech32(c.Validator)
+	if err != nil {
+		return fmt.Errorf("invalid commitment validator: %s", c.Validator)
+	}
+
+	if len(c.Commitment) != sha256.Size {
+		return fmt.Errorf("invalid commitment length: %d", len(c.Commitment))
+	}
+
+	if c.IsRevealed() && !bytes.Equal(ComputeEntropyCommitment(valAddr, c.Entropy), c.Commitment) {
+		return fmt.Errorf("revealed entropy does not match the commitment of validator %s", c.Validator)
+	}
+
+	return nil
+}
+
+// IsEntropyCommitmentDuplicated tells whether or not the given validator has more than one commitment
+// for the provided pool inside the given slice
+func IsEntropyCommitmentDuplicated(poolID uint64, validator string, slice []EntropyCommitment) bool {
+	var count = 0
+	for _, c := range slice {
+		if c.PoolId == poolID && c.Validator == validator {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// MustMarshalEntropyCommitment marshals the given commitment into a slice of bytes, and panics on error
+func MustMarshalEntropyCommitment(cdc codec.BinaryMarshaler, commitment EntropyCommitment) []byte {
+	return cdc.MustMarshalBinaryBare(&commitment)
+}
+
+// MustUnmarshalEntropyCommitment unmarshals the given byte slice into an EntropyCommitment object, and panics on error
+func MustUnmarshalEntropyCommitment(cdc codec.BinaryMarshaler, bz []byte) EntropyCommitment {
+	var commitment EntropyCommitment
+	cdc.MustUnmarshalBinaryBare(bz, &commitment)
+	return commitment
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewMissedReveals allows to build a new MissedReveals instance
+func NewMissedReveals(validator string, count uint64) MissedReveals {
+	return MissedReveals{
+		Validator: validator,
+		Count:     count,
+	}
+}
+
+// Validate returns an error if there is something wrong inside m
+func (m MissedReveals) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(m.Validator); err != nil {
+		return fmt.Errorf("invalid missed reveals validator: %s", m.Validator)
+	}
+
+	return nil
+}
+
+// MustMarshalMissedRevealsCount marshals the given count as a byte array
+func MustMarshalMissedRevealsCount(count uint64) []byte {
+	return sdk.Uint64ToBigEndian(count)
+}
+
+// MustUnmarshalMissedRevealsCount unmarshals the given byte slice as a missed reveals count
+func MustUnmarshalMissedRevealsCount(bz []byte) uint64 {
+	return sdk.BigEndianToUint64(bz)
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewPool allows to build a new Pool instance
+func NewPool(
+	id uint64, distributionParams DistributionParams, drawParams DrawParams, ticketParams TicketParams,
+	prizeParams PrizeParams, gameParams GameParams, rolloverParams RolloverParams,
+) Pool {
+	return Pool{
+		Id:                 id,
+		DistributionParams: distributionParams,
+		DrawParams:         drawParams,
+		TicketParams:       ticketParams,
+		PrizeParams:        prizeParams,
+		GameParams:         gameParams,
+		RolloverParams:     rolloverParams,
+	}
+}
+
+// Validate returns an error if there is something wrong inside p
+func (p Pool) Validate() error {
+	err := ValidateDistributionParams(p.DistributionParams)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateDrawParams(p.DrawParams)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateTicketParams(p.TicketParams)
+	if err != nil {
+		return err
+	}
+
+	err = ValidatePrizeParams(p.PrizeParams)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateGameParams(p.GameParams)
+	if err != nil {
+		return err
+	}
+
+	return ValidateRolloverParams(p.RolloverParams)
+}
+
+// IsPoolIDDuplicated tells whether or not the given pool id is duplicated inside the provided slice
+func IsPoolIDDuplicated(id uint64, slice []PoolState) bool {
+	var count = 0
+	for _, state := range slice {
+		if state.Pool.Id == id {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// IsPoolIDPresent tells whether the given slice contains a pool state having the given id
+func IsPoolIDPresent(id uint64, slice []PoolState) bool {
+	for _, state := range slice {
+		if state.Pool.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// MustMarshalPool marshals the given pool into a slice of bytes, and panics on error
+func MustMarshalPool(cdc codec.BinaryMarshaler, pool Pool) []byte {
+	return cdc.MustMarshalBinaryBare(&pool)
+}
+
+// MustUnmarshalPool unmarshals the given byte slice into a Pool object, and panics on error
+func MustUnmarshalPool(cdc codec.BinaryMarshaler, bz []byte) Pool {
+	var pool Pool
+	cdc.MustUnmarshalBinaryBare(bz, &pool)
+	return pool
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// NewExclusion allows to build a new Exclusion instance
+func NewExclusion(address string, until time.Time) Exclusion {
+	return Exclusion{
+		Address: address,
+		Until:   until,
+	}
+}
+
+// Validate returns an error if there is something wrong inside e
+func (e Exclusion) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid exclusion address: %s", e.Address)
+	}
+
+	if e.Until.IsZero() {
+		return fmt.Errorf("invalid exclusion end time: %s", e.Until.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// IsActive tells whether the exclusion is still active at the given time
+func (e Exclusion) IsActive(now time.Time) bool {
+	return now.Before(e.Until)
+}
+
+// MustMarshalExclusion marshals the given exclusion into a slice of bytes, and panics on error
+func MustMarshalExclusion(cdc codec.BinaryMarshaler, exclusion Exclusion) []byte {
+	return cdc.MustMarshalBinaryBare(&exclusion)
+}
+
+// MustUnmarshalExclusion unmarshals the given byte slice into an Exclusion, and panics on error
+func MustUnmarshalExclusion(cdc codec.BinaryMarshaler, bz []byte) Exclusion {
+	var exclusion Exclusion
+	cdc.MustUnmarshalBinaryBare(bz, &exclusion)
+	return exclusion
+}
+
+// IsExclusionDuplicated tells whether the given address has more than one exclusion inside the given slice
+func IsExclusionDuplicated(address string, slice []Exclusion) bool {
+	var count = 0
+	for _, exclusion := range slice {
+		if exclusion.Address == address {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// NewSpendingLimit allows to build a new SpendingLimit instance
+func NewSpendingLimit(
+	address string, amount sdk.Coins, window time.Duration, pending *PendingSpendingLimit,
+) SpendingLimit {
+	return SpendingLimit{
+		Address: address,
+		Amount:  amount,
+		Window:  window,
+		Pending: pending,
+	}
+}
+
+// NewPendingSpendingLimit allows to build a new PendingSpendingLimit instance
+func NewPendingSpendingLimit(amount sdk.Coins, window time.Duration, effectiveTime time.Time) *PendingSpendingLimit {
+	return &PendingSpendingLimit{
+		Amount:        amount,
+		Window:        window,
+		EffectiveTime: effectiveTime,
+	}
+}
+
+// Validate returns an error if there is something wrong inside l
+func (l SpendingLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return fmt.Errorf("invalid spending limit address: %s", l.Address)
+	}
+
+	if l.Amount.Empty() || !l.Amount.IsValid() {
+		return fmt.Errorf("invalid spending limit amount: %s", l.Amount)
+	}
+
+	if l.Window <= 0 {
+		return fmt.Errorf("invalid spending limit window: %s", l.Window)
+	}
+
+	if l.Pending != nil {
+		return l.Pending.Validate()
+	}
+
+	return nil
+}
+
+// Validate returns an error if there is something wrong inside p
+func (p PendingSpendingLimit) Validate() error {
+	if !p.Amount.IsValid() {
+		return fmt.Errorf("invalid pending spending limit amount: %s", p.Amount)
+	}
+
+	if !p.Amount.Empty() && p.Window <= 0 {
+		return fmt.Errorf("invalid pending spending limit window: %s", p.Window)
+	}
+
+	if p.EffectiveTime.IsZero() {
+		return fmt.Errorf("invalid pending spending limit effective time: %s", p.EffectiveTime.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// Current returns the limit that is in effect at the given time, replacing l with its pending limit
+// if its cooling-off delay has passed
+func (l SpendingLimit) Current(now time.Time) SpendingLimit {
+	if l.Pending == nil || now.Before(l.Pending.EffectiveTime) {
+		return l
+	}
+	return NewSpendingLimit(l.Address, l.Pending.Amount, l.Pending.Window, nil)
+}
+
+// IsLimited tells whether l limits the spending of any denom
+func (l SpendingLimit) IsLimited() bool {
+	return !l.Amount.Empty()
+}
+
+// Raises tells whether l allows to spend more than the given limit, either because it has a shorter window,
+// or because it allows to spend a greater amount of any of the denoms limited by the given one
+func (l SpendingLimit) Raises(other SpendingLimit) bool {
+	if !l.IsLimited() || l.Window < other.Window {
+		return true
+	}
+
+	for _, coin := range other.Amount {
+		amount := l.Amount.AmountOf(coin.Denom)
+		if amount.IsZero() || amount.GT(coin.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanSpend tells whether the given amount can be spent by an address that has already spent
+// the provided amount within the current window
+func (l SpendingLimit) CanSpend(spent, amount sdk.Coins) bool {
+	for _, coin := range amount {
+		limit := l.Amount.AmountOf(coin.Denom)
+		if !limit.IsZero() && spent.AmountOf(coin.Denom).Add(coin.Amount).GT(limit) {
+			return false
+		}
+	}
+	return true
+}
+
+// MustMarshalSpendingLimit marshals the given spending limit into a slice of bytes, and panics on error
+func MustMarshalSpendingLimit(cdc codec.BinaryMarshaler, limit SpendingLimit) []byte {
+	return cdc.MustMarshalBinaryBare(&limit)
+}
+
+// MustUnmarshalSpendingLimit unmarshals the given byte slice into a SpendingLimit, and panics on error
+func MustUnmarshalSpendingLimit(cdc codec.BinaryMarshaler, bz []byte) SpendingLimit {
+	var limit SpendingLimit
+	cdc.MustUnmarshalBinaryBare(bz, &limit)
+	return limit
+}
+
+// IsSpendingLimitDuplicated tells whether the given address has more than one spending limit inside the given slice
+func IsSpendingLimitDuplicated(address string, slice []SpendingLimit) bool {
+	var count = 0
+	for _, limit := range slice {
+		if limit.Address == address {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// NewSpendRecord allows to build a new SpendRecord instance
+func NewSpendRecord(address string, time time.Time, amount sdk.Coins) SpendRecord {
+	return SpendRecord{
+		Address: address,
+		Time:    time,
+		Amount:  amount,
+	}
+}
+
+// Validate returns an error if there is something wrong inside r
+func (r SpendRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid spend record address: %s", r.Address)
+	}
+
+	if r.Time.IsZero() {
+		return fmt.Errorf("invalid spend record time: %s", r.Time.Format(time.RFC3339))
+	}
+
+	if r.Amount.Empty() || !r.Amount.IsValid() {
+		return fmt.Errorf("invalid spend record amount: %s", r.Amount)
+	}
+
+	return nil
+}
+
+// MustMarshalSpendRecord marshals the given spend record into a slice of bytes, and panics on error
+func MustMarshalSpendRecord(cdc codec.BinaryMarshaler, record SpendRecord) []byte {
+	return cdc.MustMarshalBinaryBare(&record)
+}
+
+// MustUnmarshalSpendRecord unmarshals the given byte slice into a SpendRecord, and panics on error
+func MustUnmarshalSpendRecord(cdc codec.BinaryMarshaler, bz []byte) SpendRecord {
+	var record SpendRecord
+	cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record
+}
+
+// IsSpendRecordDuplicated tells whether the given address has more than one spend record
+// with the given time inside the provided slice
+func IsSpendRecordDuplicated(address string, time time.Time, slice []SpendRecord) bool {
+	var count = 0
+	for _, record := range slice {
+		if record.Address == address && record.Time.Equal(time) {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// NewDrawFees allows to build a new DrawFees instance
+func NewDrawFees(drawID uint64, destination FeeDestination, amount sdk.Coins) DrawFees {
+	return DrawFees{
+		DrawId:      drawID,
+		Destination: destination,
+		Amount:      amount,
+	}
+}
+
+// Validate returns an error if there is something wrong inside f
+func (f DrawFees) Validate() error {
+	if f.DrawId == 0 {
+		return fmt.Errorf("invalid draw fees draw id: %d", f.DrawId)
+	}
+
+	if _, ok := FeeDestination_name[int32(f.Destination)]; !ok {
+		return fmt.Errorf("invalid draw fees destination: %d", f.Destination)
+	}
+
+	if f.Amount.Empty() || !f.Amount.IsValid() {
+		return fmt.Errorf("invalid draw fees amount: %s", f.Amount)
+	}
+
+	return nil
+}
+
+// MustMarshalDrawFees marshals the given draw fees into a slice of bytes, and panics on error
+func MustMarshalDrawFees(cdc codec.BinaryMarshaler, fees DrawFees) []byte {
+	return cdc.MustMarshalBinaryBare(&fees)
+}
+
+// MustUnmarshalDrawFees unmarshals the given byte slice into a DrawFees, and panics on error
+func MustUnmarshalDrawFees(cdc codec.BinaryMarshaler, bz []byte) DrawFees {
+	var fees DrawFees
+	cdc.MustUnmarshalBinaryBare(bz, &fees)
+	return fees
+}
+
+// IsDrawFeesDuplicated tells whether the given draw has more than one fees entry for the given destination
+// inside the provided slice
+func IsDrawFeesDuplicated(drawID uint64, destination FeeDestination, slice []DrawFees) bool {
+	var count = 0
+	for _, fees := range slice {
+		if fees.DrawId == drawID && fees.Destination == destination {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// NewPricePost allows to build a new PricePost instance
+func NewPricePost(denom string, oracle string, price sdk.Dec, timestamp time.Time) PricePost {
+	return PricePost{
+		Denom:     denom,
+		Oracle:    oracle,
+		Price:     price,
+		Timestamp: timestamp,
+	}
+}
+
+// Validate returns an error if there is something wrong inside p
+func (p PricePost) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid price post denom: %s", p.Denom)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Oracle); err != nil {
+		return fmt.Errorf("invalid price post oracle: %s", p.Oracle)
+	}
+
+	if p.Price.IsNil() || !p.Price.IsPositive() {
+		return fmt.Errorf("invalid price post price: %s", p.Price)
+	}
+
+	if p.Timestamp.IsZero() {
+		return fmt.Errorf("invalid price post timestamp: %s", p.Timestamp)
+	}
+
+	return nil
+}
+
+// IsStale tells whether the price post is older than the given max age at the provided time
+func (p PricePost) IsStale(now time.Time, maxAge time.Duration) bool {
+	return now.After(p.Timestamp.Add(maxAge))
+}
+
+// MustMarshalPricePost marshals the given price post into a slice of bytes, and panics on error
+func MustMarshalPricePost(cdc codec.BinaryMarshaler, post PricePost) []byte {
+	return cdc.MustMarshalBinaryBare(&post)
+}
+
+// MustUnmarshalPricePost unmarshals the given byte slice into a PricePost, and panics on error
+func MustUnmarshalPricePost(cdc codec.BinaryMarshaler, bz []byte) PricePost {
+	var post PricePost
+	cdc.MustUnmarshalBinaryBare(bz, &post)
+	return post
+}
+
+// IsPricePostDuplicated tells whether the given oracle has posted more than one price of the given denom
+// inside the provided slice
+func IsPricePostDuplicated(denom string, oracle string, slice []PricePost) bool {
+	var count = 0
+	for _, post := range slice {
+		if post.Denom == denom && post.Oracle == oracle {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// NewPrice allows to build a new Price instance
+func NewPrice(denom string, price sdk.Dec, timestamp time.Time) Price {
+	return Price{
+		Denom:     denom,
+		Price:     price,
+		Timestamp: timestamp,
+	}
+}
+
+// Validate returns an error if there is something wrong inside p
+func (p Price) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid price denom: %s", p.Denom)
+	}
+
+	if p.Price.IsNil() || !p.Price.IsPositive() {
+		return fmt.Errorf("invalid price: %s", p.Price)
+	}
+
+	if p.Timestamp.IsZero() {
+		return fmt.Errorf("invalid price timestamp: %s", p.Timestamp)
+	}
+
+	return nil
+}
+
+// IsStale tells whether the price is older than the given max age at the provided time
+func (p Price) IsStale(now time.Time, maxAge time.Duration) bool {
+	return now.After(p.Timestamp.Add(maxAge))
+}
+
+// MustMarshalPrice marshals the given price into a slice of bytes, and panics on error
+func MustMarshalPrice(cdc codec.BinaryMarshaler, price Price) []byte {
+	return cdc.MustMarshalBinaryBare(&price)
+}
+
+// MustUnmarshalPrice unmarshals the given byte slice into a Price, and panics on error
+func MustUnmarshalPrice(cdc codec.BinaryMarshaler, bz []byte) Price {
+	var price Price
+	cdc.MustUnmarshalBinaryBare(bz, &price)
+	return price
+}
+
+// IsPriceDuplicated tells whether the given denom has more than one price inside the provided slice
+func IsPriceDuplicated(denom string, slice []Price) bool {
+	var count = 0
+	for _, price := range slice {
+		if price.Denom == denom {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// MedianPrice returns the median of the given prices, which is the average of the two middle ones
+// when their count is even. The given slice must not be empty
+func MedianPrice(prices []sdk.Dec) sdk.Dec {
+	sorted := make([]sdk.Dec, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return sorted[middle-1].Add(sorted[middle]).QuoInt64(2)
+	}
+	return sorted[middle]
+}
@@ -4,12 +4,14 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -29,11 +31,71 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DrawStatus represents the outcome of a past draw
+type DrawStatus int32
+
+const (
+	// The winners of the draw have been extracted and rewarded
+	DrawStatusSettled DrawStatus = 0
+	// The draw has not been settled due to the lack of participants or revealed
+	// entropy, and its tickets have been moved to the next draw
+	DrawStatusRolledOver DrawStatus = 1
+	// The draw has not been settled after reaching the max number of rollovers,
+	// and its tickets have been refunded
+	DrawStatusRefunded DrawStatus = 2
+	// The draw has been cancelled through governance, and its tickets have been
+	// refunded
+	DrawStatusCancelled DrawStatus = 3
+	// The draw has ended without any ticket sold, and its prize has been kept
+	// for the next draw
+	DrawStatusEmpty DrawStatus = 4
+)
+
+var DrawStatus_name = map[int32]string{
+	0: "DRAW_STATUS_SETTLED",
+	1: "DRAW_STATUS_ROLLED_OVER",
+	2: "DRAW_STATUS_REFUNDED",
+	3: "DRAW_STATUS_CANCELLED",
+	4: "DRAW_STATUS_EMPTY",
+}
+
+var DrawStatus_value = map[string]int32{
+	"DRAW_STATUS_SETTLED":     0,
+	"DRAW_STATUS_ROLLED_OVER": 1,
+	"DRAW_STATUS_REFUNDED":    2,
+	"DRAW_STATUS_CANCELLED":   3,
+	"DRAW_STATUS_EMPTY":       4,
+}
+
+func (x DrawStatus) String() string {
+	return proto.EnumName(DrawStatus_name, int32(x))
+}
+
+func (DrawStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{0}
+}
+
 // Ticket represents a single entry for the next drawn
 type Ticket struct {
 	Id        string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Owner     string    `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Timestamp time.Time `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	DrawId    uint64    `protobuf:"varint,4,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	// Numbers picked by the buyer, sorted in ascending order. Only set for the
+	// tickets of lotto pools
+	Numbers []uint32 `protobuf:"varint,5,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	// Price paid to buy the ticket
+	Price types.Coin `protobuf:"bytes,6,opt,name=price,proto3" json:"price"`
+	// Address that paid for the ticket. It is kept when the ticket is
+	// transferred, and if empty the ticket is considered paid by its owner
+	Payer string `protobuf:"bytes,7,opt,name=payer,proto3" json:"payer,omitempty"`
+	// Part of the price that has been added to the prize of the draw, which is
+	// given back if the draw is refunded
+	PrizeShare github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=prize_share,json=prizeShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prize_share"`
+	// Whether the ticket has been transferred since it was bought. If the draw
+	// is refunded, the prize share of a transferred ticket is sent to its owner
+	// rather than to its payer
+	Transferred bool `protobuf:"varint,9,opt,name=transferred,proto3" json:"transferred,omitempty"`
 }
 
 func (m *Ticket) Reset()         { *m = Ticket{} }
@@ -90,12 +152,56 @@ func (m *Ticket) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+func (m *Ticket) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
+	}
+	return 0
+}
+
+func (m *Ticket) GetNumbers() []uint32 {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
+func (m *Ticket) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Ticket) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *Ticket) GetPrizeShare() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PrizeShare
+	}
+	return nil
+}
+
+func (m *Ticket) GetTransferred() bool {
+	if m != nil {
+		return m.Transferred
+	}
+	return false
+}
+
 // Draw contains the data of the next planned draw
 type Draw struct {
 	Participants uint32                                   `protobuf:"varint,1,opt,name=participants,proto3" json:"participants,omitempty"`
 	TicketsSold  uint32                                   `protobuf:"varint,2,opt,name=tickets_sold,json=ticketsSold,proto3" json:"tickets_sold,omitempty"`
 	Prize        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=prize,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prize"`
 	EndTime      time.Time                                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	Id           uint64                                   `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	PoolId       uint64                                   `protobuf:"varint,6,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
 func (m *Draw) Reset()         { *m = Draw{} }
@@ -159,10 +265,47 @@ func (m *Draw) GetEndTime() time.Time {
 	return time.Time{}
 }
 
-// HistoricalDrawData contains the data of a past draw and its winner
+func (m *Draw) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Draw) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// HistoricalDrawData contains the data of a past draw and its winner, along
+// with the data needed to verify how the winner has been selected
 type HistoricalDrawData struct {
 	Draw          Draw   `protobuf:"bytes,1,opt,name=draw,proto3" json:"draw"`
 	WinningTicket Ticket `protobuf:"bytes,2,opt,name=winning_ticket,json=winningTicket,proto3" json:"winning_ticket"`
+	// Seed used to extract the winning index
+	Seed []byte `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Merkle root of the draw tickets, in the order they were added to the draw
+	TicketsRoot []byte `protobuf:"bytes,4,opt,name=tickets_root,json=ticketsRoot,proto3" json:"tickets_root,omitempty"`
+	// Index of the winning ticket inside the ordered draw tickets list
+	WinningIndex uint32 `protobuf:"varint,5,opt,name=winning_index,json=winningIndex,proto3" json:"winning_index,omitempty"`
+	// Merkle proof of the winning ticket inclusion inside the tickets root
+	WinningTicketProof [][]byte `protobuf:"bytes,6,rep,name=winning_ticket_proof,json=winningTicketProof,proto3" json:"winning_ticket_proof,omitempty"`
+	// All the winners of the draw, sorted by the order in which they have been
+	// extracted
+	Winners []DrawWinner `protobuf:"bytes,7,rep,name=winners,proto3" json:"winners"`
+	// Numbers drawn using the seed, sorted in ascending order. Only set for the
+	// draws of lotto pools
+	WinningNumbers []uint32 `protobuf:"varint,8,rep,packed,name=winning_numbers,json=winningNumbers,proto3" json:"winning_numbers,omitempty"`
+	// Highest number that could be drawn, used to replay the extraction of the
+	// winning numbers
+	MaxNumber uint32 `protobuf:"varint,9,opt,name=max_number,json=maxNumber,proto3" json:"max_number,omitempty"`
+	// Outcome of the draw
+	Status DrawStatus `protobuf:"varint,10,opt,name=status,proto3,enum=cosmicbet.wta.v1beta1.DrawStatus" json:"status,omitempty"`
+	// Number of consecutive rollovers of the draw, including the current one if
+	// the draw has been rolled over
+	Rollovers uint32 `protobuf:"varint,11,opt,name=rollovers,proto3" json:"rollovers,omitempty"`
 }
 
 func (m *HistoricalDrawData) Reset()         { *m = HistoricalDrawData{} }
@@ -212,296 +355,4964 @@ func (m *HistoricalDrawData) GetWinningTicket() Ticket {
 	return Ticket{}
 }
 
-func init() {
-	proto.RegisterType((*Ticket)(nil), "cosmicbet.wta.v1beta1.Ticket")
-	proto.RegisterType((*Draw)(nil), "cosmicbet.wta.v1beta1.Draw")
-	proto.RegisterType((*HistoricalDrawData)(nil), "cosmicbet.wta.v1beta1.HistoricalDrawData")
+func (m *HistoricalDrawData) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("cosmicbet/wta/v1beta1/models.proto", fileDescriptor_351a58cdbed24e72)
+func (m *HistoricalDrawData) GetTicketsRoot() []byte {
+	if m != nil {
+		return m.TicketsRoot
+	}
+	return nil
 }
 
-var fileDescriptor_351a58cdbed24e72 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x31, 0x73, 0xd3, 0x3e,
-	0x1c, 0x8d, 0x92, 0xb4, 0xff, 0x54, 0x6e, 0x3a, 0xe8, 0xfa, 0xbf, 0x33, 0xe1, 0xb0, 0x83, 0x17,
-	0xb2, 0x20, 0xd1, 0x70, 0x2c, 0x2c, 0x1c, 0xa1, 0x03, 0xc7, 0x68, 0x3a, 0xb1, 0xe4, 0x64, 0x4b,
-	0x18, 0x5d, 0x6d, 0xc9, 0x67, 0xa9, 0x18, 0x38, 0x3e, 0x44, 0x37, 0x18, 0x3b, 0xf3, 0x49, 0x3a,
-	0x76, 0x64, 0xa2, 0x5c, 0xb2, 0xb0, 0xf2, 0x0d, 0x38, 0x49, 0x4e, 0x38, 0x38, 0x18, 0x98, 0x6c,
-	0x3d, 0xbf, 0xdf, 0xd3, 0x7b, 0xcf, 0x3f, 0x98, 0xe4, 0x4a, 0x57, 0x22, 0xcf, 0xb8, 0x21, 0xad,
-	0xa1, 0xe4, 0xf5, 0x51, 0xc6, 0x0d, 0x3d, 0x22, 0x95, 0x62, 0xbc, 0xd4, 0xb8, 0x6e, 0x94, 0x51,
-	0xe8, 0xff, 0x2d, 0x07, 0xb7, 0x86, 0xe2, 0x8e, 0x33, 0x39, 0x2c, 0x54, 0xa1, 0x1c, 0x83, 0xd8,
-	0x37, 0x4f, 0x9e, 0xc4, 0x85, 0x52, 0x45, 0xc9, 0x89, 0x3b, 0x65, 0x67, 0x2f, 0x89, 0x11, 0x15,
-	0xd7, 0x86, 0x56, 0x75, 0x47, 0x88, 0xac, 0x9a, 0xd2, 0x24, 0xa3, 0x9a, 0x6f, 0xef, 0xcb, 0x95,
-	0x90, 0xfe, 0x7b, 0xf2, 0x1e, 0xee, 0x9e, 0x88, 0xfc, 0x94, 0x1b, 0x74, 0x00, 0xfb, 0x82, 0x85,
-	0x60, 0x0a, 0x66, 0x7b, 0x69, 0x5f, 0x30, 0x74, 0x08, 0x77, 0x54, 0x2b, 0x79, 0x13, 0xf6, 0x1d,
-	0xe4, 0x0f, 0x68, 0x01, 0xf7, 0xb6, 0x57, 0x84, 0x83, 0x29, 0x98, 0x05, 0xf3, 0x09, 0xf6, 0x26,
-	0xf0, 0xc6, 0x04, 0x3e, 0xd9, 0x30, 0x16, 0xa3, 0xcb, 0x2f, 0x71, 0xef, 0xfc, 0x3a, 0x06, 0xe9,
-	0xcf, 0xb1, 0x87, 0xa3, 0x8f, 0x17, 0x31, 0xf8, 0x76, 0x11, 0x83, 0xe4, 0x3b, 0x80, 0xc3, 0xe3,
-	0x86, 0xb6, 0x28, 0x81, 0xfb, 0x35, 0x6d, 0x8c, 0xc8, 0x45, 0x4d, 0xa5, 0xd1, 0xce, 0xc6, 0x38,
-	0xfd, 0x05, 0x43, 0xb7, 0xe1, 0xbe, 0x71, 0x56, 0xf5, 0x52, 0xab, 0x92, 0x39, 0x5f, 0xe3, 0x34,
-	0xe8, 0xb0, 0xe7, 0xaa, 0x64, 0x88, 0xc2, 0x9d, 0xba, 0x11, 0xef, 0x78, 0x38, 0x98, 0x0e, 0x66,
-	0xc1, 0xfc, 0x06, 0xf6, 0xe9, 0xb1, 0x4d, 0xbf, 0x69, 0x12, 0x3f, 0x51, 0x42, 0x2e, 0xee, 0x59,
-	0x63, 0x9f, 0xae, 0xe3, 0x59, 0x21, 0xcc, 0xab, 0xb3, 0x0c, 0xe7, 0xaa, 0x22, 0x5d, 0x55, 0xfe,
-	0x71, 0x57, 0xb3, 0x53, 0x62, 0xde, 0xd6, 0x5c, 0xbb, 0x01, 0x9d, 0x7a, 0x65, 0xf4, 0x08, 0x8e,
-	0xb8, 0x64, 0x4b, 0x9b, 0x26, 0x1c, 0xfe, 0x43, 0xfe, 0xff, 0xb8, 0x64, 0x16, 0x4f, 0x3e, 0x00,
-	0x88, 0x9e, 0x0a, 0x6d, 0x54, 0x23, 0x72, 0x5a, 0xda, 0xf4, 0xc7, 0xd4, 0x50, 0xf4, 0x00, 0x0e,
-	0x59, 0x43, 0x5b, 0x97, 0x3c, 0x98, 0xdf, 0xc4, 0x7f, 0xdc, 0x02, 0x6c, 0xe9, 0x8b, 0xa1, 0x15,
-	0x4d, 0x1d, 0x1d, 0x3d, 0x83, 0x07, 0xad, 0x90, 0x52, 0xc8, 0x62, 0xe9, 0x8b, 0x70, 0xb5, 0x04,
-	0xf3, 0x5b, 0x7f, 0x11, 0xf0, 0x3f, 0xbb, 0x93, 0x18, 0x77, 0xa3, 0x1d, 0xf8, 0xf8, 0x72, 0x15,
-	0x81, 0xab, 0x55, 0x04, 0xbe, 0xae, 0x22, 0x70, 0xbe, 0x8e, 0x7a, 0x57, 0xeb, 0xa8, 0xf7, 0x79,
-	0x1d, 0xf5, 0x5e, 0xdc, 0xf9, 0xad, 0x25, 0xbf, 0xc2, 0x25, 0x67, 0x05, 0x6f, 0xc8, 0x1b, 0xb7,
-	0xcb, 0xae, 0xaa, 0x6c, 0xd7, 0x75, 0x70, 0xff, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xfb,
-	0x7f, 0x33, 0xe9, 0x02, 0x00, 0x00,
+func (m *HistoricalDrawData) GetWinningIndex() uint32 {
+	if m != nil {
+		return m.WinningIndex
+	}
+	return 0
 }
 
-func (this *Ticket) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *HistoricalDrawData) GetWinningTicketProof() [][]byte {
+	if m != nil {
+		return m.WinningTicketProof
 	}
+	return nil
+}
 
-	that1, ok := that.(*Ticket)
-	if !ok {
-		that2, ok := that.(Ticket)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *HistoricalDrawData) GetWinners() []DrawWinner {
+	if m != nil {
+		return m.Winners
 	}
-	if this.Id != that1.Id {
-		return false
+	return nil
+}
+
+func (m *HistoricalDrawData) GetWinningNumbers() []uint32 {
+	if m != nil {
+		return m.WinningNumbers
 	}
-	if this.Owner != that1.Owner {
-		return false
+	return nil
+}
+
+func (m *HistoricalDrawData) GetMaxNumber() uint32 {
+	if m != nil {
+		return m.MaxNumber
 	}
-	if !this.Timestamp.Equal(that1.Timestamp) {
-		return false
+	return 0
+}
+
+func (m *HistoricalDrawData) GetStatus() DrawStatus {
+	if m != nil {
+		return m.Status
 	}
-	return true
+	return DrawStatusSettled
 }
-func (m *Ticket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *HistoricalDrawData) GetRollovers() uint32 {
+	if m != nil {
+		return m.Rollovers
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Ticket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DrawWinner contains the data of a single winning ticket of a draw
+type DrawWinner struct {
+	// Index of the payout table tier won by the ticket, starting from 0
+	Tier   uint32 `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	Ticket Ticket `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket"`
+	// Index of the ticket inside the ordered draw tickets list
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// Merkle proof of the ticket inclusion inside the tickets root
+	Proof [][]byte `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+	// Amount won by the ticket owner
+	Prize github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=prize,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prize"`
+	// Amount of winning numbers matched by the ticket. Only set for the draws of
+	// lotto pools
+	Matches uint32 `protobuf:"varint,6,opt,name=matches,proto3" json:"matches,omitempty"`
 }
 
-func (m *Ticket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
+func (m *DrawWinner) Reset()         { *m = DrawWinner{} }
+func (m *DrawWinner) String() string { return proto.CompactTextString(m) }
+func (*DrawWinner) ProtoMessage()    {}
+func (*DrawWinner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{3}
+}
+func (m *DrawWinner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrawWinner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrawWinner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n1
-	i = encodeVarintModels(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *DrawWinner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrawWinner.Merge(m, src)
+}
+func (m *DrawWinner) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrawWinner) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrawWinner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrawWinner proto.InternalMessageInfo
+
+func (m *DrawWinner) GetTier() uint32 {
+	if m != nil {
+		return m.Tier
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *DrawWinner) GetTicket() Ticket {
+	if m != nil {
+		return m.Ticket
 	}
-	return len(dAtA) - i, nil
+	return Ticket{}
 }
 
-func (m *Draw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DrawWinner) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Draw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DrawWinner) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
 }
 
-func (m *Draw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
+func (m *DrawWinner) GetPrize() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Prize
 	}
-	i -= n2
-	i = encodeVarintModels(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Prize) > 0 {
-		for iNdEx := len(m.Prize) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prize[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *DrawWinner) GetMatches() uint32 {
+	if m != nil {
+		return m.Matches
+	}
+	return 0
+}
+
+// EntropyCommitment contains the entropy committed by a validator for the
+// current draw, along with the revealed value once it has been disclosed
+type EntropyCommitment struct {
+	Validator  string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Entropy    []byte `protobuf:"bytes,3,opt,name=entropy,proto3" json:"entropy,omitempty"`
+	PoolId     uint64 `protobuf:"varint,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *EntropyCommitment) Reset()         { *m = EntropyCommitment{} }
+func (m *EntropyCommitment) String() string { return proto.CompactTextString(m) }
+func (*EntropyCommitment) ProtoMessage()    {}
+func (*EntropyCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{4}
+}
+func (m *EntropyCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntropyCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntropyCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TicketsSold != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TicketsSold))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EntropyCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntropyCommitment.Merge(m, src)
+}
+func (m *EntropyCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntropyCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntropyCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntropyCommitment proto.InternalMessageInfo
+
+func (m *EntropyCommitment) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	if m.Participants != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Participants))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EntropyCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *HistoricalDrawData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EntropyCommitment) GetEntropy() []byte {
+	if m != nil {
+		return m.Entropy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HistoricalDrawData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EntropyCommitment) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *HistoricalDrawData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.WinningTicket.MarshalToSizedBuffer(dAtA[:i])
+// MissedReveals contains the number of entropy reveals a validator has missed
+type MissedReveals struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Count     uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MissedReveals) Reset()         { *m = MissedReveals{} }
+func (m *MissedReveals) String() string { return proto.CompactTextString(m) }
+func (*MissedReveals) ProtoMessage()    {}
+func (*MissedReveals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{5}
+}
+func (m *MissedReveals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedReveals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedReveals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintModels(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Draw.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MissedReveals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedReveals.Merge(m, src)
+}
+func (m *MissedReveals) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedReveals) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedReveals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedReveals proto.InternalMessageInfo
+
+func (m *MissedReveals) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MissedReveals) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Pool contains the parameters of a lottery pool, which runs its own sequence
+// of draws independently from the other pools
+type Pool struct {
+	Id                 uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DistributionParams DistributionParams `protobuf:"bytes,2,opt,name=distribution_params,json=distributionParams,proto3" json:"distribution_params"`
+	DrawParams         DrawParams         `protobuf:"bytes,3,opt,name=draw_params,json=drawParams,proto3" json:"draw_params"`
+	TicketParams       TicketParams       `protobuf:"bytes,4,opt,name=ticket_params,json=ticketParams,proto3" json:"ticket_params"`
+	PrizeParams        PrizeParams        `protobuf:"bytes,5,opt,name=prize_params,json=prizeParams,proto3" json:"prize_params"`
+	GameParams         GameParams         `protobuf:"bytes,6,opt,name=game_params,json=gameParams,proto3" json:"game_params"`
+	RolloverParams     RolloverParams     `protobuf:"bytes,7,opt,name=rollover_params,json=rolloverParams,proto3" json:"rollover_params"`
+}
+
+func (m *Pool) Reset()         { *m = Pool{} }
+func (m *Pool) String() string { return proto.CompactTextString(m) }
+func (*Pool) ProtoMessage()    {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{6}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintModels(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
 }
 
-func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModels(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func (m *Pool) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Ticket) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Pool) GetDistributionParams() DistributionParams {
+	if m != nil {
+		return m.DistributionParams
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	return DistributionParams{}
+}
+
+func (m *Pool) GetDrawParams() DrawParams {
+	if m != nil {
+		return m.DrawParams
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	return DrawParams{}
+}
+
+func (m *Pool) GetTicketParams() TicketParams {
+	if m != nil {
+		return m.TicketParams
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovModels(uint64(l))
-	return n
+	return TicketParams{}
 }
 
-func (m *Draw) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Pool) GetPrizeParams() PrizeParams {
+	if m != nil {
+		return m.PrizeParams
 	}
-	var l int
-	_ = l
-	if m.Participants != 0 {
-		n += 1 + sovModels(uint64(m.Participants))
+	return PrizeParams{}
+}
+
+func (m *Pool) GetGameParams() GameParams {
+	if m != nil {
+		return m.GameParams
 	}
-	if m.TicketsSold != 0 {
-		n += 1 + sovModels(uint64(m.TicketsSold))
+	return GameParams{}
+}
+
+func (m *Pool) GetRolloverParams() RolloverParams {
+	if m != nil {
+		return m.RolloverParams
 	}
-	if len(m.Prize) > 0 {
-		for _, e := range m.Prize {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
+	return RolloverParams{}
+}
+
+// PruningDraw contains the number of tickets of a past draw that still need to
+// be removed from the store
+type PruningDraw struct {
+	DrawId           uint64 `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	RemainingTickets uint32 `protobuf:"varint,2,opt,name=remaining_tickets,json=remainingTickets,proto3" json:"remaining_tickets,omitempty"`
+}
+
+func (m *PruningDraw) Reset()         { *m = PruningDraw{} }
+func (m *PruningDraw) String() string { return proto.CompactTextString(m) }
+func (*PruningDraw) ProtoMessage()    {}
+func (*PruningDraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{7}
+}
+func (m *PruningDraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruningDraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruningDraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruningDraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruningDraw.Merge(m, src)
+}
+func (m *PruningDraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruningDraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruningDraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruningDraw proto.InternalMessageInfo
+
+func (m *PruningDraw) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
+	}
+	return 0
+}
+
+func (m *PruningDraw) GetRemainingTickets() uint32 {
+	if m != nil {
+		return m.RemainingTickets
+	}
+	return 0
+}
+
+// Exclusion contains the self exclusion of an address, which cannot buy any
+// ticket until the exclusion expires
+type Exclusion struct {
+	Address string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Until   time.Time `protobuf:"bytes,2,opt,name=until,proto3,stdtime" json:"until" yaml:"until"`
+}
+
+func (m *Exclusion) Reset()         { *m = Exclusion{} }
+func (m *Exclusion) String() string { return proto.CompactTextString(m) }
+func (*Exclusion) ProtoMessage()    {}
+func (*Exclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{8}
+}
+func (m *Exclusion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Exclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Exclusion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Exclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Exclusion.Merge(m, src)
+}
+func (m *Exclusion) XXX_Size() int {
+	return m.Size()
+}
+func (m *Exclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Exclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Exclusion proto.InternalMessageInfo
+
+func (m *Exclusion) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Exclusion) GetUntil() time.Time {
+	if m != nil {
+		return m.Until
+	}
+	return time.Time{}
+}
+
+// SpendingLimit contains the max amount that an address can spend buying
+// tickets within a rolling window
+type SpendingLimit struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// Max amount that can be spent within the window. The denoms that are not
+	// included are not limited
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	// Duration of the rolling window
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+	// Raised limit that replaces the current one once its cooling-off delay has
+	// passed, if any
+	Pending *PendingSpendingLimit `protobuf:"bytes,4,opt,name=pending,proto3" json:"pending,omitempty" yaml:"pending"`
+}
+
+func (m *SpendingLimit) Reset()         { *m = SpendingLimit{} }
+func (m *SpendingLimit) String() string { return proto.CompactTextString(m) }
+func (*SpendingLimit) ProtoMessage()    {}
+func (*SpendingLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{9}
+}
+func (m *SpendingLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendingLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendingLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendingLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendingLimit.Merge(m, src)
+}
+func (m *SpendingLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendingLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendingLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendingLimit proto.InternalMessageInfo
+
+func (m *SpendingLimit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SpendingLimit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SpendingLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *SpendingLimit) GetPending() *PendingSpendingLimit {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+// PendingSpendingLimit contains a raised spending limit waiting for its
+// cooling-off delay to pass. An empty amount removes the limit
+type PendingSpendingLimit struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	Window time.Duration                            `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+	// Time after which the limit replaces the current one
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time" yaml:"effective_time"`
+}
+
+func (m *PendingSpendingLimit) Reset()         { *m = PendingSpendingLimit{} }
+func (m *PendingSpendingLimit) String() string { return proto.CompactTextString(m) }
+func (*PendingSpendingLimit) ProtoMessage()    {}
+func (*PendingSpendingLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{10}
+}
+func (m *PendingSpendingLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSpendingLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSpendingLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSpendingLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSpendingLimit.Merge(m, src)
+}
+func (m *PendingSpendingLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSpendingLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSpendingLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSpendingLimit proto.InternalMessageInfo
+
+func (m *PendingSpendingLimit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PendingSpendingLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *PendingSpendingLimit) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// SpendRecord contains the amount spent buying tickets by an address with a
+// limited spending inside the block having the given time
+type SpendRecord struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Time    time.Time                                `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *SpendRecord) Reset()         { *m = SpendRecord{} }
+func (m *SpendRecord) String() string { return proto.CompactTextString(m) }
+func (*SpendRecord) ProtoMessage()    {}
+func (*SpendRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{11}
+}
+func (m *SpendRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendRecord.Merge(m, src)
+}
+func (m *SpendRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendRecord proto.InternalMessageInfo
+
+func (m *SpendRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SpendRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SpendRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// DrawFees contains the total fee of the tickets of a draw that has been sent
+// to a single destination
+type DrawFees struct {
+	DrawId      uint64                                   `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	Destination FeeDestination                           `protobuf:"varint,2,opt,name=destination,proto3,enum=cosmicbet.wta.v1beta1.FeeDestination" json:"destination,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *DrawFees) Reset()         { *m = DrawFees{} }
+func (m *DrawFees) String() string { return proto.CompactTextString(m) }
+func (*DrawFees) ProtoMessage()    {}
+func (*DrawFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{12}
+}
+func (m *DrawFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrawFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrawFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrawFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrawFees.Merge(m, src)
+}
+func (m *DrawFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrawFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrawFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrawFees proto.InternalMessageInfo
+
+func (m *DrawFees) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
+	}
+	return 0
+}
+
+func (m *DrawFees) GetDestination() FeeDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return FeeDestinationFeeCollector
+}
+
+func (m *DrawFees) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// PricePost contains the price of a denomination posted by a single oracle
+type PricePost struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Oracle string `protobuf:"bytes,2,opt,name=oracle,proto3" json:"oracle,omitempty" yaml:"oracle"`
+	// Value of a single unit of the denomination, expressed in the reference
+	// unit of the price feed
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// Time of the block in which the price has been posted
+	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+}
+
+func (m *PricePost) Reset()         { *m = PricePost{} }
+func (m *PricePost) String() string { return proto.CompactTextString(m) }
+func (*PricePost) ProtoMessage()    {}
+func (*PricePost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{13}
+}
+func (m *PricePost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricePost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricePost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricePost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricePost.Merge(m, src)
+}
+func (m *PricePost) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricePost) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricePost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricePost proto.InternalMessageInfo
+
+func (m *PricePost) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PricePost) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
+func (m *PricePost) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// Price contains the median of the prices of a denomination posted by the
+// oracles, computed when the last one of them has been posted
+type Price struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// Value of a single unit of the denomination, expressed in the reference
+	// unit of the price feed
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// Time of the block in which the median price has been computed
+	Timestamp time.Time `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+}
+
+func (m *Price) Reset()         { *m = Price{} }
+func (m *Price) String() string { return proto.CompactTextString(m) }
+func (*Price) ProtoMessage()    {}
+func (*Price) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{14}
+}
+func (m *Price) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Price) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Price.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Price) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Price.Merge(m, src)
+}
+func (m *Price) XXX_Size() int {
+	return m.Size()
+}
+func (m *Price) XXX_DiscardUnknown() {
+	xxx_messageInfo_Price.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Price proto.InternalMessageInfo
+
+func (m *Price) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Price) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// TicketsMove contains the id of a rolled over draw whose tickets are still
+// being moved to a new draw, along with the id of such draw
+type TicketsMove struct {
+	DrawId    uint64 `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	NewDrawId uint64 `protobuf:"varint,2,opt,name=new_draw_id,json=newDrawId,proto3" json:"new_draw_id,omitempty"`
+}
+
+func (m *TicketsMove) Reset()         { *m = TicketsMove{} }
+func (m *TicketsMove) String() string { return proto.CompactTextString(m) }
+func (*TicketsMove) ProtoMessage()    {}
+func (*TicketsMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_351a58cdbed24e72, []int{15}
+}
+func (m *TicketsMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TicketsMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TicketsMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TicketsMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketsMove.Merge(m, src)
+}
+func (m *TicketsMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *TicketsMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketsMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketsMove proto.InternalMessageInfo
+
+func (m *TicketsMove) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
+	}
+	return 0
+}
+
+func (m *TicketsMove) GetNewDrawId() uint64 {
+	if m != nil {
+		return m.NewDrawId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmicbet.wta.v1beta1.DrawStatus", DrawStatus_name, DrawStatus_value)
+	proto.RegisterType((*Ticket)(nil), "cosmicbet.wta.v1beta1.Ticket")
+	proto.RegisterType((*Draw)(nil), "cosmicbet.wta.v1beta1.Draw")
+	proto.RegisterType((*HistoricalDrawData)(nil), "cosmicbet.wta.v1beta1.HistoricalDrawData")
+	proto.RegisterType((*DrawWinner)(nil), "cosmicbet.wta.v1beta1.DrawWinner")
+	proto.RegisterType((*EntropyCommitment)(nil), "cosmicbet.wta.v1beta1.EntropyCommitment")
+	proto.RegisterType((*MissedReveals)(nil), "cosmicbet.wta.v1beta1.MissedReveals")
+	proto.RegisterType((*Pool)(nil), "cosmicbet.wta.v1beta1.Pool")
+	proto.RegisterType((*PruningDraw)(nil), "cosmicbet.wta.v1beta1.PruningDraw")
+	proto.RegisterType((*Exclusion)(nil), "cosmicbet.wta.v1beta1.Exclusion")
+	proto.RegisterType((*SpendingLimit)(nil), "cosmicbet.wta.v1beta1.SpendingLimit")
+	proto.RegisterType((*PendingSpendingLimit)(nil), "cosmicbet.wta.v1beta1.PendingSpendingLimit")
+	proto.RegisterType((*SpendRecord)(nil), "cosmicbet.wta.v1beta1.SpendRecord")
+	proto.RegisterType((*DrawFees)(nil), "cosmicbet.wta.v1beta1.DrawFees")
+	proto.RegisterType((*PricePost)(nil), "cosmicbet.wta.v1beta1.PricePost")
+	proto.RegisterType((*Price)(nil), "cosmicbet.wta.v1beta1.Price")
+	proto.RegisterType((*TicketsMove)(nil), "cosmicbet.wta.v1beta1.TicketsMove")
+}
+
+func init() {
+	proto.RegisterFile("cosmicbet/wta/v1beta1/models.proto", fileDescriptor_351a58cdbed24e72)
+}
+
+var fileDescriptor_351a58cdbed24e72 = []byte{
+	// 1688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xf2, 0x53, 0x7c, 0x24, 0x65, 0x69, 0x2c, 0x57, 0x6b, 0x36, 0x21, 0xe9, 0x0d, 0x9a,
+	0x28, 0x49, 0x4b, 0x26, 0x2e, 0x7c, 0xa8, 0x0b, 0xb4, 0x90, 0x44, 0xda, 0x71, 0x2a, 0xdb, 0xc2,
+	0x92, 0x49, 0x90, 0x5e, 0xd8, 0xe1, 0xce, 0x88, 0x1e, 0x64, 0x77, 0x87, 0xd8, 0x1d, 0x8a, 0x52,
+	0xee, 0x2d, 0x5a, 0xb5, 0x28, 0x82, 0x9e, 0x82, 0x02, 0x02, 0x02, 0xf4, 0xd6, 0xbf, 0xa2, 0xc7,
+	0x1c, 0xd3, 0x5b, 0x51, 0x14, 0x4e, 0x61, 0x5f, 0x7a, 0xce, 0xb1, 0x97, 0x16, 0xf3, 0xb1, 0xe4,
+	0xd2, 0x09, 0x25, 0x0b, 0x70, 0xda, 0x93, 0x38, 0x6f, 0xde, 0xfb, 0xcd, 0x7b, 0xbf, 0xf7, 0xde,
+	0xbc, 0x59, 0x81, 0xe3, 0xf1, 0x38, 0x60, 0xde, 0x90, 0x8a, 0xf6, 0x54, 0xe0, 0xf6, 0xd1, 0xdb,
+	0x43, 0x2a, 0xf0, 0xdb, 0xed, 0x80, 0x13, 0xea, 0xc7, 0xad, 0x71, 0xc4, 0x05, 0x47, 0xd7, 0x66,
+	0x3a, 0xad, 0xa9, 0xc0, 0x2d, 0xa3, 0x53, 0xdb, 0x1c, 0xf1, 0x11, 0x57, 0x1a, 0x6d, 0xf9, 0x4b,
+	0x2b, 0xd7, 0x1a, 0x23, 0xce, 0x47, 0x3e, 0x6d, 0xab, 0xd5, 0x70, 0x72, 0xd8, 0x16, 0x2c, 0xa0,
+	0xb1, 0xc0, 0xc1, 0xd8, 0x28, 0xd4, 0x9f, 0x55, 0x20, 0x93, 0x08, 0x0b, 0xc6, 0xc3, 0x64, 0x5f,
+	0x9e, 0xc6, 0xe3, 0xf6, 0x10, 0xc7, 0x74, 0xe6, 0x8f, 0xc7, 0x59, 0xb2, 0xbf, 0xc4, 0xe3, 0x31,
+	0x8e, 0x70, 0x60, 0x3c, 0x76, 0x7e, 0x97, 0x85, 0x42, 0x9f, 0x79, 0x1f, 0x51, 0x81, 0xd6, 0x20,
+	0xc3, 0x88, 0x6d, 0x35, 0xad, 0xed, 0x92, 0x9b, 0x61, 0x04, 0x6d, 0x42, 0x9e, 0x4f, 0x43, 0x1a,
+	0xd9, 0x19, 0x25, 0xd2, 0x0b, 0xb4, 0x0b, 0xa5, 0x99, 0x9f, 0x76, 0xb6, 0x69, 0x6d, 0x97, 0x6f,
+	0xd6, 0x5a, 0xda, 0xd1, 0x56, 0xe2, 0x68, 0xab, 0x9f, 0x68, 0xec, 0xae, 0x7e, 0xfe, 0xb8, 0xb1,
+	0xf2, 0xc9, 0x97, 0x0d, 0xcb, 0x9d, 0x9b, 0xa1, 0x2d, 0x28, 0x92, 0x08, 0x4f, 0x07, 0x8c, 0xd8,
+	0xb9, 0xa6, 0xb5, 0x9d, 0x73, 0x0b, 0x72, 0x79, 0x8f, 0x20, 0x1b, 0x8a, 0xe1, 0x24, 0x18, 0xd2,
+	0x28, 0xb6, 0xf3, 0xcd, 0xec, 0x76, 0xd5, 0x4d, 0x96, 0xe8, 0x16, 0xe4, 0xc7, 0x11, 0xf3, 0xa8,
+	0x5d, 0x50, 0x47, 0x5e, 0x6f, 0xe9, 0xd8, 0x5b, 0x32, 0xf6, 0x84, 0xe7, 0xd6, 0x1e, 0x67, 0xe1,
+	0x6e, 0x4e, 0x9e, 0xe8, 0x6a, 0x6d, 0x19, 0xc3, 0x18, 0x9f, 0xd0, 0xc8, 0x2e, 0xea, 0x18, 0xd4,
+	0x02, 0xf9, 0x50, 0x1e, 0x47, 0xec, 0x63, 0x3a, 0x88, 0x1f, 0xe1, 0x88, 0xda, 0xab, 0xcd, 0xec,
+	0xf9, 0x90, 0x6f, 0x49, 0xc8, 0x3f, 0x7f, 0xd9, 0xd8, 0x1e, 0x31, 0xf1, 0x68, 0x32, 0x6c, 0x79,
+	0x3c, 0x68, 0x1b, 0xee, 0xf5, 0x9f, 0x1f, 0xc4, 0xe4, 0xa3, 0xb6, 0x38, 0x19, 0xd3, 0x58, 0x19,
+	0xc4, 0x2e, 0x28, 0xfc, 0x9e, 0x84, 0x47, 0x4d, 0x28, 0x8b, 0x08, 0x87, 0xf1, 0x21, 0x8d, 0x22,
+	0x4a, 0xec, 0x52, 0xd3, 0xda, 0x5e, 0x75, 0xd3, 0xa2, 0xdb, 0xab, 0x9f, 0x7e, 0xd6, 0xb0, 0xfe,
+	0xf5, 0x59, 0xc3, 0x72, 0xfe, 0x98, 0x81, 0x5c, 0x27, 0xc2, 0x53, 0xe4, 0x40, 0x65, 0x8c, 0x23,
+	0xc1, 0x3c, 0x36, 0xc6, 0xa1, 0x88, 0x55, 0x5a, 0xaa, 0xee, 0x82, 0x0c, 0xdd, 0x80, 0x8a, 0x50,
+	0xa9, 0x8b, 0x07, 0x31, 0xf7, 0x89, 0xca, 0x53, 0xd5, 0x2d, 0x1b, 0x59, 0x8f, 0xfb, 0x04, 0x61,
+	0x45, 0xdb, 0xc7, 0xd4, 0xce, 0xbe, 0xf8, 0x18, 0x35, 0x32, 0xfa, 0x29, 0xac, 0xd2, 0x90, 0x0c,
+	0x64, 0x76, 0xed, 0xdc, 0x25, 0xea, 0xa1, 0x48, 0x43, 0x22, 0xe5, 0xa6, 0xee, 0xf2, 0xaa, 0x10,
+	0x64, 0xdd, 0x6d, 0x41, 0x71, 0xcc, 0xb9, 0x2f, 0xab, 0xa3, 0xa0, 0xab, 0x43, 0x2e, 0xef, 0x11,
+	0xe7, 0x37, 0x39, 0x40, 0xef, 0xb0, 0x58, 0xf0, 0x88, 0x79, 0xd8, 0x97, 0x34, 0x75, 0xb0, 0xc0,
+	0xe8, 0x16, 0xe4, 0x64, 0xf9, 0x28, 0x8a, 0xca, 0x37, 0xbf, 0xdb, 0xfa, 0xc6, 0x1e, 0x6c, 0x49,
+	0x75, 0x53, 0x1b, 0x4a, 0x1d, 0xbd, 0x0b, 0x6b, 0x53, 0x16, 0x86, 0x2c, 0x1c, 0x0d, 0x34, 0x63,
+	0x8a, 0xbf, 0xf2, 0xcd, 0x97, 0x97, 0x00, 0xe8, 0x2e, 0x31, 0x10, 0x55, 0x63, 0x6a, 0x5a, 0x07,
+	0x41, 0x2e, 0xa6, 0x94, 0xa8, 0x7e, 0xa8, 0xb8, 0xea, 0x77, 0x3a, 0x3b, 0x11, 0xe7, 0x42, 0x71,
+	0x53, 0x99, 0x65, 0xc7, 0xe5, 0x5c, 0xa0, 0x57, 0x20, 0xc1, 0x19, 0xb0, 0x90, 0xd0, 0x63, 0x45,
+	0x42, 0xd5, 0xad, 0x18, 0xe1, 0x3d, 0x29, 0x43, 0x6f, 0xc1, 0xe6, 0xa2, 0x9f, 0x83, 0x71, 0xc4,
+	0xf9, 0xa1, 0x5d, 0x68, 0x66, 0xb7, 0x2b, 0x2e, 0x5a, 0x70, 0xe4, 0x40, 0xee, 0xa0, 0x1d, 0x28,
+	0x4a, 0xa9, 0xec, 0xa2, 0xa2, 0x4a, 0xfb, 0x8d, 0x73, 0x38, 0xf9, 0x40, 0x69, 0x9a, 0xb0, 0x12,
+	0x3b, 0xf4, 0x1a, 0x5c, 0x49, 0x0e, 0x4d, 0x1a, 0x72, 0x55, 0x35, 0x64, 0xc2, 0xd9, 0x03, 0xd3,
+	0x97, 0x2f, 0x03, 0x04, 0xf8, 0xd8, 0x28, 0xa9, 0xda, 0xae, 0xba, 0xa5, 0x00, 0x1f, 0xeb, 0x7d,
+	0xf4, 0x23, 0x28, 0xc4, 0x02, 0x8b, 0x49, 0x6c, 0x43, 0xd3, 0xda, 0x5e, 0x3b, 0xd7, 0x93, 0x9e,
+	0x52, 0x74, 0x8d, 0x01, 0x7a, 0x09, 0x4a, 0x11, 0xf7, 0x7d, 0x7e, 0x24, 0x0f, 0x2f, 0x6b, 0xe0,
+	0x99, 0xc0, 0xf9, 0x55, 0x06, 0x60, 0xee, 0xbe, 0x4c, 0x80, 0x60, 0x34, 0x32, 0x6d, 0xa2, 0x7e,
+	0xa3, 0x1f, 0x43, 0xe1, 0xf2, 0x89, 0x35, 0x26, 0xf2, 0xe2, 0xd0, 0x29, 0xc9, 0x2a, 0x44, 0xbd,
+	0x90, 0x52, 0x4d, 0x7e, 0x4e, 0x91, 0xaf, 0x17, 0xf3, 0x26, 0xcb, 0x7f, 0x6b, 0x4d, 0x66, 0x43,
+	0x31, 0xc0, 0xc2, 0x7b, 0x44, 0x63, 0xd5, 0x13, 0x55, 0x37, 0x59, 0x3a, 0xbf, 0xb7, 0x60, 0xa3,
+	0x1b, 0x8a, 0x88, 0x8f, 0x4f, 0xf6, 0x78, 0x10, 0x30, 0x11, 0xd0, 0x50, 0x48, 0xf2, 0x8e, 0xb0,
+	0xcf, 0x08, 0x16, 0x3c, 0x32, 0x57, 0xfa, 0x5c, 0x80, 0xea, 0x00, 0xde, 0x4c, 0x57, 0xb1, 0x53,
+	0x71, 0x53, 0x12, 0x79, 0x1a, 0xd5, 0x90, 0xa6, 0xa2, 0x93, 0x65, 0xba, 0x37, 0x73, 0xe9, 0xde,
+	0x4c, 0x5d, 0x61, 0x7b, 0x50, 0xbd, 0xcf, 0xe2, 0x98, 0x12, 0x97, 0x1e, 0x51, 0xec, 0xc7, 0x17,
+	0xf8, 0xb2, 0x09, 0x79, 0x8f, 0x4f, 0x8c, 0x1b, 0x39, 0x57, 0x2f, 0x9c, 0xff, 0x64, 0x21, 0x77,
+	0xc0, 0xb9, 0x9f, 0x1a, 0x4a, 0xfa, 0x72, 0xf8, 0x05, 0x5c, 0x25, 0x2c, 0x16, 0x11, 0x1b, 0x4e,
+	0xe4, 0x24, 0x1c, 0xe8, 0x61, 0x66, 0x32, 0xfc, 0xfa, 0xb2, 0xea, 0x4a, 0x59, 0x1c, 0x28, 0x03,
+	0x93, 0x6d, 0x44, 0xbe, 0xb6, 0x83, 0xde, 0x81, 0xb2, 0x1a, 0x4e, 0x06, 0x59, 0x8f, 0xb8, 0xf3,
+	0xea, 0x76, 0x01, 0x11, 0xc8, 0x4c, 0x82, 0x1e, 0x40, 0x35, 0xe9, 0x58, 0x8d, 0xa5, 0xaf, 0xc7,
+	0x57, 0xce, 0xad, 0xc3, 0x05, 0xb4, 0x8a, 0x48, 0xc9, 0xd0, 0xcf, 0xa0, 0xa2, 0xc7, 0x96, 0x81,
+	0xcb, 0x2b, 0x38, 0x67, 0x09, 0xdc, 0x81, 0x54, 0x5d, 0x40, 0x2b, 0x8f, 0xe7, 0x22, 0x19, 0xe6,
+	0x08, 0x07, 0x33, 0xac, 0xc2, 0xb9, 0x61, 0xde, 0xc5, 0xc1, 0x22, 0x14, 0x8c, 0x66, 0x12, 0xd4,
+	0x87, 0x2b, 0x49, 0x5f, 0x26, 0x68, 0x45, 0x85, 0xf6, 0xbd, 0x25, 0x68, 0xae, 0xd1, 0x5e, 0x40,
+	0x5c, 0x8b, 0x16, 0xa4, 0x4e, 0x0f, 0xca, 0x07, 0xd1, 0x44, 0x5e, 0x35, 0x6a, 0x1e, 0xa6, 0x9e,
+	0x0c, 0xd6, 0xc2, 0x93, 0xe1, 0x4d, 0xd8, 0x88, 0x68, 0x80, 0x59, 0xea, 0x82, 0x8c, 0xcd, 0x24,
+	0x5c, 0x9f, 0x6d, 0x68, 0x6a, 0x63, 0xe7, 0x97, 0x16, 0x94, 0xba, 0xc7, 0x9e, 0x3f, 0x89, 0x19,
+	0x0f, 0xd1, 0xf7, 0xa1, 0x88, 0x09, 0x89, 0x68, 0xac, 0xc7, 0x6b, 0x69, 0x17, 0x7d, 0xf5, 0xb8,
+	0xb1, 0x76, 0x82, 0x03, 0xff, 0xb6, 0x63, 0x36, 0x1c, 0x37, 0x51, 0x41, 0xef, 0x42, 0x7e, 0x12,
+	0x0a, 0xe6, 0xdb, 0x99, 0x0b, 0x87, 0x9c, 0x2d, 0x23, 0xfa, 0xea, 0x71, 0xa3, 0xa2, 0xb1, 0x94,
+	0x99, 0xa3, 0x86, 0x9e, 0x86, 0x70, 0xfe, 0x91, 0x81, 0x6a, 0x6f, 0x4c, 0x43, 0xc2, 0xc2, 0xd1,
+	0x3e, 0x0b, 0x98, 0xb8, 0xa4, 0x2f, 0x02, 0x0a, 0x38, 0x30, 0x5d, 0x73, 0xc1, 0x95, 0xb3, 0x63,
+	0x7c, 0xa9, 0x1a, 0x2c, 0x65, 0xe6, 0x5c, 0xea, 0x0e, 0x32, 0x67, 0xa1, 0x7d, 0x28, 0x4c, 0x59,
+	0x48, 0xf8, 0xd4, 0x34, 0xc5, 0xf5, 0xaf, 0x51, 0xd0, 0x31, 0x0f, 0xd4, 0xdd, 0xeb, 0x8b, 0xa7,
+	0x6a, 0x33, 0xe7, 0x53, 0x49, 0x81, 0xc1, 0x40, 0x1f, 0x42, 0xd1, 0x30, 0x60, 0xfa, 0xe2, 0xcd,
+	0x65, 0x85, 0xac, 0xb5, 0x16, 0xf8, 0x4a, 0xd3, 0x63, 0xe4, 0x8e, 0x9b, 0xe0, 0x39, 0x7f, 0xc9,
+	0xc0, 0xe6, 0x37, 0x59, 0xa5, 0x78, 0xb3, 0xfe, 0x2f, 0xbc, 0x65, 0x5e, 0x00, 0x6f, 0x04, 0xd6,
+	0xe8, 0xe1, 0x21, 0xf5, 0x04, 0x3b, 0xa2, 0xfa, 0xd5, 0x75, 0xf1, 0x2b, 0xfc, 0x86, 0x81, 0xbd,
+	0xa6, 0x61, 0x17, 0xed, 0x75, 0x65, 0x56, 0x67, 0x42, 0x69, 0xe6, 0xfc, 0xdb, 0x82, 0xb2, 0xe2,
+	0xce, 0xa5, 0x1e, 0x8f, 0xc8, 0x25, 0xeb, 0xf3, 0xae, 0x1c, 0xc7, 0x01, 0x7d, 0x8e, 0x56, 0xd9,
+	0x32, 0x9e, 0x95, 0x35, 0xd4, 0xdc, 0x1f, 0x05, 0x90, 0x4a, 0x58, 0xf6, 0x7f, 0x97, 0x30, 0xe7,
+	0xaf, 0x16, 0xac, 0xca, 0x5b, 0xe7, 0x0e, 0xa5, 0xf1, 0xf2, 0x9b, 0xe7, 0x2e, 0x94, 0x09, 0x8d,
+	0x05, 0x0b, 0x55, 0xea, 0x54, 0xac, 0x6b, 0x4b, 0xef, 0xbc, 0x3b, 0x94, 0x76, 0xe6, 0xca, 0x6e,
+	0xda, 0x12, 0x79, 0xcf, 0x1f, 0xe4, 0xe5, 0x1f, 0x10, 0x49, 0x4c, 0x7f, 0xc8, 0x40, 0xe9, 0x40,
+	0x7e, 0x13, 0x1d, 0xf0, 0x58, 0xa0, 0x57, 0x21, 0x4f, 0x68, 0xc8, 0x03, 0x93, 0xcc, 0xf5, 0xf9,
+	0x65, 0xa5, 0xc4, 0x8e, 0xab, 0xb7, 0xd1, 0xeb, 0x50, 0xe0, 0x11, 0xf6, 0x7c, 0x9d, 0xca, 0xd2,
+	0xee, 0xc6, 0x9c, 0x60, 0x2d, 0x77, 0x5c, 0xa3, 0x80, 0xfa, 0xc9, 0x17, 0x5a, 0x56, 0x69, 0xfe,
+	0x44, 0x7a, 0xfa, 0xf7, 0xc7, 0x8d, 0x57, 0x9f, 0xc3, 0xd3, 0x0e, 0xf5, 0xe6, 0x0e, 0x28, 0x10,
+	0x27, 0xf9, 0x80, 0x7b, 0x3f, 0xfd, 0xb9, 0x79, 0xf1, 0xe7, 0xc5, 0x4b, 0xa6, 0x08, 0xd6, 0xe7,
+	0xe5, 0xa4, 0x36, 0x9c, 0x67, 0x3e, 0x41, 0x6f, 0xe7, 0xd4, 0x5b, 0xe5, 0xa9, 0x05, 0x79, 0x45,
+	0xca, 0x73, 0x13, 0x32, 0x8b, 0x32, 0xf3, 0xad, 0x45, 0x99, 0x7d, 0xd1, 0x51, 0xde, 0x81, 0xb2,
+	0x19, 0x80, 0xf7, 0xf9, 0x11, 0x5d, 0x5e, 0xd0, 0x75, 0x28, 0x87, 0x74, 0x3a, 0x48, 0x36, 0xf5,
+	0x83, 0xac, 0x14, 0xd2, 0x69, 0x47, 0xed, 0xbf, 0xf1, 0x5b, 0xf3, 0xe6, 0xd6, 0x0f, 0x75, 0xd4,
+	0x82, 0xab, 0x1d, 0x77, 0xe7, 0x83, 0x41, 0xaf, 0xbf, 0xd3, 0x7f, 0xaf, 0x37, 0xe8, 0x75, 0xfb,
+	0xfd, 0xfd, 0x6e, 0x67, 0x7d, 0xa5, 0x76, 0xed, 0xf4, 0xac, 0xb9, 0x31, 0x57, 0xec, 0x51, 0x21,
+	0x7c, 0x4a, 0xd0, 0x2d, 0xd8, 0x4a, 0xeb, 0xbb, 0x0f, 0xf7, 0xf7, 0xbb, 0x9d, 0xc1, 0xc3, 0xf7,
+	0xbb, 0xee, 0xba, 0x55, 0xb3, 0x4f, 0xcf, 0x9a, 0x9b, 0x73, 0x1b, 0xf9, 0x44, 0xa0, 0xe4, 0xe1,
+	0x11, 0x8d, 0xe4, 0xf7, 0xcf, 0x82, 0x59, 0xf7, 0xce, 0x7b, 0x0f, 0x3a, 0xdd, 0xce, 0x7a, 0xa6,
+	0xf6, 0x9d, 0xd3, 0xb3, 0x26, 0x4a, 0xd9, 0xd0, 0xc3, 0x49, 0x48, 0x28, 0x41, 0x37, 0xe1, 0x5a,
+	0xda, 0x62, 0x6f, 0xe7, 0xc1, 0x5e, 0x57, 0x9e, 0xb5, 0x9e, 0xad, 0x6d, 0x9d, 0x9e, 0x35, 0xaf,
+	0xce, 0x4d, 0xf6, 0x70, 0xe8, 0x51, 0x79, 0x12, 0x7a, 0x03, 0x36, 0xd2, 0x36, 0xdd, 0xfb, 0x07,
+	0xfd, 0x0f, 0xd7, 0x73, 0xb5, 0xab, 0xa7, 0x67, 0xcd, 0x2b, 0x73, 0xfd, 0x6e, 0x30, 0x16, 0x27,
+	0xb5, 0xdc, 0xaf, 0xff, 0x54, 0x5f, 0xd9, 0xdd, 0xf9, 0xfc, 0x49, 0xdd, 0xfa, 0xe2, 0x49, 0xdd,
+	0xfa, 0xe7, 0x93, 0xba, 0xf5, 0xc9, 0xd3, 0xfa, 0xca, 0x17, 0x4f, 0xeb, 0x2b, 0x7f, 0x7b, 0x5a,
+	0x5f, 0xf9, 0xf9, 0x6b, 0xcf, 0x14, 0x83, 0xfe, 0x17, 0x8c, 0x4f, 0xc9, 0x88, 0x46, 0xed, 0x63,
+	0xf5, 0xbf, 0x18, 0x55, 0x11, 0xc3, 0x82, 0xca, 0xed, 0x0f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff,
+	0x64, 0x13, 0xa1, 0xf8, 0x5b, 0x12, 0x00, 0x00,
+}
+
+func (this *Ticket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Ticket)
+	if !ok {
+		that2, ok := that.(Ticket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return false
+	}
+	if this.DrawId != that1.DrawId {
+		return false
+	}
+	if len(this.Numbers) != len(that1.Numbers) {
+		return false
+	}
+	for i := range this.Numbers {
+		if this.Numbers[i] != that1.Numbers[i] {
+			return false
+		}
+	}
+	if !this.Price.Equal(&that1.Price) {
+		return false
+	}
+	if this.Payer != that1.Payer {
+		return false
+	}
+	if len(this.PrizeShare) != len(that1.PrizeShare) {
+		return false
+	}
+	for i := range this.PrizeShare {
+		if !this.PrizeShare[i].Equal(&that1.PrizeShare[i]) {
+			return false
+		}
+	}
+	if this.Transferred != that1.Transferred {
+		return false
+	}
+	return true
+}
+func (this *EntropyCommitment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EntropyCommitment)
+	if !ok {
+		that2, ok := that.(EntropyCommitment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if !bytes.Equal(this.Commitment, that1.Commitment) {
+		return false
+	}
+	if !bytes.Equal(this.Entropy, that1.Entropy) {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	return true
+}
+func (this *PricePost) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PricePost)
+	if !ok {
+		that2, ok := that.(PricePost)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Oracle != that1.Oracle {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return false
+	}
+	return true
+}
+func (this *Price) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Price)
+	if !ok {
+		that2, ok := that.(Price)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return false
+	}
+	return true
+}
+func (m *Ticket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ticket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ticket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transferred {
+		i--
+		if m.Transferred {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PrizeShare) > 0 {
+		for iNdEx := len(m.PrizeShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrizeShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Numbers) > 0 {
+		dAtA3 := make([]byte, len(m.Numbers)*10)
+		var j2 int
+		for _, num := range m.Numbers {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintModels(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DrawId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintModels(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Draw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Draw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Draw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x28
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintModels(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Prize) > 0 {
+		for iNdEx := len(m.Prize) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prize[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TicketsSold != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TicketsSold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Participants != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Participants))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalDrawData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalDrawData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalDrawData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rollovers != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Rollovers))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Status != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxNumber != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxNumber))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.WinningNumbers) > 0 {
+		dAtA7 := make([]byte, len(m.WinningNumbers)*10)
+		var j6 int
+		for _, num := range m.WinningNumbers {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintModels(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Winners) > 0 {
+		for iNdEx := len(m.Winners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Winners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.WinningTicketProof) > 0 {
+		for iNdEx := len(m.WinningTicketProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WinningTicketProof[iNdEx])
+			copy(dAtA[i:], m.WinningTicketProof[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.WinningTicketProof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.WinningIndex != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.WinningIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TicketsRoot) > 0 {
+		i -= len(m.TicketsRoot)
+		copy(dAtA[i:], m.TicketsRoot)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TicketsRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.WinningTicket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Draw.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DrawWinner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrawWinner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrawWinner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Matches != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Matches))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Prize) > 0 {
+		for iNdEx := len(m.Prize) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prize[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Ticket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Tier != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntropyCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntropyCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntropyCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Entropy) > 0 {
+		i -= len(m.Entropy)
+		copy(dAtA[i:], m.Entropy)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Entropy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedReveals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedReveals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedReveals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RolloverParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.GameParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.PrizeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TicketParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.DrawParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DistributionParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruningDraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruningDraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruningDraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingTickets != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.RemainingTickets))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DrawId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Exclusion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Exclusion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Exclusion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Until, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Until):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintModels(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendingLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendingLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendingLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintModels(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSpendingLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSpendingLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSpendingLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintModels(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x1a
+	n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintModels(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintModels(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrawFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrawFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrawFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Destination != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DrawId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PricePost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricePost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricePost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintModels(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Price) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Price) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintModels(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModels(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TicketsMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TicketsMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TicketsMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewDrawId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.NewDrawId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DrawId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModels(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Ticket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovModels(uint64(l))
+	if m.DrawId != 0 {
+		n += 1 + sovModels(uint64(m.DrawId))
+	}
+	if len(m.Numbers) > 0 {
+		l = 0
+		for _, e := range m.Numbers {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.PrizeShare) > 0 {
+		for _, e := range m.PrizeShare {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Transferred {
+		n += 2
+	}
+	return n
+}
+
+func (m *Draw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Participants != 0 {
+		n += 1 + sovModels(uint64(m.Participants))
+	}
+	if m.TicketsSold != 0 {
+		n += 1 + sovModels(uint64(m.TicketsSold))
+	}
+	if len(m.Prize) > 0 {
+		for _, e := range m.Prize {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovModels(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovModels(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *HistoricalDrawData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Draw.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.WinningTicket.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.TicketsRoot)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.WinningIndex != 0 {
+		n += 1 + sovModels(uint64(m.WinningIndex))
+	}
+	if len(m.WinningTicketProof) > 0 {
+		for _, b := range m.WinningTicketProof {
+			l = len(b)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.Winners) > 0 {
+		for _, e := range m.Winners {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.WinningNumbers) > 0 {
+		l = 0
+		for _, e := range m.WinningNumbers {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	if m.MaxNumber != 0 {
+		n += 1 + sovModels(uint64(m.MaxNumber))
+	}
+	if m.Status != 0 {
+		n += 1 + sovModels(uint64(m.Status))
+	}
+	if m.Rollovers != 0 {
+		n += 1 + sovModels(uint64(m.Rollovers))
+	}
+	return n
+}
+
+func (m *DrawWinner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tier != 0 {
+		n += 1 + sovModels(uint64(m.Tier))
+	}
+	l = m.Ticket.Size()
+	n += 1 + l + sovModels(uint64(l))
+	if m.Index != 0 {
+		n += 1 + sovModels(uint64(m.Index))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.Prize) > 0 {
+		for _, e := range m.Prize {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if m.Matches != 0 {
+		n += 1 + sovModels(uint64(m.Matches))
+	}
+	return n
+}
+
+func (m *EntropyCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Entropy)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovModels(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MissedReveals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovModels(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = m.DistributionParams.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.DrawParams.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.TicketParams.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.PrizeParams.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.GameParams.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = m.RolloverParams.Size()
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *PruningDraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		n += 1 + sovModels(uint64(m.DrawId))
+	}
+	if m.RemainingTickets != 0 {
+		n += 1 + sovModels(uint64(m.RemainingTickets))
+	}
+	return n
+}
+
+func (m *Exclusion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Until)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *SpendingLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovModels(uint64(l))
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingSpendingLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *SpendRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovModels(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DrawFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		n += 1 + sovModels(uint64(m.DrawId))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovModels(uint64(m.Destination))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PricePost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *Price) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovModels(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovModels(uint64(l))
+	return n
+}
+
+func (m *TicketsMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		n += 1 + sovModels(uint64(m.DrawId))
+	}
+	if m.NewDrawId != 0 {
+		n += 1 + sovModels(uint64(m.NewDrawId))
+	}
+	return n
+}
+
+func sovModels(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModels(x uint64) (n int) {
+	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Ticket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ticket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ticket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
+			}
+			m.DrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Numbers = append(m.Numbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Numbers) == 0 {
+					m.Numbers = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Numbers = append(m.Numbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numbers", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizeShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrizeShare = append(m.PrizeShare, types.Coin{})
+			if err := m.PrizeShare[len(m.PrizeShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transferred = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Draw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Draw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Draw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			m.Participants = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Participants |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketsSold", wireType)
+			}
+			m.TicketsSold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TicketsSold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prize = append(m.Prize, types.Coin{})
+			if err := m.Prize[len(m.Prize)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalDrawData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalDrawData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalDrawData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Draw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningTicket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WinningTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TicketsRoot = append(m.TicketsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.TicketsRoot == nil {
+				m.TicketsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningIndex", wireType)
+			}
+			m.WinningIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinningIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningTicketProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinningTicketProof = append(m.WinningTicketProof, make([]byte, postIndex-iNdEx))
+			copy(m.WinningTicketProof[len(m.WinningTicketProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winners = append(m.Winners, DrawWinner{})
+			if err := m.Winners[len(m.Winners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WinningNumbers = append(m.WinningNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WinningNumbers) == 0 {
+					m.WinningNumbers = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WinningNumbers = append(m.WinningNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningNumbers", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumber", wireType)
+			}
+			m.MaxNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DrawStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollovers", wireType)
+			}
+			m.Rollovers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rollovers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrawWinner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrawWinner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrawWinner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ticket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prize = append(m.Prize, types.Coin{})
+			if err := m.Prize[len(m.Prize)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
+			}
+			m.Matches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Matches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntropyCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntropyCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntropyCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entropy = append(m.Entropy[:0], dAtA[iNdEx:postIndex]...)
+			if m.Entropy == nil {
+				m.Entropy = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedReveals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedReveals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedReveals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DrawParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TicketParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrizeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GameParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloverParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RolloverParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruningDraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruningDraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruningDraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
+			}
+			m.DrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingTickets", wireType)
+			}
+			m.RemainingTickets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingTickets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Exclusion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Exclusion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Exclusion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Until, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendingLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendingLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendingLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pending == nil {
+				m.Pending = &PendingSpendingLimit{}
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSpendingLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSpendingLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSpendingLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovModels(uint64(l))
-	return n
-}
 
-func (m *HistoricalDrawData) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Draw.Size()
-	n += 1 + l + sovModels(uint64(l))
-	l = m.WinningTicket.Size()
-	n += 1 + l + sovModels(uint64(l))
-	return n
-}
-
-func sovModels(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModels(x uint64) (n int) {
-	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Ticket) Unmarshal(dAtA []byte) error {
+func (m *DrawFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -524,17 +5335,17 @@ func (m *Ticket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Ticket: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrawFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ticket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrawFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
 			}
-			var stringLen uint64
+			m.DrawId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -544,29 +5355,16 @@ func (m *Ticket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DrawId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var stringLen uint64
+			m.Destination = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -576,27 +5374,14 @@ func (m *Ticket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Destination |= FeeDestination(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -623,7 +5408,8 @@ func (m *Ticket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -648,7 +5434,7 @@ func (m *Ticket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Draw) Unmarshal(dAtA []byte) error {
+func (m *PricePost) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -671,17 +5457,17 @@ func (m *Draw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Draw: wiretype end group for non-group")
+			return fmt.Errorf("proto: PricePost: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Draw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PricePost: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.Participants = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -691,16 +5477,29 @@ func (m *Draw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Participants |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TicketsSold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
-			m.TicketsSold = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -710,16 +5509,29 @@ func (m *Draw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TicketsSold |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -729,29 +5541,29 @@ func (m *Draw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prize = append(m.Prize, types.Coin{})
-			if err := m.Prize[len(m.Prize)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -778,7 +5590,7 @@ func (m *Draw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -803,7 +5615,7 @@ func (m *Draw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoricalDrawData) Unmarshal(dAtA []byte) error {
+func (m *Price) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -826,17 +5638,17 @@ func (m *HistoricalDrawData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalDrawData: wiretype end group for non-group")
+			return fmt.Errorf("proto: Price: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalDrawData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Draw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -846,28 +5658,61 @@ func (m *HistoricalDrawData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Draw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WinningTicket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -894,7 +5739,7 @@ func (m *HistoricalDrawData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WinningTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -919,6 +5764,94 @@ func (m *HistoricalDrawData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TicketsMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TicketsMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TicketsMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
+			}
+			m.DrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDrawId", wireType)
+			}
+			m.NewDrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewDrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipModels(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,22 +18,72 @@ func TestTicket_Validate(t *testing.T) {
 	}{
 		{
 			name:      "invalid id",
-			ticket:    types.NewTicket("", time.Now(), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"),
+			ticket:    types.NewTicket("", 1, time.Now(), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", sdk.NewInt64Coin("stake", 10), nil),
 			shouldErr: true,
 		},
 		{
 			name:      "invalid time",
-			ticket:    types.NewTicket("ticket-id", time.Time{}, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"),
+			ticket:    types.NewTicket("ticket-id", 1, time.Time{}, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", sdk.NewInt64Coin("stake", 10), nil),
 			shouldErr: true,
 		},
 		{
 			name:      "invalid owner",
-			ticket:    types.NewTicket("ticket-id", time.Now(), ""),
+			ticket:    types.NewTicket("ticket-id", 1, time.Now(), "", "", sdk.NewInt64Coin("stake", 10), nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid payer",
+			ticket:    types.NewTicket("ticket-id", 1, time.Now(), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "payer", sdk.NewInt64Coin("stake", 10), nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid price",
+			ticket:    types.NewTicket("ticket-id", 1, time.Now(), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", sdk.NewInt64Coin("stake", 0), nil),
+			shouldErr: true,
+		},
+		{
+			name: "prize share greater than the price",
+			ticket: func() types.Ticket {
+				ticket := types.NewTicket("ticket-id", 1, time.Now(), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", sdk.NewInt64Coin("stake", 10), nil)
+				ticket.PrizeShare = sdk.NewCoins(sdk.NewInt64Coin("stake", 11))
+				return ticket
+			}(),
+			shouldErr: true,
+		},
+		{
+			name: "prize share in a different denom",
+			ticket: func() types.Ticket {
+				ticket := types.NewTicket("ticket-id", 1, time.Now(), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", sdk.NewInt64Coin("stake", 10), nil)
+				ticket.PrizeShare = sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+				return ticket
+			}(),
 			shouldErr: true,
 		},
 		{
 			name:      "valid ticket",
-			ticket:    types.NewTicket("ticket-id", time.Now(), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"),
+			ticket:    types.NewTicket("ticket-id", 1, time.Now(), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", sdk.NewInt64Coin("stake", 10), nil),
+			shouldErr: false,
+		},
+		{
+			name: "valid ticket with prize share",
+			ticket: func() types.Ticket {
+				ticket := types.NewTicket("ticket-id", 1, time.Now(), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", sdk.NewInt64Coin("stake", 10), nil)
+				ticket.PrizeShare = sdk.NewCoins(sdk.NewInt64Coin("stake", 8))
+				return ticket
+			}(),
+			shouldErr: false,
+		},
+		{
+			name: "valid ticket with payer",
+			ticket: types.NewTicket(
+				"ticket-id",
+				1,
+				time.Now(),
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				"cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu",
+				sdk.NewInt64Coin("stake", 10),
+				nil,
+			),
 			shouldErr: false,
 		},
 	}
@@ -52,6 +102,29 @@ func TestTicket_Validate(t *testing.T) {
 	}
 }
 
+func TestTicket_PaidBy(t *testing.T) {
+	owner := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	payer := "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"
+
+	ticket := types.NewTicket("ticket-id", 1, time.Now(), owner, "", sdk.NewInt64Coin("stake", 10), nil)
+	require.Equal(t, owner, ticket.PaidBy())
+
+	ticket = types.NewTicket("ticket-id", 1, time.Now(), owner, payer, sdk.NewInt64Coin("stake", 10), nil)
+	require.Equal(t, payer, ticket.PaidBy())
+}
+
+func TestTicket_RefundAddress(t *testing.T) {
+	owner := "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"
+	payer := "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"
+
+	ticket := types.NewTicket("ticket-id", 1, time.Now(), owner, payer, sdk.NewInt64Coin("stake", 10), nil)
+	require.Equal(t, payer, ticket.RefundAddress())
+
+	ticket.Transferred = true
+	require.Equal(t, owner, ticket.RefundAddress())
+	require.Equal(t, payer, ticket.PaidBy())
+}
+
 func TestIsTicketIDDuplicated(t *testing.T) {
 	usecases := []struct {
 		name          string
@@ -63,8 +136,8 @@ func TestIsTicketIDDuplicated(t *testing.T) {
 			name: "duplicated id",
 			id:   "ticket-id",
 			tickets: []types.Ticket{
-				types.NewTicket("ticket-id", time.Now(), "owner-1"),
-				types.NewTicket("ticket-id", time.Now(), "owner-1"),
+				types.NewTicket("ticket-id", 1, time.Now(), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+				types.NewTicket("ticket-id", 1, time.Now(), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
 			},
 			expDuplicated: true,
 		},
@@ -72,8 +145,8 @@ func TestIsTicketIDDuplicated(t *testing.T) {
 			name: "non duplicated id",
 			id:   "ticket-id-1",
 			tickets: []types.Ticket{
-				types.NewTicket("ticket-id-1", time.Now(), "owner-1"),
-				types.NewTicket("ticket-id-2", time.Now(), "owner-1"),
+				types.NewTicket("ticket-id-1", 1, time.Now(), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+				types.NewTicket("ticket-id-2", 1, time.Now(), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
 			},
 			expDuplicated: false,
 		},
@@ -96,6 +169,8 @@ func TestDraw_Validate(t *testing.T) {
 		{
 			name: "invalid number of tickets and participants",
 			draw: types.NewDraw(
+				1,
+				types.DefaultPoolID,
 				10,
 				5,
 				sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
@@ -106,6 +181,8 @@ func TestDraw_Validate(t *testing.T) {
 		{
 			name: "invalid prize",
 			draw: types.NewDraw(
+				1,
+				types.DefaultPoolID,
 				1,
 				1,
 				sdk.Coins{sdk.Coin{Denom: "./+", Amount: sdk.NewInt(10)}},
@@ -116,6 +193,8 @@ func TestDraw_Validate(t *testing.T) {
 		{
 			name: "invalid time",
 			draw: types.NewDraw(
+				1,
+				types.DefaultPoolID,
 				1,
 				1,
 				sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
@@ -126,6 +205,8 @@ func TestDraw_Validate(t *testing.T) {
 		{
 			name: "valid ticket",
 			draw: types.NewDraw(
+				1,
+				types.DefaultPoolID,
 				1,
 				1,
 				sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
@@ -149,3 +230,696 @@ func TestDraw_Validate(t *testing.T) {
 
 	}
 }
+
+func TestHistoricalDrawData_Validate(t *testing.T) {
+	draw := types.NewDraw(
+		1,
+		types.DefaultPoolID,
+		1,
+		2,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		time.Now(),
+	)
+
+	rolledOverWithWinners := types.NewUnsettledHistoricalDrawData(draw, types.DrawStatusRolledOver, 1)
+	rolledOverWithWinners.Winners = []types.DrawWinner{
+		types.NewDrawWinner(0, types.Ticket{}, 0, nil, sdk.NewCoins(), 0),
+	}
+
+	usecases := []struct {
+		name      string
+		data      types.HistoricalDrawData
+		shouldErr bool
+	}{
+		{
+			name:      "invalid status",
+			data:      types.NewUnsettledHistoricalDrawData(draw, types.DrawStatus(10), 1),
+			shouldErr: true,
+		},
+		{
+			name:      "unsettled draw without rollovers",
+			data:      types.NewUnsettledHistoricalDrawData(draw, types.DrawStatusRolledOver, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "unsettled draw with winners",
+			data:      rolledOverWithWinners,
+			shouldErr: true,
+		},
+		{
+			name:      "valid rolled over draw",
+			data:      types.NewUnsettledHistoricalDrawData(draw, types.DrawStatusRolledOver, 1),
+			shouldErr: false,
+		},
+		{
+			name:      "valid refunded draw",
+			data:      types.NewUnsettledHistoricalDrawData(draw, types.DrawStatusRefunded, 4),
+			shouldErr: false,
+		},
+		{
+			name:      "valid cancelled draw",
+			data:      types.NewUnsettledHistoricalDrawData(draw, types.DrawStatusCancelled, 0),
+			shouldErr: false,
+		},
+		{
+			name:      "empty draw with tickets sold",
+			data:      types.NewUnsettledHistoricalDrawData(draw, types.DrawStatusEmpty, 0),
+			shouldErr: true,
+		},
+		{
+			name: "valid empty draw",
+			data: types.NewUnsettledHistoricalDrawData(
+				types.NewDraw(draw.Id, draw.PoolId, 0, 0, draw.Prize, draw.EndTime), types.DrawStatusEmpty, 0,
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.data.Validate()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEntropyCommitment_Validate(t *testing.T) {
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper14zfwkjm35j05ydm3s3qu4he39yjxe9573dvzzv")
+	require.NoError(t, err)
+
+	usecases := []struct {
+		name       string
+		commitment types.EntropyCommitment
+		shouldErr  bool
+	}{
+		{
+			name:       "invalid validator",
+			commitment: types.NewEntropyCommitment(types.DefaultPoolID, "validator", types.ComputeEntropyCommitment(valAddr, []byte("entropy")), nil),
+			shouldErr:  true,
+		},
+		{
+			name:       "invalid commitment",
+			commitment: types.NewEntropyCommitment(types.DefaultPoolID, valAddr.String(), []byte("commitment"), nil),
+			shouldErr:  true,
+		},
+		{
+			name: "revealed entropy not matching",
+			commitment: types.NewEntropyCommitment(
+				types.DefaultPoolID,
+				valAddr.String(),
+				types.ComputeEntropyCommitment(valAddr, []byte("entropy")),
+				[]byte("another-entropy"),
+			),
+			shouldErr: true,
+		},
+		{
+			name:       "valid unrevealed commitment",
+			commitment: types.NewEntropyCommitment(types.DefaultPoolID, valAddr.String(), types.ComputeEntropyCommitment(valAddr, []byte("entropy")), nil),
+			shouldErr:  false,
+		},
+		{
+			name: "valid revealed commitment",
+			commitment: types.NewEntropyCommitment(
+				types.DefaultPoolID,
+				valAddr.String(),
+				types.ComputeEntropyCommitment(valAddr, []byte("entropy")),
+				[]byte("entropy"),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.commitment.Validate()
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPool_Validate(t *testing.T) {
+	usecases := []struct {
+		name      string
+		pool      types.Pool
+		shouldErr bool
+	}{
+		{
+			name: "invalid distribution params",
+			pool: types.NewPool(
+				1,
+				types.NewDistributionParams(sdk.NewDecWithPrec(98, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(2, 2), types.FeeDestinationFeeCollector),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultGameParams(),
+				types.DefaultRolloverParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid draw params",
+			pool: types.NewPool(
+				1,
+				types.DefaultDistributionParams(),
+				types.NewDrawParams(0, time.Minute, 0, false),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultGameParams(),
+				types.DefaultRolloverParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid ticket params",
+			pool: types.NewPool(
+				1,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.NewTicketParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), nil, sdk.ZeroDec(), 0, 0),
+				types.DefaultPrizeParams(),
+				types.DefaultGameParams(),
+				types.DefaultRolloverParams(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid pool",
+			pool: types.NewPool(
+				1,
+				types.DefaultDistributionParams(),
+				types.DefaultDrawParams(),
+				types.DefaultTicketParams(),
+				types.DefaultPrizeParams(),
+				types.DefaultGameParams(),
+				types.DefaultRolloverParams(),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.pool.Validate()
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExclusion_Validate(t *testing.T) {
+	usecases := []struct {
+		name      string
+		exclusion types.Exclusion
+		shouldErr bool
+	}{
+		{
+			name:      "invalid address",
+			exclusion: types.NewExclusion("address", time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)),
+			shouldErr: true,
+		},
+		{
+			name:      "zero end time",
+			exclusion: types.NewExclusion("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", time.Time{}),
+			shouldErr: true,
+		},
+		{
+			name: "valid exclusion",
+			exclusion: types.NewExclusion(
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.exclusion.Validate()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExclusion_IsActive(t *testing.T) {
+	exclusion := types.NewExclusion(
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+	)
+
+	require.True(t, exclusion.IsActive(time.Date(2020, 12, 31, 23, 59, 59, 000, time.UTC)))
+	require.False(t, exclusion.IsActive(time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)))
+	require.False(t, exclusion.IsActive(time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC)))
+}
+
+func TestSpendingLimit_Validate(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+	effectiveTime := time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC)
+
+	usecases := []struct {
+		name      string
+		limit     types.SpendingLimit
+		shouldErr bool
+	}{
+		{
+			name:      "invalid address",
+			limit:     types.NewSpendingLimit("address", amount, time.Hour, nil),
+			shouldErr: true,
+		},
+		{
+			name: "empty amount",
+			limit: types.NewSpendingLimit(
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				sdk.NewCoins(),
+				time.Hour,
+				nil,
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "zero window",
+			limit:     types.NewSpendingLimit("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", amount, 0, nil),
+			shouldErr: true,
+		},
+		{
+			name: "pending limit without window",
+			limit: types.NewSpendingLimit(
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				amount,
+				time.Hour,
+				types.NewPendingSpendingLimit(amount, 0, effectiveTime),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "pending limit without effective time",
+			limit: types.NewSpendingLimit(
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				amount,
+				time.Hour,
+				types.NewPendingSpendingLimit(amount, time.Hour, time.Time{}),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid pending removal",
+			limit: types.NewSpendingLimit(
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				amount,
+				time.Hour,
+				types.NewPendingSpendingLimit(sdk.NewCoins(), 0, effectiveTime),
+			),
+			shouldErr: false,
+		},
+		{
+			name:      "valid spending limit",
+			limit:     types.NewSpendingLimit("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", amount, time.Hour, nil),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.limit.Validate()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSpendingLimit_Current(t *testing.T) {
+	limit := types.NewSpendingLimit(
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		time.Hour,
+		types.NewPendingSpendingLimit(
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			time.Hour,
+			time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC),
+		),
+	)
+
+	require.Equal(t, limit, limit.Current(time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)))
+	require.Equal(t,
+		types.NewSpendingLimit(
+			"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			time.Hour,
+			nil,
+		),
+		limit.Current(time.Date(2021, 1, 2, 00, 00, 00, 000, time.UTC)),
+	)
+}
+
+func TestSpendingLimit_Raises(t *testing.T) {
+	current := types.NewSpendingLimit(
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		time.Hour,
+		nil,
+	)
+
+	usecases := []struct {
+		name      string
+		amount    sdk.Coins
+		window    time.Duration
+		expRaises bool
+	}{
+		{
+			name:      "removed limit",
+			amount:    sdk.NewCoins(),
+			window:    0,
+			expRaises: true,
+		},
+		{
+			name:      "greater amount",
+			amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 501)),
+			window:    time.Hour,
+			expRaises: true,
+		},
+		{
+			name:      "shorter window",
+			amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+			window:    time.Minute,
+			expRaises: true,
+		},
+		{
+			name:      "limited denom removed",
+			amount:    sdk.NewCoins(sdk.NewInt64Coin("ufchs", 100)),
+			window:    time.Hour,
+			expRaises: true,
+		},
+		{
+			name:      "same limit",
+			amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+			window:    time.Hour,
+			expRaises: false,
+		},
+		{
+			name:      "lower amount and longer window",
+			amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			window:    time.Hour * 2,
+			expRaises: false,
+		},
+		{
+			name:      "new denom limited",
+			amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("ufchs", 100)),
+			window:    time.Hour,
+			expRaises: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			limit := types.NewSpendingLimit(current.Address, uc.amount, uc.window, nil)
+			require.Equal(t, uc.expRaises, limit.Raises(current))
+		})
+	}
+}
+
+func TestSpendingLimit_CanSpend(t *testing.T) {
+	limit := types.NewSpendingLimit(
+		"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		time.Hour,
+		nil,
+	)
+
+	spent := sdk.NewCoins(sdk.NewInt64Coin("stake", 300))
+	require.True(t, limit.CanSpend(spent, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))))
+	require.False(t, limit.CanSpend(spent, sdk.NewCoins(sdk.NewInt64Coin("stake", 201))))
+	require.True(t, limit.CanSpend(spent, sdk.NewCoins(sdk.NewInt64Coin("ufchs", 1000))))
+}
+
+func TestSpendRecord_Validate(t *testing.T) {
+	usecases := []struct {
+		name      string
+		record    types.SpendRecord
+		shouldErr bool
+	}{
+		{
+			name: "invalid address",
+			record: types.NewSpendRecord(
+				"address",
+				time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero time",
+			record: types.NewSpendRecord(
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				time.Time{},
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "empty amount",
+			record: types.NewSpendRecord(
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+				sdk.NewCoins(),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid spend record",
+			record: types.NewSpendRecord(
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.record.Validate()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDrawFees_Validate(t *testing.T) {
+	usecases := []struct {
+		name      string
+		fees      types.DrawFees
+		shouldErr bool
+	}{
+		{
+			name:      "invalid draw id",
+			fees:      types.NewDrawFees(0, types.FeeDestinationTreasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid destination",
+			fees:      types.NewDrawFees(1, types.FeeDestination(10), sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			shouldErr: true,
+		},
+		{
+			name:      "empty amount",
+			fees:      types.NewDrawFees(1, types.FeeDestinationTreasury, sdk.NewCoins()),
+			shouldErr: true,
+		},
+		{
+			name:      "valid draw fees",
+			fees:      types.NewDrawFees(1, types.FeeDestinationTreasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.fees.Validate()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPricePost_Validate(t *testing.T) {
+	usecases := []struct {
+		name      string
+		post      types.PricePost
+		shouldErr bool
+	}{
+		{
+			name: "invalid denom",
+			post: types.NewPricePost(
+				"./",
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				sdk.NewDecWithPrec(25, 2),
+				time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid oracle",
+			post: types.NewPricePost(
+				"stake",
+				"oracle",
+				sdk.NewDecWithPrec(25, 2),
+				time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero price",
+			post: types.NewPricePost(
+				"stake",
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				sdk.ZeroDec(),
+				time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "zero timestamp",
+			post: types.NewPricePost(
+				"stake",
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				sdk.NewDecWithPrec(25, 2),
+				time.Time{},
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid price post",
+			post: types.NewPricePost(
+				"stake",
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				sdk.NewDecWithPrec(25, 2),
+				time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC),
+			),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.post.Validate()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPrice_Validate(t *testing.T) {
+	usecases := []struct {
+		name      string
+		price     types.Price
+		shouldErr bool
+	}{
+		{
+			name:      "invalid denom",
+			price:     types.NewPrice("./", sdk.NewDecWithPrec(25, 2), time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)),
+			shouldErr: true,
+		},
+		{
+			name:      "negative price",
+			price:     types.NewPrice("stake", sdk.NewDec(-1), time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)),
+			shouldErr: true,
+		},
+		{
+			name:      "zero timestamp",
+			price:     types.NewPrice("stake", sdk.NewDecWithPrec(25, 2), time.Time{}),
+			shouldErr: true,
+		},
+		{
+			name:      "valid price",
+			price:     types.NewPrice("stake", sdk.NewDecWithPrec(25, 2), time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.price.Validate()
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPrice_IsStale(t *testing.T) {
+	price := types.NewPrice("stake", sdk.NewDecWithPrec(25, 2), time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC))
+	require.False(t, price.IsStale(time.Date(2021, 1, 1, 00, 30, 00, 000, time.UTC), time.Hour))
+	require.False(t, price.IsStale(time.Date(2021, 1, 1, 01, 00, 00, 000, time.UTC), time.Hour))
+	require.True(t, price.IsStale(time.Date(2021, 1, 1, 01, 00, 01, 000, time.UTC), time.Hour))
+}
+
+func TestMedianPrice(t *testing.T) {
+	usecases := []struct {
+		name     string
+		prices   []sdk.Dec
+		expected sdk.Dec
+	}{
+		{
+			name:     "single price",
+			prices:   []sdk.Dec{sdk.NewDec(3)},
+			expected: sdk.NewDec(3),
+		},
+		{
+			name:     "odd amount of prices",
+			prices:   []sdk.Dec{sdk.NewDec(5), sdk.NewDec(1), sdk.NewDec(3)},
+			expected: sdk.NewDec(3),
+		},
+		{
+			name:     "even amount of prices",
+			prices:   []sdk.Dec{sdk.NewDec(4), sdk.NewDec(1), sdk.NewDec(100), sdk.NewDec(2)},
+			expected: sdk.NewDec(3),
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			require.True(t, uc.expected.Equal(types.MedianPrice(uc.prices)))
+		})
+	}
+}
@@ -1,21 +1,47 @@
 package types
 
 import (
+	"crypto/sha256"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
-	TypeMsgBuyTickets = "buy_tickets"
+	TypeMsgBuyTickets       = "buy_tickets"
+	TypeMsgCommitEntropy    = "commit_entropy"
+	TypeMsgRevealEntropy    = "reveal_entropy"
+	TypeMsgSelfExclude      = "self_exclude"
+	TypeMsgSetSpendingLimit = "set_spending_limit"
+	TypeMsgPostPrice        = "post_price"
+	TypeMsgTransferTickets  = "transfer_tickets"
+
+	// MaxEntropyLength represents the maximum length of the entropy that can be revealed
+	MaxEntropyLength = 64
 )
 
 var _ sdk.Msg = &MsgBuyTickets{}
 
-// NewMsgBuyTickets allows to build a new MsgBuyTickets instance
-func NewMsgBuyTickets(quantity uint32, user string) *MsgBuyTickets {
+// NewMsgBuyTickets allows to build a new MsgBuyTickets instance.
+// If the recipient is not empty, the tickets paid by the user are owned by such address
+func NewMsgBuyTickets(
+	poolID uint64, quantity uint32, denom string, user string, recipient string, picks []NumbersPick,
+) *MsgBuyTickets {
 	return &MsgBuyTickets{
-		Quantity: quantity,
-		Buyer:    user,
+		PoolId:    poolID,
+		Quantity:  quantity,
+		Denom:     denom,
+		Buyer:     user,
+		Recipient: recipient,
+		Picks:     picks,
+	}
+}
+
+// NewNumbersPick allows to build a new NumbersPick instance
+func NewNumbersPick(numbers ...uint32) NumbersPick {
+	return NumbersPick{
+		Numbers: numbers,
 	}
 }
 
@@ -39,6 +65,28 @@ func (m *MsgBuyTickets) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid buyer address")
 	}
 
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+		}
+	}
+
+	if m.Denom != "" {
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+	}
+
+	if len(m.Picks) != 0 && uint32(len(m.Picks)) != m.Quantity {
+		return sdkerrors.Wrapf(ErrInvalidPick, "picks count %d does not match the tickets quantity", len(m.Picks))
+	}
+
+	for _, pick := range m.Picks {
+		if len(pick.Numbers) == 0 || !AreNumbersUnique(pick.Numbers) {
+			return sdkerrors.Wrapf(ErrInvalidPick, "invalid picked numbers: %v", pick.Numbers)
+		}
+	}
+
 	return nil
 }
 
@@ -56,3 +104,341 @@ func (m *MsgBuyTickets) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{buyerAddr}
 }
+
+// ------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.Msg = &MsgCommitEntropy{}
+
+// NewMsgCommitEntropy allows to build a new MsgCommitEntropy instance
+func NewMsgCommitEntropy(poolID uint64, commitment []byte, validator string) *MsgCommitEntropy {
+	return &MsgCommitEntropy{
+		PoolId:     poolID,
+		Validator:  validator,
+		Commitment: commitment,
+	}
+}
+
+// Route implements sdk.Msg
+func (m *MsgCommitEntropy) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgCommitEntropy) Type() string {
+	return TypeMsgCommitEntropy
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCommitEntropy) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.Validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid validator address")
+	}
+
+	if len(m.Commitment) != sha256.Size {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid commitment length: %d", len(m.Commitment))
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgCommitEntropy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCommitEntropy) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(m.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.Msg = &MsgRevealEntropy{}
+
+// ValidateEntropy checks that the given entropy can be revealed once committed to
+func ValidateEntropy(entropy []byte) error {
+	if len(entropy) == 0 || len(entropy) > MaxEntropyLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid entropy length: %d", len(entropy))
+	}
+	return nil
+}
+
+// NewMsgRevealEntropy allows to build a new MsgRevealEntropy instance
+func NewMsgRevealEntropy(poolID uint64, entropy []byte, validator string) *MsgRevealEntropy {
+	return &MsgRevealEntropy{
+		PoolId:    poolID,
+		Validator: validator,
+		Entropy:   entropy,
+	}
+}
+
+// Route implements sdk.Msg
+func (m *MsgRevealEntropy) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgRevealEntropy) Type() string {
+	return TypeMsgRevealEntropy
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRevealEntropy) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.Validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid validator address")
+	}
+
+	return ValidateEntropy(m.Entropy)
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgRevealEntropy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRevealEntropy) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(m.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.Msg = &MsgSelfExclude{}
+
+// NewMsgSelfExclude allows to build a new MsgSelfExclude instance
+func NewMsgSelfExclude(address string, until time.Time) *MsgSelfExclude {
+	return &MsgSelfExclude{
+		Address: address,
+		Until:   until,
+	}
+}
+
+// Route implements sdk.Msg
+func (m *MsgSelfExclude) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgSelfExclude) Type() string {
+	return TypeMsgSelfExclude
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSelfExclude) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid self exclusion address")
+	}
+
+	if m.Until.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExclusion, "missing self exclusion end time")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSelfExclude) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSelfExclude) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.Msg = &MsgSetSpendingLimit{}
+
+// NewMsgSetSpendingLimit allows to build a new MsgSetSpendingLimit instance
+func NewMsgSetSpendingLimit(address string, amount sdk.Coins, window time.Duration) *MsgSetSpendingLimit {
+	return &MsgSetSpendingLimit{
+		Address: address,
+		Amount:  amount,
+		Window:  window,
+	}
+}
+
+// Route implements sdk.Msg
+func (m *MsgSetSpendingLimit) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgSetSpendingLimit) Type() string {
+	return TypeMsgSetSpendingLimit
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetSpendingLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid spending limit address")
+	}
+
+	if !m.Amount.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidSpendingLimit, "invalid amount: %s", m.Amount)
+	}
+
+	if !m.Amount.Empty() && m.Window <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidSpendingLimit, "invalid window: %s", m.Window)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSetSpendingLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetSpendingLimit) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.Msg = &MsgPostPrice{}
+
+// NewMsgPostPrice allows to build a new MsgPostPrice instance
+func NewMsgPostPrice(oracle string, denom string, price sdk.Dec) *MsgPostPrice {
+	return &MsgPostPrice{
+		Oracle: oracle,
+		Denom:  denom,
+		Price:  price,
+	}
+}
+
+// Route implements sdk.Msg
+func (m *MsgPostPrice) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgPostPrice) Type() string {
+	return TypeMsgPostPrice
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgPostPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Oracle); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid oracle address")
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if m.Price.IsNil() || !m.Price.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "invalid price: %s", m.Price)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgPostPrice) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgPostPrice) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Oracle)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.Msg = &MsgTransferTickets{}
+
+// NewMsgTransferTickets allows to build a new MsgTransferTickets instance
+func NewMsgTransferTickets(poolID uint64, ticketIDs []string, owner string, recipient string) *MsgTransferTickets {
+	return &MsgTransferTickets{
+		Owner:     owner,
+		Recipient: recipient,
+		PoolId:    poolID,
+		TicketIds: ticketIDs,
+	}
+}
+
+// Route implements sdk.Msg
+func (m *MsgTransferTickets) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m *MsgTransferTickets) Type() string {
+	return TypeMsgTransferTickets
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgTransferTickets) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid owner address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+	}
+
+	if m.Owner == m.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the recipient cannot be the owner of the tickets")
+	}
+
+	if len(m.TicketIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at least one ticket id is required")
+	}
+
+	ids := map[string]bool{}
+	for _, id := range m.TicketIds {
+		if id == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid ticket id")
+		}
+
+		if ids[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ticket id duplicated: %s", id)
+		}
+		ids[id] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgTransferTickets) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgTransferTickets) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
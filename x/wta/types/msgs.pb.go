@@ -6,21 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +40,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgBuyTickets struct {
 	Quantity uint32 `protobuf:"varint,1,opt,name=quantity,proto3" json:"quantity,omitempty" yaml:"quantity"`
 	Buyer    string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty" yaml:"buyer"`
+	PoolId   uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// Numbers picked for each ticket, required when buying the tickets of a
+	// lotto pool
+	Picks []NumbersPick `protobuf:"bytes,4,rep,name=picks,proto3" json:"picks" yaml:"picks"`
+	// Denomination used to pay for the tickets, which must be the one of either
+	// the main price or an alternative price of the pool. If empty, the main
+	// price is used
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// Address that will own the tickets, paid by the buyer. If empty, the
+	// tickets are owned by the buyer
+	Recipient string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
 }
 
 func (m *MsgBuyTickets) Reset()         { *m = MsgBuyTickets{} }
@@ -68,6 +86,51 @@ func (m *MsgBuyTickets) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBuyTickets proto.InternalMessageInfo
 
+// NumbersPick contains the numbers picked for a single lotto ticket
+type NumbersPick struct {
+	Numbers []uint32 `protobuf:"varint,1,rep,packed,name=numbers,proto3" json:"numbers,omitempty" yaml:"numbers"`
+}
+
+func (m *NumbersPick) Reset()         { *m = NumbersPick{} }
+func (m *NumbersPick) String() string { return proto.CompactTextString(m) }
+func (*NumbersPick) ProtoMessage()    {}
+func (*NumbersPick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{1}
+}
+func (m *NumbersPick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NumbersPick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NumbersPick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NumbersPick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NumbersPick.Merge(m, src)
+}
+func (m *NumbersPick) XXX_Size() int {
+	return m.Size()
+}
+func (m *NumbersPick) XXX_DiscardUnknown() {
+	xxx_messageInfo_NumbersPick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NumbersPick proto.InternalMessageInfo
+
+func (m *NumbersPick) GetNumbers() []uint32 {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
 // MsgBuyTicketsResponse defines the Msg/BuyTickets response type.
 type MsgBuyTicketsResponse struct {
 }
@@ -76,7 +139,7 @@ func (m *MsgBuyTicketsResponse) Reset()         { *m = MsgBuyTicketsResponse{} }
 func (m *MsgBuyTicketsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBuyTicketsResponse) ProtoMessage()    {}
 func (*MsgBuyTicketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9888ea286364cef7, []int{1}
+	return fileDescriptor_9888ea286364cef7, []int{2}
 }
 func (m *MsgBuyTicketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,218 +168,3011 @@ func (m *MsgBuyTicketsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBuyTicketsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgBuyTickets)(nil), "cosmicbet.wta.v1beta1.MsgBuyTickets")
-	proto.RegisterType((*MsgBuyTicketsResponse)(nil), "cosmicbet.wta.v1beta1.MsgBuyTicketsResponse")
+// MsgCommitEntropy represents the message to use to commit to an entropy value
+// that will later be revealed to seed the current draw.
+type MsgCommitEntropy struct {
+	Validator  string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty" yaml:"commitment"`
+	PoolId     uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func init() { proto.RegisterFile("cosmicbet/wta/v1beta1/msgs.proto", fileDescriptor_9888ea286364cef7) }
+func (m *MsgCommitEntropy) Reset()         { *m = MsgCommitEntropy{} }
+func (m *MsgCommitEntropy) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitEntropy) ProtoMessage()    {}
+func (*MsgCommitEntropy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{3}
+}
+func (m *MsgCommitEntropy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitEntropy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitEntropy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitEntropy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitEntropy.Merge(m, src)
+}
+func (m *MsgCommitEntropy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitEntropy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitEntropy.DiscardUnknown(m)
+}
 
-var fileDescriptor_9888ea286364cef7 = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x4c, 0x4e, 0x4a, 0x2d, 0xd1, 0x2f, 0x2f, 0x49, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0xcf, 0x2d, 0x4e, 0x2f, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x85, 0xab,
-	0xd0, 0x2b, 0x2f, 0x49, 0xd4, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd0,
-	0x07, 0xb1, 0x20, 0x8a, 0x95, 0x8a, 0xb8, 0x78, 0x7d, 0x8b, 0xd3, 0x9d, 0x4a, 0x2b, 0x43, 0x32,
-	0x93, 0xb3, 0x53, 0x4b, 0x8a, 0x85, 0xf4, 0xb9, 0x38, 0x0a, 0x4b, 0x13, 0xf3, 0x4a, 0x32, 0x4b,
-	0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x78, 0x9d, 0x84, 0x3f, 0xdd, 0x93, 0xe7, 0xaf, 0x4c, 0xcc,
-	0xcd, 0xb1, 0x52, 0x82, 0xc9, 0x28, 0x05, 0xc1, 0x15, 0x09, 0xa9, 0x71, 0xb1, 0x26, 0x95, 0x56,
-	0xa6, 0x16, 0x49, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x3a, 0x09, 0x7c, 0xba, 0x27, 0xcf, 0x03, 0x51,
-	0x0d, 0x16, 0x56, 0x0a, 0x82, 0x48, 0x5b, 0x71, 0x74, 0x2c, 0x90, 0x67, 0x78, 0xb1, 0x40, 0x9e,
-	0x41, 0x49, 0x9c, 0x4b, 0x14, 0xc5, 0xce, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa3,
-	0x74, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x04, 0x2e, 0x2e, 0x24, 0x07, 0xa9, 0xe8, 0x61, 0xf5,
-	0x8f, 0x1e, 0x8a, 0x11, 0x52, 0x3a, 0xc4, 0xa8, 0x82, 0x59, 0xe4, 0xe4, 0x78, 0xe2, 0x91, 0x1c,
-	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xea, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
-	0xf9, 0xb9, 0xfa, 0x88, 0x90, 0xce, 0x49, 0x4d, 0x49, 0x4f, 0x2d, 0xd2, 0xaf, 0x00, 0x07, 0x79,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xfc, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xc2, 0x52, 0xda, 0x97, 0x90, 0x01, 0x00, 0x00,
+var xxx_messageInfo_MsgCommitEntropy proto.InternalMessageInfo
+
+// MsgCommitEntropyResponse defines the Msg/CommitEntropy response type.
+type MsgCommitEntropyResponse struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgCommitEntropyResponse) Reset()         { *m = MsgCommitEntropyResponse{} }
+func (m *MsgCommitEntropyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitEntropyResponse) ProtoMessage()    {}
+func (*MsgCommitEntropyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{4}
+}
+func (m *MsgCommitEntropyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitEntropyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitEntropyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitEntropyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitEntropyResponse.Merge(m, src)
+}
+func (m *MsgCommitEntropyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitEntropyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitEntropyResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgCommitEntropyResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// BuyTickets defines the method to buy one or more lottery tickets
-	BuyTickets(ctx context.Context, in *MsgBuyTickets, opts ...grpc.CallOption) (*MsgBuyTicketsResponse, error)
+// MsgRevealEntropy represents the message to use to reveal the entropy value
+// previously committed for the current draw.
+type MsgRevealEntropy struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	Entropy   []byte `protobuf:"bytes,2,opt,name=entropy,proto3" json:"entropy,omitempty" yaml:"entropy"`
+	PoolId    uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRevealEntropy) Reset()         { *m = MsgRevealEntropy{} }
+func (m *MsgRevealEntropy) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealEntropy) ProtoMessage()    {}
+func (*MsgRevealEntropy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{5}
+}
+func (m *MsgRevealEntropy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealEntropy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealEntropy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealEntropy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealEntropy.Merge(m, src)
+}
+func (m *MsgRevealEntropy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealEntropy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealEntropy.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgRevealEntropy proto.InternalMessageInfo
+
+// MsgRevealEntropyResponse defines the Msg/RevealEntropy response type.
+type MsgRevealEntropyResponse struct {
 }
 
-func (c *msgClient) BuyTickets(ctx context.Context, in *MsgBuyTickets, opts ...grpc.CallOption) (*MsgBuyTicketsResponse, error) {
-	out := new(MsgBuyTicketsResponse)
-	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Msg/BuyTickets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealEntropyResponse) Reset()         { *m = MsgRevealEntropyResponse{} }
+func (m *MsgRevealEntropyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealEntropyResponse) ProtoMessage()    {}
+func (*MsgRevealEntropyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{6}
+}
+func (m *MsgRevealEntropyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealEntropyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealEntropyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// BuyTickets defines the method to buy one or more lottery tickets
-	BuyTickets(context.Context, *MsgBuyTickets) (*MsgBuyTicketsResponse, error)
+func (m *MsgRevealEntropyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealEntropyResponse.Merge(m, src)
+}
+func (m *MsgRevealEntropyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealEntropyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealEntropyResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgRevealEntropyResponse proto.InternalMessageInfo
+
+// MsgSelfExclude represents the message to use to prevent an address from
+// buying any ticket until the given time. An existing exclusion can only be
+// extended until it expires.
+type MsgSelfExclude struct {
+	Address string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Until   time.Time `protobuf:"bytes,2,opt,name=until,proto3,stdtime" json:"until" yaml:"until"`
 }
 
-func (*UnimplementedMsgServer) BuyTickets(ctx context.Context, req *MsgBuyTickets) (*MsgBuyTicketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BuyTickets not implemented")
+func (m *MsgSelfExclude) Reset()         { *m = MsgSelfExclude{} }
+func (m *MsgSelfExclude) String() string { return proto.CompactTextString(m) }
+func (*MsgSelfExclude) ProtoMessage()    {}
+func (*MsgSelfExclude) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{7}
+}
+func (m *MsgSelfExclude) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSelfExclude) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSelfExclude.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSelfExclude) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSelfExclude.Merge(m, src)
+}
+func (m *MsgSelfExclude) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSelfExclude) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSelfExclude.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSelfExclude proto.InternalMessageInfo
+
+// MsgSelfExcludeResponse defines the Msg/SelfExclude response type.
+type MsgSelfExcludeResponse struct {
 }
 
-func _Msg_BuyTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBuyTickets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BuyTickets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmicbet.wta.v1beta1.Msg/BuyTickets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BuyTickets(ctx, req.(*MsgBuyTickets))
+func (m *MsgSelfExcludeResponse) Reset()         { *m = MsgSelfExcludeResponse{} }
+func (m *MsgSelfExcludeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSelfExcludeResponse) ProtoMessage()    {}
+func (*MsgSelfExcludeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{8}
+}
+func (m *MsgSelfExcludeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSelfExcludeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSelfExcludeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSelfExcludeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSelfExcludeResponse.Merge(m, src)
+}
+func (m *MsgSelfExcludeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSelfExcludeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSelfExcludeResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmicbet.wta.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BuyTickets",
-			Handler:    _Msg_BuyTickets_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmicbet/wta/v1beta1/msgs.proto",
+var xxx_messageInfo_MsgSelfExcludeResponse proto.InternalMessageInfo
+
+// MsgSetSpendingLimit represents the message to use to limit the amount that
+// an address can spend buying tickets within a rolling window. Raised limits
+// replace the current one only after a cooling-off delay, while lowered ones
+// are applied immediately. An empty amount removes the limit.
+type MsgSetSpendingLimit struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	Window  time.Duration                            `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
 }
 
-func (m *MsgBuyTickets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetSpendingLimit) Reset()         { *m = MsgSetSpendingLimit{} }
+func (m *MsgSetSpendingLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendingLimit) ProtoMessage()    {}
+func (*MsgSetSpendingLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{9}
+}
+func (m *MsgSetSpendingLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSpendingLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSpendingLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetSpendingLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendingLimit.Merge(m, src)
+}
+func (m *MsgSetSpendingLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSpendingLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendingLimit.DiscardUnknown(m)
 }
 
-func (m *MsgBuyTickets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetSpendingLimit proto.InternalMessageInfo
+
+// MsgSetSpendingLimitResponse defines the Msg/SetSpendingLimit response type.
+type MsgSetSpendingLimitResponse struct {
 }
 
-func (m *MsgBuyTickets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Buyer) > 0 {
-		i -= len(m.Buyer)
-		copy(dAtA[i:], m.Buyer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Buyer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Quantity != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Quantity))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSetSpendingLimitResponse) Reset()         { *m = MsgSetSpendingLimitResponse{} }
+func (m *MsgSetSpendingLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendingLimitResponse) ProtoMessage()    {}
+func (*MsgSetSpendingLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{10}
+}
+func (m *MsgSetSpendingLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSpendingLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSpendingLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetSpendingLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendingLimitResponse.Merge(m, src)
+}
+func (m *MsgSetSpendingLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSpendingLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendingLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBuyTicketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetSpendingLimitResponse proto.InternalMessageInfo
+
+// MsgPostPrice represents the message used by a whitelisted oracle to post the
+// price of a denomination, expressed in the reference unit of the price feed.
+// Each new price replaces the previous one posted by the same oracle for the
+// same denomination.
+type MsgPostPrice struct {
+	Oracle string                                 `protobuf:"bytes,1,opt,name=oracle,proto3" json:"oracle,omitempty" yaml:"oracle"`
+	Denom  string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Price  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+}
+
+func (m *MsgPostPrice) Reset()         { *m = MsgPostPrice{} }
+func (m *MsgPostPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgPostPrice) ProtoMessage()    {}
+func (*MsgPostPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{11}
+}
+func (m *MsgPostPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostPrice.Merge(m, src)
+}
+func (m *MsgPostPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostPrice proto.InternalMessageInfo
+
+// MsgPostPriceResponse defines the Msg/PostPrice response type.
+type MsgPostPriceResponse struct {
+}
+
+func (m *MsgPostPriceResponse) Reset()         { *m = MsgPostPriceResponse{} }
+func (m *MsgPostPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPostPriceResponse) ProtoMessage()    {}
+func (*MsgPostPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{12}
+}
+func (m *MsgPostPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostPriceResponse.Merge(m, src)
+}
+func (m *MsgPostPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostPriceResponse proto.InternalMessageInfo
+
+// MsgTransferTickets represents the message to use to transfer one or more
+// tickets of the current draw of a pool to another address, before the sales
+// cutoff of the draw.
+type MsgTransferTickets struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	PoolId    uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// Ids of the tickets to be transferred, which must all be owned by the
+	// owner
+	TicketIds []string `protobuf:"bytes,4,rep,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty" yaml:"ticket_ids"`
+}
+
+func (m *MsgTransferTickets) Reset()         { *m = MsgTransferTickets{} }
+func (m *MsgTransferTickets) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTickets) ProtoMessage()    {}
+func (*MsgTransferTickets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{13}
+}
+func (m *MsgTransferTickets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferTickets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferTickets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferTickets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTickets.Merge(m, src)
+}
+func (m *MsgTransferTickets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferTickets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTickets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferTickets proto.InternalMessageInfo
+
+// MsgTransferTicketsResponse defines the Msg/TransferTickets response type.
+type MsgTransferTicketsResponse struct {
+}
+
+func (m *MsgTransferTicketsResponse) Reset()         { *m = MsgTransferTicketsResponse{} }
+func (m *MsgTransferTicketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTicketsResponse) ProtoMessage()    {}
+func (*MsgTransferTicketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9888ea286364cef7, []int{14}
+}
+func (m *MsgTransferTicketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferTicketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferTicketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferTicketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTicketsResponse.Merge(m, src)
+}
+func (m *MsgTransferTicketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferTicketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTicketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferTicketsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgBuyTickets)(nil), "cosmicbet.wta.v1beta1.MsgBuyTickets")
+	proto.RegisterType((*NumbersPick)(nil), "cosmicbet.wta.v1beta1.NumbersPick")
+	proto.RegisterType((*MsgBuyTicketsResponse)(nil), "cosmicbet.wta.v1beta1.MsgBuyTicketsResponse")
+	proto.RegisterType((*MsgCommitEntropy)(nil), "cosmicbet.wta.v1beta1.MsgCommitEntropy")
+	proto.RegisterType((*MsgCommitEntropyResponse)(nil), "cosmicbet.wta.v1beta1.MsgCommitEntropyResponse")
+	proto.RegisterType((*MsgRevealEntropy)(nil), "cosmicbet.wta.v1beta1.MsgRevealEntropy")
+	proto.RegisterType((*MsgRevealEntropyResponse)(nil), "cosmicbet.wta.v1beta1.MsgRevealEntropyResponse")
+	proto.RegisterType((*MsgSelfExclude)(nil), "cosmicbet.wta.v1beta1.MsgSelfExclude")
+	proto.RegisterType((*MsgSelfExcludeResponse)(nil), "cosmicbet.wta.v1beta1.MsgSelfExcludeResponse")
+	proto.RegisterType((*MsgSetSpendingLimit)(nil), "cosmicbet.wta.v1beta1.MsgSetSpendingLimit")
+	proto.RegisterType((*MsgSetSpendingLimitResponse)(nil), "cosmicbet.wta.v1beta1.MsgSetSpendingLimitResponse")
+	proto.RegisterType((*MsgPostPrice)(nil), "cosmicbet.wta.v1beta1.MsgPostPrice")
+	proto.RegisterType((*MsgPostPriceResponse)(nil), "cosmicbet.wta.v1beta1.MsgPostPriceResponse")
+	proto.RegisterType((*MsgTransferTickets)(nil), "cosmicbet.wta.v1beta1.MsgTransferTickets")
+	proto.RegisterType((*MsgTransferTicketsResponse)(nil), "cosmicbet.wta.v1beta1.MsgTransferTicketsResponse")
+}
+
+func init() { proto.RegisterFile("cosmicbet/wta/v1beta1/msgs.proto", fileDescriptor_9888ea286364cef7) }
+
+var fileDescriptor_9888ea286364cef7 = []byte{
+	// 1015 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0xb3, 0x4d, 0xb6, 0x99, 0xdd, 0xb4, 0x5b, 0x77, 0xb7, 0xb8, 0x06, 0xe2, 0x68, 0x80,
+	0x6d, 0x4a, 0xb7, 0xb6, 0x36, 0xc0, 0xa5, 0x48, 0x48, 0x75, 0xdb, 0x43, 0x51, 0x53, 0xad, 0xdc,
+	0x3d, 0x21, 0xa1, 0xe2, 0xd8, 0xb3, 0x66, 0xb4, 0xb6, 0xc7, 0x78, 0xc6, 0x9b, 0xe6, 0x1f, 0x70,
+	0x2c, 0x12, 0x07, 0x8e, 0x3d, 0xc3, 0x95, 0x3f, 0xc0, 0xad, 0x12, 0x97, 0x1e, 0x11, 0x12, 0x29,
+	0xda, 0x95, 0x10, 0xe7, 0xfc, 0x82, 0xca, 0x33, 0xb6, 0x63, 0xa7, 0xcd, 0x2a, 0xab, 0x3d, 0x25,
+	0xf6, 0xfb, 0xde, 0x7b, 0xdf, 0xf7, 0x26, 0xef, 0x9b, 0x80, 0xae, 0x43, 0x68, 0x80, 0x9d, 0x21,
+	0x62, 0xc6, 0x88, 0xd9, 0xc6, 0xd1, 0xee, 0x10, 0x31, 0x7b, 0xd7, 0x08, 0xa8, 0x47, 0xf5, 0x28,
+	0x26, 0x8c, 0xc8, 0x5b, 0x05, 0x42, 0x1f, 0x31, 0x5b, 0xcf, 0x10, 0xea, 0xa6, 0x47, 0x3c, 0xc2,
+	0x11, 0x46, 0xfa, 0x4d, 0x80, 0x55, 0xcd, 0x23, 0xc4, 0xf3, 0x91, 0xc1, 0x9f, 0x86, 0xc9, 0x81,
+	0xc1, 0x70, 0x80, 0x28, 0xb3, 0x83, 0x28, 0x03, 0x74, 0xe6, 0x01, 0x6e, 0x12, 0xdb, 0x0c, 0x93,
+	0x30, 0x8f, 0xa7, 0xdd, 0x08, 0x35, 0x86, 0x36, 0x45, 0x05, 0x1b, 0x87, 0xe0, 0x2c, 0x0e, 0xff,
+	0xac, 0x83, 0xf6, 0x80, 0x7a, 0x66, 0x32, 0xde, 0xc7, 0xce, 0x21, 0x62, 0x54, 0x36, 0xc0, 0xc5,
+	0x1f, 0x12, 0x3b, 0x64, 0x98, 0x8d, 0x15, 0xa9, 0x2b, 0xf5, 0xda, 0xe6, 0xd5, 0xe9, 0x44, 0xbb,
+	0x3c, 0xb6, 0x03, 0xff, 0x0e, 0xcc, 0x23, 0xd0, 0x2a, 0x40, 0xf2, 0x36, 0x68, 0x0c, 0x93, 0x31,
+	0x8a, 0x95, 0x7a, 0x57, 0xea, 0xb5, 0xcc, 0x8d, 0xe9, 0x44, 0x5b, 0x17, 0x68, 0xfe, 0x1a, 0x5a,
+	0x22, 0x2c, 0xdf, 0x02, 0xab, 0x11, 0x21, 0xfe, 0x53, 0xec, 0x2a, 0x2b, 0x5d, 0xa9, 0x77, 0xc1,
+	0x94, 0xa7, 0x13, 0xed, 0x92, 0x40, 0x66, 0x01, 0x68, 0x35, 0xd3, 0x6f, 0x0f, 0x5d, 0xf9, 0x31,
+	0x68, 0x44, 0xd8, 0x39, 0xa4, 0xca, 0x85, 0xee, 0x4a, 0x6f, 0xad, 0x0f, 0xf5, 0x77, 0x4e, 0x4d,
+	0x7f, 0x9c, 0x04, 0x43, 0x14, 0xd3, 0x3d, 0xec, 0x1c, 0x9a, 0x9b, 0x2f, 0x27, 0x5a, 0x6d, 0xd6,
+	0x9c, 0xa7, 0x43, 0x4b, 0x94, 0x49, 0x49, 0xba, 0x28, 0x24, 0x81, 0xd2, 0x98, 0x27, 0xc9, 0x5f,
+	0x43, 0x4b, 0x84, 0xe5, 0x3e, 0x68, 0xc5, 0xc8, 0xc1, 0x11, 0x46, 0x21, 0x53, 0x9a, 0x1c, 0xbb,
+	0x39, 0x9d, 0x68, 0x1b, 0x02, 0x5b, 0x84, 0xa0, 0x35, 0x83, 0xdd, 0xb9, 0xf8, 0xe3, 0x0b, 0xad,
+	0xf6, 0xff, 0x0b, 0xad, 0x06, 0xbf, 0x04, 0x6b, 0x25, 0x46, 0xf2, 0x0e, 0x58, 0x0d, 0xc5, 0xa3,
+	0x22, 0x75, 0x57, 0x7a, 0xed, 0xb2, 0xe2, 0x2c, 0x00, 0xad, 0x1c, 0x02, 0xdf, 0x03, 0x5b, 0x95,
+	0x93, 0xb0, 0x10, 0x8d, 0x48, 0x48, 0x11, 0xfc, 0x5d, 0x02, 0x1b, 0x03, 0xea, 0xdd, 0x23, 0x41,
+	0x80, 0xd9, 0x83, 0x90, 0xc5, 0x24, 0x1a, 0xa7, 0x44, 0x8f, 0x6c, 0x1f, 0xbb, 0x36, 0x23, 0xb1,
+	0x22, 0xcd, 0x13, 0x2d, 0x42, 0xd0, 0x9a, 0xc1, 0xe4, 0x2f, 0x00, 0x70, 0x78, 0x91, 0x20, 0x55,
+	0x97, 0x1e, 0xd7, 0xba, 0xb9, 0x35, 0x9d, 0x68, 0x57, 0x44, 0xd2, 0x2c, 0x06, 0xad, 0x12, 0xf0,
+	0x4c, 0x07, 0x57, 0x1a, 0x86, 0x0a, 0x94, 0x79, 0xd6, 0x85, 0xa4, 0xdf, 0x84, 0x24, 0x0b, 0x1d,
+	0x21, 0xdb, 0x3f, 0x8f, 0xa4, 0x1d, 0xb0, 0x8a, 0x44, 0x7a, 0xa6, 0xa7, 0xc4, 0x2d, 0x0b, 0x40,
+	0x2b, 0x87, 0x9c, 0x4f, 0x49, 0x85, 0x6c, 0xa1, 0xe4, 0x67, 0x09, 0x5c, 0x1a, 0x50, 0xef, 0x09,
+	0xf2, 0x0f, 0x1e, 0x3c, 0x73, 0xfc, 0xc4, 0x45, 0x29, 0x27, 0xdb, 0x75, 0x63, 0x44, 0x69, 0xa6,
+	0xa2, 0xd4, 0x25, 0x0b, 0x40, 0x2b, 0x87, 0xc8, 0x5f, 0x83, 0x46, 0x12, 0x32, 0xec, 0x73, 0xfe,
+	0x6b, 0x7d, 0x55, 0x17, 0x1b, 0xad, 0xe7, 0x1b, 0xad, 0xef, 0xe7, 0x2b, 0x6f, 0x2a, 0xd5, 0x5f,
+	0x38, 0x4f, 0x83, 0xcf, 0x5f, 0x6b, 0x92, 0x25, 0x4a, 0x94, 0x28, 0x2b, 0xe0, 0x5a, 0x95, 0x55,
+	0x41, 0xf8, 0xa7, 0x3a, 0xb8, 0xca, 0x43, 0xec, 0x49, 0x84, 0x42, 0x17, 0x87, 0xde, 0x23, 0x1c,
+	0x60, 0x76, 0x46, 0xd6, 0x0c, 0x34, 0xed, 0x80, 0x24, 0xfc, 0x67, 0x94, 0x2e, 0xe8, 0x75, 0x5d,
+	0x18, 0x8d, 0x9e, 0x1a, 0x4d, 0xb1, 0x9e, 0xf7, 0x08, 0x0e, 0xcd, 0xbb, 0x19, 0xeb, 0x76, 0x56,
+	0x8b, 0xa7, 0xc1, 0x5f, 0x5f, 0x6b, 0x3d, 0x0f, 0xb3, 0xef, 0x93, 0xa1, 0xee, 0x90, 0xc0, 0xc8,
+	0x6c, 0x4a, 0x7c, 0xdc, 0xa6, 0xee, 0xa1, 0xc1, 0xc6, 0x11, 0xa2, 0xbc, 0x02, 0xb5, 0xb2, 0x5e,
+	0xf2, 0x23, 0xd0, 0x1c, 0xe1, 0xd0, 0x25, 0x23, 0x7e, 0x7c, 0x69, 0xd7, 0xf9, 0x61, 0xdd, 0xcf,
+	0xec, 0xcf, 0xbc, 0x5e, 0xed, 0x2a, 0xd2, 0xe0, 0x2f, 0xe9, 0xb0, 0xb2, 0x1a, 0xa5, 0x69, 0x7d,
+	0x08, 0xde, 0x7f, 0xc7, 0x48, 0x8a, 0x91, 0xfd, 0x21, 0x81, 0xf5, 0x01, 0xf5, 0xf6, 0x08, 0x65,
+	0x7b, 0x31, 0x76, 0x90, 0x7c, 0x13, 0x34, 0x49, 0x6c, 0x3b, 0x3e, 0xca, 0x46, 0x75, 0x65, 0xd6,
+	0x48, 0xbc, 0x87, 0x56, 0x06, 0x98, 0x19, 0x4f, 0xfd, 0x74, 0xe3, 0xd9, 0x07, 0x8d, 0x28, 0xad,
+	0xcd, 0x95, 0xb5, 0xcc, 0xaf, 0x52, 0xfa, 0x7f, 0x4f, 0xb4, 0xed, 0x25, 0x66, 0x74, 0x1f, 0x39,
+	0xb3, 0xaa, 0xbc, 0x48, 0x6a, 0x7b, 0xe9, 0x67, 0x49, 0xe2, 0x35, 0xb0, 0x59, 0x96, 0x50, 0x68,
+	0xfb, 0x47, 0x02, 0xf2, 0x80, 0x7a, 0xfb, 0xb1, 0x1d, 0xd2, 0x03, 0x14, 0xe7, 0xb7, 0xc0, 0x36,
+	0x68, 0x90, 0x51, 0x88, 0xf2, 0x3d, 0x2c, 0xd1, 0xe6, 0xaf, 0xa1, 0x25, 0xc2, 0x55, 0xbf, 0xac,
+	0x2f, 0xe5, 0x97, 0x67, 0xbb, 0x08, 0x3e, 0x07, 0x80, 0x71, 0x4e, 0x4f, 0xb1, 0x2b, 0x6e, 0x83,
+	0x56, 0xd9, 0xb3, 0x66, 0x31, 0x68, 0xb5, 0xc4, 0xc3, 0x43, 0x97, 0x96, 0x74, 0x7f, 0x00, 0xd4,
+	0xb7, 0xe5, 0xe5, 0xea, 0xfb, 0xff, 0x35, 0xc0, 0xca, 0x80, 0x7a, 0xf2, 0x77, 0x00, 0x94, 0xae,
+	0xc0, 0x8f, 0x17, 0xdc, 0x36, 0x15, 0x7b, 0x56, 0x77, 0x96, 0x41, 0xe5, 0x9d, 0x64, 0x0c, 0xda,
+	0x55, 0x03, 0xbf, 0xb1, 0x38, 0xbd, 0x02, 0x54, 0x8d, 0x25, 0x81, 0xe5, 0x56, 0x55, 0x63, 0x3d,
+	0xa5, 0x55, 0x05, 0xa8, 0x1a, 0x4b, 0x02, 0x8b, 0x56, 0x0e, 0x58, 0x2b, 0x3b, 0xdf, 0x27, 0x8b,
+	0xf3, 0x4b, 0x30, 0xf5, 0xf6, 0x52, 0xb0, 0xa2, 0x49, 0x0c, 0x36, 0xde, 0x72, 0xab, 0x4f, 0x4f,
+	0x2b, 0x51, 0xc5, 0xaa, 0xfd, 0xe5, 0xb1, 0x45, 0xcf, 0x6f, 0x41, 0x6b, 0xb6, 0xee, 0x1f, 0x2d,
+	0x2e, 0x50, 0x80, 0xd4, 0x5b, 0x4b, 0x80, 0x8a, 0xf2, 0x04, 0x5c, 0x9e, 0xdf, 0xb8, 0x9b, 0x8b,
+	0xf3, 0xe7, 0xa0, 0xea, 0xee, 0xd2, 0xd0, 0xbc, 0xa1, 0x79, 0xf7, 0xe5, 0x71, 0x47, 0x7a, 0x75,
+	0xdc, 0x91, 0xfe, 0x3d, 0xee, 0x48, 0xcf, 0x4f, 0x3a, 0xb5, 0x57, 0x27, 0x9d, 0xda, 0x5f, 0x27,
+	0x9d, 0xda, 0x37, 0x37, 0xe6, 0x1c, 0x46, 0xfc, 0x79, 0xf5, 0x91, 0xeb, 0xa1, 0xd8, 0x78, 0xc6,
+	0xff, 0xc5, 0x72, 0x9b, 0x19, 0x36, 0xb9, 0xc9, 0x7e, 0xf6, 0x26, 0x00, 0x00, 0xff, 0xff, 0xce,
+	0xc7, 0x8c, 0xe0, 0xe3, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// BuyTickets defines the method to buy one or more lottery tickets
+	BuyTickets(ctx context.Context, in *MsgBuyTickets, opts ...grpc.CallOption) (*MsgBuyTicketsResponse, error)
+	// CommitEntropy defines the method to commit to a hashed entropy value that
+	// will be used to seed the current draw
+	CommitEntropy(ctx context.Context, in *MsgCommitEntropy, opts ...grpc.CallOption) (*MsgCommitEntropyResponse, error)
+	// RevealEntropy defines the method to reveal a previously committed entropy
+	// value
+	RevealEntropy(ctx context.Context, in *MsgRevealEntropy, opts ...grpc.CallOption) (*MsgRevealEntropyResponse, error)
+	// SelfExclude defines the method to prevent an address from buying any
+	// ticket until a given time
+	SelfExclude(ctx context.Context, in *MsgSelfExclude, opts ...grpc.CallOption) (*MsgSelfExcludeResponse, error)
+	// SetSpendingLimit defines the method to set the max amount that an address
+	// can spend buying tickets within a rolling window
+	SetSpendingLimit(ctx context.Context, in *MsgSetSpendingLimit, opts ...grpc.CallOption) (*MsgSetSpendingLimitResponse, error)
+	// PostPrice defines the method used by the oracles to post the price of a
+	// denomination
+	PostPrice(ctx context.Context, in *MsgPostPrice, opts ...grpc.CallOption) (*MsgPostPriceResponse, error)
+	// TransferTickets defines the method to transfer one or more tickets of the
+	// current draw of a pool to another address
+	TransferTickets(ctx context.Context, in *MsgTransferTickets, opts ...grpc.CallOption) (*MsgTransferTicketsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) BuyTickets(ctx context.Context, in *MsgBuyTickets, opts ...grpc.CallOption) (*MsgBuyTicketsResponse, error) {
+	out := new(MsgBuyTicketsResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Msg/BuyTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitEntropy(ctx context.Context, in *MsgCommitEntropy, opts ...grpc.CallOption) (*MsgCommitEntropyResponse, error) {
+	out := new(MsgCommitEntropyResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Msg/CommitEntropy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealEntropy(ctx context.Context, in *MsgRevealEntropy, opts ...grpc.CallOption) (*MsgRevealEntropyResponse, error) {
+	out := new(MsgRevealEntropyResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Msg/RevealEntropy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SelfExclude(ctx context.Context, in *MsgSelfExclude, opts ...grpc.CallOption) (*MsgSelfExcludeResponse, error) {
+	out := new(MsgSelfExcludeResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Msg/SelfExclude", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSpendingLimit(ctx context.Context, in *MsgSetSpendingLimit, opts ...grpc.CallOption) (*MsgSetSpendingLimitResponse, error) {
+	out := new(MsgSetSpendingLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Msg/SetSpendingLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PostPrice(ctx context.Context, in *MsgPostPrice, opts ...grpc.CallOption) (*MsgPostPriceResponse, error) {
+	out := new(MsgPostPriceResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Msg/PostPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferTickets(ctx context.Context, in *MsgTransferTickets, opts ...grpc.CallOption) (*MsgTransferTicketsResponse, error) {
+	out := new(MsgTransferTicketsResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Msg/TransferTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// BuyTickets defines the method to buy one or more lottery tickets
+	BuyTickets(context.Context, *MsgBuyTickets) (*MsgBuyTicketsResponse, error)
+	// CommitEntropy defines the method to commit to a hashed entropy value that
+	// will be used to seed the current draw
+	CommitEntropy(context.Context, *MsgCommitEntropy) (*MsgCommitEntropyResponse, error)
+	// RevealEntropy defines the method to reveal a previously committed entropy
+	// value
+	RevealEntropy(context.Context, *MsgRevealEntropy) (*MsgRevealEntropyResponse, error)
+	// SelfExclude defines the method to prevent an address from buying any
+	// ticket until a given time
+	SelfExclude(context.Context, *MsgSelfExclude) (*MsgSelfExcludeResponse, error)
+	// SetSpendingLimit defines the method to set the max amount that an address
+	// can spend buying tickets within a rolling window
+	SetSpendingLimit(context.Context, *MsgSetSpendingLimit) (*MsgSetSpendingLimitResponse, error)
+	// PostPrice defines the method used by the oracles to post the price of a
+	// denomination
+	PostPrice(context.Context, *MsgPostPrice) (*MsgPostPriceResponse, error)
+	// TransferTickets defines the method to transfer one or more tickets of the
+	// current draw of a pool to another address
+	TransferTickets(context.Context, *MsgTransferTickets) (*MsgTransferTicketsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) BuyTickets(ctx context.Context, req *MsgBuyTickets) (*MsgBuyTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyTickets not implemented")
+}
+func (*UnimplementedMsgServer) CommitEntropy(ctx context.Context, req *MsgCommitEntropy) (*MsgCommitEntropyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitEntropy not implemented")
+}
+func (*UnimplementedMsgServer) RevealEntropy(ctx context.Context, req *MsgRevealEntropy) (*MsgRevealEntropyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealEntropy not implemented")
+}
+func (*UnimplementedMsgServer) SelfExclude(ctx context.Context, req *MsgSelfExclude) (*MsgSelfExcludeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfExclude not implemented")
+}
+func (*UnimplementedMsgServer) SetSpendingLimit(ctx context.Context, req *MsgSetSpendingLimit) (*MsgSetSpendingLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingLimit not implemented")
+}
+func (*UnimplementedMsgServer) PostPrice(ctx context.Context, req *MsgPostPrice) (*MsgPostPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostPrice not implemented")
+}
+func (*UnimplementedMsgServer) TransferTickets(ctx context.Context, req *MsgTransferTickets) (*MsgTransferTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTickets not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_BuyTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyTickets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Msg/BuyTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyTickets(ctx, req.(*MsgBuyTickets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitEntropy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitEntropy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitEntropy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Msg/CommitEntropy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitEntropy(ctx, req.(*MsgCommitEntropy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealEntropy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealEntropy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealEntropy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Msg/RevealEntropy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealEntropy(ctx, req.(*MsgRevealEntropy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SelfExclude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSelfExclude)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SelfExclude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Msg/SelfExclude",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SelfExclude(ctx, req.(*MsgSelfExclude))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSpendingLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSpendingLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSpendingLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Msg/SetSpendingLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSpendingLimit(ctx, req.(*MsgSetSpendingLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PostPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPostPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PostPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Msg/PostPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PostPrice(ctx, req.(*MsgPostPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTickets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Msg/TransferTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTickets(ctx, req.(*MsgTransferTickets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmicbet.wta.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BuyTickets",
+			Handler:    _Msg_BuyTickets_Handler,
+		},
+		{
+			MethodName: "CommitEntropy",
+			Handler:    _Msg_CommitEntropy_Handler,
+		},
+		{
+			MethodName: "RevealEntropy",
+			Handler:    _Msg_RevealEntropy_Handler,
+		},
+		{
+			MethodName: "SelfExclude",
+			Handler:    _Msg_SelfExclude_Handler,
+		},
+		{
+			MethodName: "SetSpendingLimit",
+			Handler:    _Msg_SetSpendingLimit_Handler,
+		},
+		{
+			MethodName: "PostPrice",
+			Handler:    _Msg_PostPrice_Handler,
+		},
+		{
+			MethodName: "TransferTickets",
+			Handler:    _Msg_TransferTickets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmicbet/wta/v1beta1/msgs.proto",
+}
+
+func (m *MsgBuyTickets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyTickets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyTickets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Picks) > 0 {
+		for iNdEx := len(m.Picks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Picks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NumbersPick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumbersPick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumbersPick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Numbers) > 0 {
+		dAtA2 := make([]byte, len(m.Numbers)*10)
+		var j1 int
+		for _, num := range m.Numbers {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintMsgs(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyTicketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyTicketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyTicketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitEntropy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitEntropy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitEntropy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitEntropyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitEntropyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitEntropyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealEntropy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealEntropy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealEntropy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Entropy) > 0 {
+		i -= len(m.Entropy)
+		copy(dAtA[i:], m.Entropy)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Entropy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealEntropyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealEntropyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealEntropyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelfExclude) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelfExclude) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelfExclude) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Until, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Until):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMsgs(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelfExcludeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelfExcludeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelfExcludeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSpendingLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendingLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendingLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMsgs(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSpendingLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendingLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendingLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPostPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPostPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTickets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTickets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTickets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TicketIds) > 0 {
+		for iNdEx := len(m.TicketIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TicketIds[iNdEx])
+			copy(dAtA[i:], m.TicketIds[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.TicketIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTicketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTicketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTicketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBuyTickets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quantity != 0 {
+		n += 1 + sovMsgs(uint64(m.Quantity))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovMsgs(uint64(m.PoolId))
+	}
+	if len(m.Picks) > 0 {
+		for _, e := range m.Picks {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *NumbersPick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Numbers) > 0 {
+		l = 0
+		for _, e := range m.Numbers {
+			l += sovMsgs(uint64(e))
+		}
+		n += 1 + sovMsgs(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgBuyTicketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitEntropy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovMsgs(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgCommitEntropyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealEntropy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Entropy)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovMsgs(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgRevealEntropyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSelfExclude) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Until)
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSelfExcludeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSpendingLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSetSpendingLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPostPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgPostPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferTickets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovMsgs(uint64(m.PoolId))
+	}
+	if len(m.TicketIds) > 0 {
+		for _, s := range m.TicketIds {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTransferTicketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBuyTickets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyTickets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyTickets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Picks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Picks = append(m.Picks, NumbersPick{})
+			if err := m.Picks[len(m.Picks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumbersPick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumbersPick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumbersPick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Numbers = append(m.Numbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Numbers) == 0 {
+					m.Numbers = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Numbers = append(m.Numbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numbers", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyTicketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyTicketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyTicketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitEntropy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitEntropy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitEntropy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitEntropyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitEntropyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitEntropyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealEntropy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealEntropy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealEntropy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entropy = append(m.Entropy[:0], dAtA[iNdEx:postIndex]...)
+			if m.Entropy == nil {
+				m.Entropy = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealEntropyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealEntropyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealEntropyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSelfExclude) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSelfExclude: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSelfExclude: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Until, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSelfExcludeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSelfExcludeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSelfExcludeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSpendingLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSpendingLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSpendingLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSpendingLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSpendingLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSpendingLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPostPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPostPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPostPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBuyTicketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgBuyTicketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgBuyTickets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Quantity != 0 {
-		n += 1 + sovMsgs(uint64(m.Quantity))
-	}
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgPostPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPostPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPostPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgBuyTicketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgBuyTickets) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferTickets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -339,17 +3195,17 @@ func (m *MsgBuyTickets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyTickets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferTickets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyTickets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferTickets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.Quantity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -359,14 +3215,27 @@ func (m *MsgBuyTickets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quantity |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -394,7 +3263,58 @@ func (m *MsgBuyTickets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TicketIds = append(m.TicketIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -417,7 +3337,7 @@ func (m *MsgBuyTickets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyTicketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferTicketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -440,10 +3360,10 @@ func (m *MsgBuyTicketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyTicketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferTicketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyTicketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferTicketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmicbet/ledger/x/wta/types"
@@ -16,17 +18,371 @@ func TestMsgBuyTickets_ValidateBasic(t *testing.T) {
 	}{
 		{
 			name:      "invalid quantity",
-			msg:       types.NewMsgBuyTickets(0, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"),
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 0, "", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", nil),
 			shouldErr: true,
 		},
 		{
 			name:      "invalid buyer",
-			msg:       types.NewMsgBuyTickets(1, "buyer"),
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 1, "", "buyer", "", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid recipient",
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 1, "", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "recipient", nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid denom",
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 1, "./", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", nil),
+			shouldErr: true,
+		},
+		{
+			name: "picks count not matching the quantity",
+			msg: types.NewMsgBuyTickets(1, 2, "", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", []types.NumbersPick{
+				types.NewNumbersPick(1, 2, 3),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated picked numbers",
+			msg: types.NewMsgBuyTickets(1, 1, "", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", []types.NumbersPick{
+				types.NewNumbersPick(1, 2, 2),
+			}),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message",
+			msg:       types.NewMsgBuyTickets(types.DefaultPoolID, 1, "", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", nil),
+			shouldErr: false,
+		},
+		{
+			name: "valid message with recipient",
+			msg: types.NewMsgBuyTickets(
+				types.DefaultPoolID,
+				1,
+				"",
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				"cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu",
+				nil,
+			),
+			shouldErr: false,
+		},
+		{
+			name: "valid message with picks",
+			msg: types.NewMsgBuyTickets(1, 1, "", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "", []types.NumbersPick{
+				types.NewNumbersPick(1, 2, 3),
+			}),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.msg.ValidateBasic()
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCommitEntropy_ValidateBasic(t *testing.T) {
+	valAddr := "cosmosvaloper14zfwkjm35j05ydm3s3qu4he39yjxe9573dvzzv"
+
+	usecases := []struct {
+		name      string
+		msg       *types.MsgCommitEntropy
+		shouldErr bool
+	}{
+		{
+			name:      "invalid validator",
+			msg:       types.NewMsgCommitEntropy(types.DefaultPoolID, make([]byte, 32), "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid commitment",
+			msg:       types.NewMsgCommitEntropy(types.DefaultPoolID, []byte("commitment"), valAddr),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message",
+			msg:       types.NewMsgCommitEntropy(types.DefaultPoolID, make([]byte, 32), valAddr),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.msg.ValidateBasic()
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateEntropy(t *testing.T) {
+	usecases := []struct {
+		name      string
+		entropy   []byte
+		shouldErr bool
+	}{
+		{
+			name:      "empty entropy",
+			entropy:   nil,
+			shouldErr: true,
+		},
+		{
+			name:      "too long entropy",
+			entropy:   make([]byte, types.MaxEntropyLength+1),
+			shouldErr: true,
+		},
+		{
+			name:      "entropy having the maximum length",
+			entropy:   make([]byte, types.MaxEntropyLength),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.ValidateEntropy(uc.entropy)
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRevealEntropy_ValidateBasic(t *testing.T) {
+	valAddr := "cosmosvaloper14zfwkjm35j05ydm3s3qu4he39yjxe9573dvzzv"
+
+	usecases := []struct {
+		name      string
+		msg       *types.MsgRevealEntropy
+		shouldErr bool
+	}{
+		{
+			name:      "invalid validator",
+			msg:       types.NewMsgRevealEntropy(types.DefaultPoolID, []byte("entropy"), "validator"),
+			shouldErr: true,
+		},
+		{
+			name:      "empty entropy",
+			msg:       types.NewMsgRevealEntropy(types.DefaultPoolID, nil, valAddr),
+			shouldErr: true,
+		},
+		{
+			name:      "too long entropy",
+			msg:       types.NewMsgRevealEntropy(types.DefaultPoolID, make([]byte, types.MaxEntropyLength+1), valAddr),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message",
+			msg:       types.NewMsgRevealEntropy(types.DefaultPoolID, []byte("entropy"), valAddr),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.msg.ValidateBasic()
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSelfExclude_ValidateBasic(t *testing.T) {
+	until := time.Date(2021, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	usecases := []struct {
+		name      string
+		msg       *types.MsgSelfExclude
+		shouldErr bool
+	}{
+		{
+			name:      "invalid address",
+			msg:       types.NewMsgSelfExclude("address", until),
+			shouldErr: true,
+		},
+		{
+			name:      "zero end time",
+			msg:       types.NewMsgSelfExclude("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", time.Time{}),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message",
+			msg:       types.NewMsgSelfExclude("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", until),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.msg.ValidateBasic()
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetSpendingLimit_ValidateBasic(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+
+	usecases := []struct {
+		name      string
+		msg       *types.MsgSetSpendingLimit
+		shouldErr bool
+	}{
+		{
+			name:      "invalid address",
+			msg:       types.NewMsgSetSpendingLimit("address", amount, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name: "invalid amount",
+			msg: types.NewMsgSetSpendingLimit(
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+				time.Hour,
+			),
+			shouldErr: true,
+		},
+		{
+			name:      "zero window",
+			msg:       types.NewMsgSetSpendingLimit("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", amount, 0),
+			shouldErr: true,
+		},
+		{
+			name:      "valid limit removal",
+			msg:       types.NewMsgSetSpendingLimit("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", sdk.NewCoins(), 0),
+			shouldErr: false,
+		},
+		{
+			name:      "valid message",
+			msg:       types.NewMsgSetSpendingLimit("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", amount, time.Hour),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.msg.ValidateBasic()
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgPostPrice_ValidateBasic(t *testing.T) {
+	usecases := []struct {
+		name      string
+		msg       *types.MsgPostPrice
+		shouldErr bool
+	}{
+		{
+			name:      "invalid oracle",
+			msg:       types.NewMsgPostPrice("oracle", "stake", sdk.NewDecWithPrec(25, 2)),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid denom",
+			msg:       types.NewMsgPostPrice("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "./", sdk.NewDecWithPrec(25, 2)),
+			shouldErr: true,
+		},
+		{
+			name:      "zero price",
+			msg:       types.NewMsgPostPrice("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "stake", sdk.ZeroDec()),
+			shouldErr: true,
+		},
+		{
+			name:      "valid message",
+			msg:       types.NewMsgPostPrice("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "stake", sdk.NewDecWithPrec(25, 2)),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := uc.msg.ValidateBasic()
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgTransferTickets_ValidateBasic(t *testing.T) {
+	usecases := []struct {
+		name      string
+		msg       *types.MsgTransferTickets
+		shouldErr bool
+	}{
+		{
+			name:      "invalid owner",
+			msg:       types.NewMsgTransferTickets(1, []string{"ticket-1"}, "owner", "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid recipient",
+			msg:       types.NewMsgTransferTickets(1, []string{"ticket-1"}, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "recipient"),
+			shouldErr: true,
+		},
+		{
+			name:      "recipient equal to the owner",
+			msg:       types.NewMsgTransferTickets(1, []string{"ticket-1"}, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"),
+			shouldErr: true,
+		},
+		{
+			name:      "no ticket ids",
+			msg:       types.NewMsgTransferTickets(1, nil, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"),
+			shouldErr: true,
+		},
+		{
+			name:      "empty ticket id",
+			msg:       types.NewMsgTransferTickets(1, []string{""}, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"),
+			shouldErr: true,
+		},
+		{
+			name:      "duplicated ticket id",
+			msg:       types.NewMsgTransferTickets(1, []string{"ticket-1", "ticket-1"}, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"),
 			shouldErr: true,
 		},
 		{
 			name:      "valid message",
-			msg:       types.NewMsgBuyTickets(1, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"),
+			msg:       types.NewMsgTransferTickets(1, []string{"ticket-1", "ticket-2"}, "cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl", "cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"),
 			shouldErr: false,
 		},
 	}
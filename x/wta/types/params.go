@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,27 @@ const (
 
 	// Min draw duration
 	MinDrawDuration = time.Minute
+
+	// Default duration of the entropy reveal window
+	DefaultRevealDuration = time.Minute
+
+	// Max number of winners of each draw
+	MaxPrizeWinners = 100
+
+	// Max number that can be picked inside a lotto ticket
+	MaxLottoNumber = 100
+
+	// Default max number of past draws tickets removed at the end of each block
+	DefaultMaxPrunedTicketsPerBlock = 1000
+
+	// Max length of the reason why the ticket sales have been halted
+	MaxHaltReasonLength = 256
+
+	// Default cooling-off delay after which a raised spending limit is applied
+	DefaultSpendingLimitIncreaseDelay = time.Hour * 24
+
+	// Default max age of the prices posted by the oracles
+	DefaultMaxPriceAge = time.Hour
 )
 
 // Default wta params
@@ -26,9 +48,15 @@ var (
 
 // Parameters store keys
 var (
-	ParamStoreDistributionParamsKey = []byte("DistributionParams")
-	ParamStoreDrawParamsKey         = []byte("DrawParams")
-	ParamStoreTicketParamsKey       = []byte("TicketParams")
+	ParamStoreDistributionParamsKey  = []byte("DistributionParams")
+	ParamStoreDrawParamsKey          = []byte("DrawParams")
+	ParamStoreTicketParamsKey        = []byte("TicketParams")
+	ParamStorePrizeParamsKey         = []byte("PrizeParams")
+	ParamStoreRolloverParamsKey      = []byte("RolloverParams")
+	ParamStorePruningParamsKey       = []byte("PruningParams")
+	ParamStoreSalesParamsKey         = []byte("SalesParams")
+	ParamStoreSpendingLimitParamsKey = []byte("SpendingLimitParams")
+	ParamStoreOracleParamsKey        = []byte("OracleParams")
 )
 
 // ParamKeyTable Key declaration for parameters
@@ -37,16 +65,25 @@ func ParamKeyTable() paramstypes.KeyTable {
 		paramstypes.NewParamSetPair(ParamStoreDistributionParamsKey, &DistributionParams{}, ValidateDistributionParams),
 		paramstypes.NewParamSetPair(ParamStoreDrawParamsKey, &DrawParams{}, ValidateDrawParams),
 		paramstypes.NewParamSetPair(ParamStoreTicketParamsKey, &TicketParams{}, ValidateTicketParams),
+		paramstypes.NewParamSetPair(ParamStorePrizeParamsKey, &PrizeParams{}, ValidatePrizeParams),
+		paramstypes.NewParamSetPair(ParamStoreRolloverParamsKey, &RolloverParams{}, ValidateRolloverParams),
+		paramstypes.NewParamSetPair(ParamStorePruningParamsKey, &PruningParams{}, ValidatePruningParams),
+		paramstypes.NewParamSetPair(ParamStoreSalesParamsKey, &SalesParams{}, ValidateSalesParams),
+		paramstypes.NewParamSetPair(ParamStoreSpendingLimitParamsKey, &SpendingLimitParams{}, ValidateSpendingLimitParams),
+		paramstypes.NewParamSetPair(ParamStoreOracleParamsKey, &OracleParams{}, ValidateOracleParams),
 	)
 }
 
 // -------------------------------------------------------------------------------------------------------------------
 
-func NewDistributionParams(prizePercentage, feePercentage, burnPercentage sdk.Dec) DistributionParams {
+func NewDistributionParams(
+	prizePercentage, feePercentage, burnPercentage sdk.Dec, feeDestination FeeDestination,
+) DistributionParams {
 	return DistributionParams{
 		PrizePercentage: prizePercentage,
 		FeePercentage:   feePercentage,
 		BurnPercentage:  burnPercentage,
+		FeeDestination:  feeDestination,
 	}
 }
 
@@ -55,6 +92,7 @@ func DefaultDistributionParams() DistributionParams {
 		DefaultPrizePercentage,
 		DefaultBurnPercentage,
 		DefaultFeePercentage,
+		FeeDestinationFeeCollector,
 	)
 }
 
@@ -83,6 +121,10 @@ func ValidateDistributionParams(i interface{}) error {
 		return fmt.Errorf("percentages does not sum to 1.00")
 	}
 
+	if _, ok := FeeDestination_name[int32(params.FeeDestination)]; !ok {
+		return fmt.Errorf("invalid fee destination param: %d", params.FeeDestination)
+	}
+
 	return nil
 }
 
@@ -103,14 +145,17 @@ func validatePercentageValue(i interface{}) error {
 
 // -------------------------------------------------------------------------------------------------------------------
 
-func NewDrawParams(duration time.Duration) DrawParams {
+func NewDrawParams(duration, revealDuration, salesCutoff time.Duration, assignToNextDraw bool) DrawParams {
 	return DrawParams{
-		Duration: duration,
+		Duration:         duration,
+		RevealDuration:   revealDuration,
+		SalesCutoff:      salesCutoff,
+		AssignToNextDraw: assignToNextDraw,
 	}
 }
 
 func DefaultDrawParams() DrawParams {
-	return NewDrawParams(DefaultDrawDuration)
+	return NewDrawParams(DefaultDrawDuration, DefaultRevealDuration, 0, false)
 }
 
 func ValidateDrawParams(i interface{}) error {
@@ -123,19 +168,59 @@ func ValidateDrawParams(i interface{}) error {
 		return fmt.Errorf("invalid draw duration param: %s", params.Duration)
 	}
 
+	if params.RevealDuration < 0 {
+		return fmt.Errorf("invalid reveal duration param: %s", params.RevealDuration)
+	}
+
+	if params.SalesCutoff < 0 || params.SalesCutoff >= params.Duration {
+		return fmt.Errorf("invalid sales cutoff param: %s", params.SalesCutoff)
+	}
+
 	return nil
 }
 
 // -------------------------------------------------------------------------------------------------------------------
 
-func NewTicketParams(price sdk.Coin) TicketParams {
+func NewTicketParams(
+	price sdk.Coin, alternativePrices sdk.Coins, referencePrice sdk.Dec, maxTicketsPerAddress, maxTicketsPerMsg uint32,
+) TicketParams {
 	return TicketParams{
-		Price: price,
+		Price:                price,
+		AlternativePrices:    alternativePrices,
+		ReferencePrice:       referencePrice,
+		MaxTicketsPerAddress: maxTicketsPerAddress,
+		MaxTicketsPerMsg:     maxTicketsPerMsg,
 	}
 }
 
 func DefaultTicketParams() TicketParams {
-	return NewTicketParams(DefaultTicketPrice)
+	return NewTicketParams(DefaultTicketPrice, nil, sdk.ZeroDec(), 0, 0)
+}
+
+// HasReferencePrice tells whether the ticket price is expressed in the reference unit of the price feed
+func (params TicketParams) HasReferencePrice() bool {
+	return !params.ReferencePrice.IsNil() && params.ReferencePrice.IsPositive()
+}
+
+// Prices returns all the prices at which an individual ticket can be bought, including the main one
+func (params TicketParams) Prices() sdk.Coins {
+	return sdk.NewCoins(params.Price).Add(params.AlternativePrices...)
+}
+
+// PriceOf returns the price of an individual ticket paid using the given denom.
+// If the denom is empty, the main price is returned
+func (params TicketParams) PriceOf(denom string) (price sdk.Coin, found bool) {
+	if denom == "" || denom == params.Price.Denom {
+		return params.Price, true
+	}
+
+	for _, price := range params.AlternativePrices {
+		if price.Denom == denom {
+			return price, true
+		}
+	}
+
+	return sdk.Coin{}, false
 }
 
 func ValidateTicketParams(i interface{}) error {
@@ -152,5 +237,398 @@ func ValidateTicketParams(i interface{}) error {
 		return fmt.Errorf("ticket price cannot be zero")
 	}
 
+	if err := params.AlternativePrices.Validate(); err != nil {
+		return fmt.Errorf("invalid alternative ticket prices param: %s", err.Error())
+	}
+
+	if params.AlternativePrices.AmountOf(params.Price.Denom).IsPositive() {
+		return fmt.Errorf("alternative ticket prices cannot contain the main price denom: %s", params.Price.Denom)
+	}
+
+	if params.ReferencePrice.IsNil() || params.ReferencePrice.IsNegative() {
+		return fmt.Errorf("invalid reference ticket price param: %s", params.ReferencePrice)
+	}
+
+	if params.MaxTicketsPerAddress != 0 && params.MaxTicketsPerMsg > params.MaxTicketsPerAddress {
+		return fmt.Errorf("max tickets per message cannot be greater than max tickets per address: %d > %d",
+			params.MaxTicketsPerMsg, params.MaxTicketsPerAddress)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+func NewPrizeTier(winners uint32, percentage sdk.Dec) PrizeTier {
+	return PrizeTier{
+		Winners:    winners,
+		Percentage: percentage,
+	}
+}
+
+func NewPrizeParams(tiers []PrizeTier) PrizeParams {
+	return PrizeParams{
+		Tiers: tiers,
+	}
+}
+
+// DefaultPrizeParams returns the default PrizeParams, which give the whole prize to a single winner
+func DefaultPrizeParams() PrizeParams {
+	return NewPrizeParams([]PrizeTier{
+		NewPrizeTier(1, sdk.NewDecWithPrec(100, 2)),
+	})
+}
+
+func ValidatePrizeParams(i interface{}) error {
+	params, ok := i.(PrizeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(params.Tiers) == 0 {
+		return fmt.Errorf("prize tiers cannot be empty")
+	}
+
+	totalPercentage := sdk.ZeroDec()
+	for _, tier := range params.Tiers {
+		if tier.Winners == 0 {
+			return fmt.Errorf("invalid prize tier winners: %d", tier.Winners)
+		}
+
+		err := validatePercentageValue(tier.Percentage)
+		if err != nil {
+			return err
+		}
+
+		totalPercentage = totalPercentage.Add(tier.Percentage)
+	}
+
+	if params.TotalWinners() > MaxPrizeWinners {
+		return fmt.Errorf("prize winners cannot exceed %d", MaxPrizeWinners)
+	}
+
+	if !totalPercentage.Equal(sdk.NewDecWithPrec(100, 2)) {
+		return fmt.Errorf("prize tiers percentages does not sum to 1.00")
+	}
+
+	return nil
+}
+
+// TotalWinners returns the number of winners of all the tiers
+func (p PrizeParams) TotalWinners() uint32 {
+	var total uint32
+	for _, tier := range p.Tiers {
+		total += tier.Winners
+	}
+	return total
+}
+
+// SplitPrize splits the given prize among the winners of each tier.
+// It returns the tier and the amount won by each winning position, sorted from the first place to the last one.
+// The amount of each tier is split evenly among its winners, truncating any remainder
+func (p PrizeParams) SplitPrize(prize sdk.Coins) (tiers []uint32, amounts []sdk.Coins) {
+	decPrize := sdk.NewDecCoinsFromCoins(prize...)
+	for i, tier := range p.Tiers {
+		tierAmount := decPrize.MulDecTruncate(tier.Percentage)
+		winnerAmount, _ := tierAmount.QuoDecTruncate(sdk.NewDec(int64(tier.Winners))).TruncateDecimal()
+
+		for j := uint32(0); j < tier.Winners; j++ {
+			tiers = append(tiers, uint32(i))
+			amounts = append(amounts, winnerAmount)
+		}
+	}
+	return tiers, amounts
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+func NewRolloverParams(maxRollovers uint32, treasuryPercentage sdk.Dec) RolloverParams {
+	return RolloverParams{
+		MaxRollovers:       maxRollovers,
+		TreasuryPercentage: treasuryPercentage,
+	}
+}
+
+// DefaultRolloverParams returns the default RolloverParams, which allow draws to be rolled over indefinitely
+// without seeding their prize
+func DefaultRolloverParams() RolloverParams {
+	return NewRolloverParams(0, sdk.ZeroDec())
+}
+
+func ValidateRolloverParams(i interface{}) error {
+	params, ok := i.(RolloverParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if params.TreasuryPercentage.IsNil() || params.TreasuryPercentage.IsNegative() ||
+		params.TreasuryPercentage.GT(sdk.NewDecWithPrec(100, 2)) {
+		return fmt.Errorf("invalid treasury percentage param: %s", params.TreasuryPercentage)
+	}
+
+	return nil
+}
+
+// CanRollover tells whether a draw can be rolled over for the given number of consecutive times
+func (p RolloverParams) CanRollover(rollovers uint32) bool {
+	return p.MaxRollovers == 0 || rollovers <= p.MaxRollovers
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+func NewPruningParams(maxTicketsPerBlock uint32) PruningParams {
+	return PruningParams{
+		MaxTicketsPerBlock: maxTicketsPerBlock,
+	}
+}
+
+// DefaultPruningParams returns the default PruningParams
+func DefaultPruningParams() PruningParams {
+	return NewPruningParams(DefaultMaxPrunedTicketsPerBlock)
+}
+
+func ValidatePruningParams(i interface{}) error {
+	params, ok := i.(PruningParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if params.MaxTicketsPerBlock == 0 {
+		return fmt.Errorf("invalid max tickets per block param: %d", params.MaxTicketsPerBlock)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+func NewSalesParams(halted bool, haltReason string) SalesParams {
+	return SalesParams{
+		Halted:     halted,
+		HaltReason: haltReason,
+	}
+}
+
+// DefaultSalesParams returns the default SalesParams, which do not halt the ticket sales
+func DefaultSalesParams() SalesParams {
+	return NewSalesParams(false, "")
+}
+
+func ValidateSalesParams(i interface{}) error {
+	params, ok := i.(SalesParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if params.Halted && strings.TrimSpace(params.HaltReason) == "" {
+		return fmt.Errorf("a halt reason is required when the ticket sales are halted")
+	}
+
+	if !params.Halted && params.HaltReason != "" {
+		return fmt.Errorf("the halt reason must be empty when the ticket sales are not halted")
+	}
+
+	if len(params.HaltReason) > MaxHaltReasonLength {
+		return fmt.Errorf("halt reason cannot be longer than %d characters", MaxHaltReasonLength)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+func NewSpendingLimitParams(increaseDelay time.Duration) SpendingLimitParams {
+	return SpendingLimitParams{
+		IncreaseDelay: increaseDelay,
+	}
+}
+
+// DefaultSpendingLimitParams returns the default SpendingLimitParams
+func DefaultSpendingLimitParams() SpendingLimitParams {
+	return NewSpendingLimitParams(DefaultSpendingLimitIncreaseDelay)
+}
+
+func ValidateSpendingLimitParams(i interface{}) error {
+	params, ok := i.(SpendingLimitParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if params.IncreaseDelay < 0 {
+		return fmt.Errorf("invalid increase delay param: %s", params.IncreaseDelay)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+func NewOracleParams(oracles []string, maxPriceAge time.Duration) OracleParams {
+	return OracleParams{
+		Oracles:     oracles,
+		MaxPriceAge: maxPriceAge,
+	}
+}
+
+// DefaultOracleParams returns the default OracleParams, which do not allow any oracle to post prices
+func DefaultOracleParams() OracleParams {
+	return NewOracleParams(nil, DefaultMaxPriceAge)
+}
+
+// IsOracle tells whether the given address is allowed to post prices
+func (params OracleParams) IsOracle(address string) bool {
+	for _, oracle := range params.Oracles {
+		if oracle == address {
+			return true
+		}
+	}
+	return false
+}
+
+func ValidateOracleParams(i interface{}) error {
+	params, ok := i.(OracleParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	oracles := map[string]bool{}
+	for _, oracle := range params.Oracles {
+		if _, err := sdk.AccAddressFromBech32(oracle); err != nil {
+			return fmt.Errorf("invalid oracle address: %s", oracle)
+		}
+
+		if oracles[oracle] {
+			return fmt.Errorf("duplicated oracle address: %s", oracle)
+		}
+		oracles[oracle] = true
+	}
+
+	if params.MaxPriceAge <= 0 {
+		return fmt.Errorf("invalid max price age param: %s", params.MaxPriceAge)
+	}
+
+	return nil
+}
+
+func NewMatchTier(matches uint32, percentage sdk.Dec) MatchTier {
+	return MatchTier{
+		Matches:    matches,
+		Percentage: percentage,
+	}
+}
+
+func NewGameParams(gameType GameType, picks, maxNumber uint32, matchTiers []MatchTier) GameParams {
+	return GameParams{
+		GameType:   gameType,
+		Picks:      picks,
+		MaxNumber:  maxNumber,
+		MatchTiers: matchTiers,
+	}
+}
+
+// DefaultGameParams returns the default GameParams, which represent a game having random tickets
+func DefaultGameParams() GameParams {
+	return NewGameParams(GameTypeRandom, 0, 0, nil)
+}
+
+func ValidateGameParams(i interface{}) error {
+	params, ok := i.(GameParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch params.GameType {
+	case GameTypeRandom:
+		if params.Picks != 0 || params.MaxNumber != 0 || len(params.MatchTiers) != 0 {
+			return fmt.Errorf("random game cannot have lotto parameters")
+		}
+		return nil
+
+	case GameTypeLotto:
+		break
+
+	default:
+		return fmt.Errorf("invalid game type: %s", params.GameType)
+	}
+
+	if params.Picks == 0 {
+		return fmt.Errorf("invalid lotto picks: %d", params.Picks)
+	}
+
+	if params.MaxNumber < params.Picks || params.MaxNumber > MaxLottoNumber {
+		return fmt.Errorf("invalid lotto max number: %d", params.MaxNumber)
+	}
+
+	if len(params.MatchTiers) == 0 {
+		return fmt.Errorf("lotto match tiers cannot be empty")
+	}
+
+	totalPercentage := sdk.ZeroDec()
+	for _, tier := range params.MatchTiers {
+		if tier.Matches == 0 || tier.Matches > params.Picks {
+			return fmt.Errorf("invalid match tier matches: %d", tier.Matches)
+		}
+
+		if params.countMatchTiers(tier.Matches) > 1 {
+			return fmt.Errorf("match tier duplicated: %d", tier.Matches)
+		}
+
+		err := validatePercentageValue(tier.Percentage)
+		if err != nil {
+			return err
+		}
+
+		totalPercentage = totalPercentage.Add(tier.Percentage)
+	}
+
+	if !totalPercentage.Equal(sdk.NewDecWithPrec(100, 2)) {
+		return fmt.Errorf("match tiers percentages does not sum to 1.00")
+	}
+
+	return nil
+}
+
+// countMatchTiers returns the number of tiers of p that are won with the given amount of matches
+func (p GameParams) countMatchTiers(matches uint32) int {
+	var count = 0
+	for _, tier := range p.MatchTiers {
+		if tier.Matches == matches {
+			count++
+		}
+	}
+	return count
+}
+
+// MatchTierIndex returns the index of the tier that is won by matching the given amount of numbers,
+// and whether such tier exists
+func (p GameParams) MatchTierIndex(matches uint32) (uint32, bool) {
+	for i, tier := range p.MatchTiers {
+		if tier.Matches == matches {
+			return uint32(i), true
+		}
+	}
+	return 0, false
+}
+
+// ValidatePick returns an error if the given numbers cannot be picked by a ticket of a lotto game having params p
+func (p GameParams) ValidatePick(numbers []uint32) error {
+	if p.GameType != GameTypeLotto {
+		return fmt.Errorf("numbers can only be picked inside lotto games")
+	}
+
+	if uint32(len(numbers)) != p.Picks {
+		return fmt.Errorf("invalid amount of picked numbers: expected %d but got %d", p.Picks, len(numbers))
+	}
+
+	for _, number := range numbers {
+		if number == 0 || number > p.MaxNumber {
+			return fmt.Errorf("invalid picked number: %d", number)
+		}
+	}
+
+	if !AreNumbersUnique(numbers) {
+		return fmt.Errorf("picked numbers must be unique")
+	}
+
 	return nil
 }
@@ -29,6 +29,73 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FeeDestination represents where the fee share of the ticket cost is sent
+type FeeDestination int32
+
+const (
+	// The fee is sent to the fee collector, along with the transactions fees
+	FeeDestinationFeeCollector FeeDestination = 0
+	// The fee is sent to the community pool
+	FeeDestinationCommunityPool FeeDestination = 1
+	// The fee is sent to the wta treasury account
+	FeeDestinationTreasury FeeDestination = 2
+	// The fee is allocated to the bonded validators proportionally to their
+	// stake, and then shared with their delegators
+	FeeDestinationStakers FeeDestination = 3
+)
+
+var FeeDestination_name = map[int32]string{
+	0: "FEE_DESTINATION_FEE_COLLECTOR",
+	1: "FEE_DESTINATION_COMMUNITY_POOL",
+	2: "FEE_DESTINATION_TREASURY",
+	3: "FEE_DESTINATION_STAKERS",
+}
+
+var FeeDestination_value = map[string]int32{
+	"FEE_DESTINATION_FEE_COLLECTOR":  0,
+	"FEE_DESTINATION_COMMUNITY_POOL": 1,
+	"FEE_DESTINATION_TREASURY":       2,
+	"FEE_DESTINATION_STAKERS":        3,
+}
+
+func (x FeeDestination) String() string {
+	return proto.EnumName(FeeDestination_name, int32(x))
+}
+
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{0}
+}
+
+// GameType represents the kind of game played inside a pool
+type GameType int32
+
+const (
+	// Each ticket has a random id, and the winners are randomly extracted among
+	// the sold tickets
+	GameTypeRandom GameType = 0
+	// Each ticket contains the numbers picked by its buyer, and the winners are
+	// the tickets matching the drawn numbers
+	GameTypeLotto GameType = 1
+)
+
+var GameType_name = map[int32]string{
+	0: "GAME_TYPE_RANDOM",
+	1: "GAME_TYPE_LOTTO",
+}
+
+var GameType_value = map[string]int32{
+	"GAME_TYPE_RANDOM": 0,
+	"GAME_TYPE_LOTTO":  1,
+}
+
+func (x GameType) String() string {
+	return proto.EnumName(GameType_name, int32(x))
+}
+
+func (GameType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{1}
+}
+
 // DistributionParams contains the parameters of the distribution of the prize
 type DistributionParams struct {
 	// Percentage of the ticket cost that should be sent to the prize pool,
@@ -40,6 +107,8 @@ type DistributionParams struct {
 	// Percentage of the ticket cost that should be considered as a fee,
 	// represented as a value between 0.00 and 1.00.
 	FeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee_percentage,json=feePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_percentage"`
+	// Destination to which the fee share of the ticket cost is sent
+	FeeDestination FeeDestination `protobuf:"varint,4,opt,name=fee_destination,json=feeDestination,proto3,enum=cosmicbet.wta.v1beta1.FeeDestination" json:"fee_destination,omitempty" yaml:"fee_destination"`
 }
 
 func (m *DistributionParams) Reset()         { *m = DistributionParams{} }
@@ -75,11 +144,27 @@ func (m *DistributionParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DistributionParams proto.InternalMessageInfo
 
+func (m *DistributionParams) GetFeeDestination() FeeDestination {
+	if m != nil {
+		return m.FeeDestination
+	}
+	return FeeDestinationFeeCollector
+}
+
 // DrawParams contain the parameters for each draw
 type DrawParams struct {
 	// Duration of each draw, after which the winner is picked and a new draw is
 	// created
 	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	// Duration of the window following the draw end time during which
+	// validators can reveal their committed entropy
+	RevealDuration time.Duration `protobuf:"bytes,5,opt,name=reveal_duration,json=revealDuration,proto3,stdduration" json:"reveal_duration"`
+	// Duration of the window preceding the draw end time during which tickets
+	// can no longer be bought for the draw
+	SalesCutoff time.Duration `protobuf:"bytes,6,opt,name=sales_cutoff,json=salesCutoff,proto3,stdduration" json:"sales_cutoff" yaml:"sales_cutoff"`
+	// Tells whether the tickets bought during the sales cutoff window are
+	// assigned to the following draw instead of being rejected
+	AssignToNextDraw bool `protobuf:"varint,7,opt,name=assign_to_next_draw,json=assignToNextDraw,proto3" json:"assign_to_next_draw,omitempty" yaml:"assign_to_next_draw"`
 }
 
 func (m *DrawParams) Reset()         { *m = DrawParams{} }
@@ -122,10 +207,45 @@ func (m *DrawParams) GetDuration() time.Duration {
 	return 0
 }
 
+func (m *DrawParams) GetRevealDuration() time.Duration {
+	if m != nil {
+		return m.RevealDuration
+	}
+	return 0
+}
+
+func (m *DrawParams) GetSalesCutoff() time.Duration {
+	if m != nil {
+		return m.SalesCutoff
+	}
+	return 0
+}
+
+func (m *DrawParams) GetAssignToNextDraw() bool {
+	if m != nil {
+		return m.AssignToNextDraw
+	}
+	return false
+}
+
 // TicketParams contain the parameters for each ticket
 type TicketParams struct {
 	// Cost of an individual ticket
 	Price types.Coin `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
+	// Maximum number of tickets that a single address can buy for each draw,
+	// where 0 means no limit
+	MaxTicketsPerAddress uint32 `protobuf:"varint,6,opt,name=max_tickets_per_address,json=maxTicketsPerAddress,proto3" json:"max_tickets_per_address,omitempty" yaml:"max_tickets_per_address"`
+	// Maximum number of tickets that can be bought with a single message,
+	// where 0 means no limit
+	MaxTicketsPerMsg uint32 `protobuf:"varint,7,opt,name=max_tickets_per_msg,json=maxTicketsPerMsg,proto3" json:"max_tickets_per_msg,omitempty" yaml:"max_tickets_per_msg"`
+	// Other prices at which an individual ticket can be bought using a
+	// denomination different from the one of the main price
+	AlternativePrices github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=alternative_prices,json=alternativePrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"alternative_prices" yaml:"alternative_prices"`
+	// Price of an individual ticket expressed in the reference unit of the price
+	// feed. If positive, the amount paid using each accepted denomination is
+	// computed when buying the tickets by converting this value with the price
+	// feed, and only the denominations of the other prices are used
+	ReferencePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price" yaml:"reference_price"`
 }
 
 func (m *TicketParams) Reset()         { *m = TicketParams{} }
@@ -168,217 +288,2312 @@ func (m *TicketParams) GetPrice() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*DistributionParams)(nil), "cosmicbet.wta.v1beta1.DistributionParams")
-	proto.RegisterType((*DrawParams)(nil), "cosmicbet.wta.v1beta1.DrawParams")
-	proto.RegisterType((*TicketParams)(nil), "cosmicbet.wta.v1beta1.TicketParams")
+func (m *TicketParams) GetMaxTicketsPerAddress() uint32 {
+	if m != nil {
+		return m.MaxTicketsPerAddress
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("cosmicbet/wta/v1beta1/params.proto", fileDescriptor_ce4ff2a375989179)
+func (m *TicketParams) GetMaxTicketsPerMsg() uint32 {
+	if m != nil {
+		return m.MaxTicketsPerMsg
+	}
+	return 0
 }
 
-var fileDescriptor_ce4ff2a375989179 = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x8f, 0xd2, 0x40,
-	0x14, 0xc7, 0x5b, 0x04, 0x83, 0xa3, 0x82, 0x69, 0x34, 0x41, 0x0e, 0x03, 0xe9, 0x41, 0xb9, 0x38,
-	0x13, 0x34, 0x9e, 0x8d, 0xb5, 0x1e, 0x4d, 0x08, 0xd1, 0x18, 0xbd, 0x98, 0x99, 0xe1, 0x51, 0x27,
-	0x40, 0xa7, 0x99, 0x4e, 0x97, 0xdd, 0xfd, 0x14, 0x7b, 0xdc, 0xdb, 0x7e, 0x1d, 0x8e, 0x1c, 0x37,
-	0x7b, 0x60, 0x37, 0xf0, 0x45, 0x36, 0x6d, 0x87, 0x86, 0xdd, 0x23, 0xa7, 0x4e, 0x9a, 0xff, 0xfb,
-	0xbd, 0xff, 0x7b, 0xff, 0x87, 0x7c, 0xa1, 0xd2, 0x85, 0x14, 0x1c, 0x0c, 0x5d, 0x1a, 0x46, 0x4f,
-	0x86, 0x1c, 0x0c, 0x1b, 0xd2, 0x84, 0x69, 0xb6, 0x48, 0x49, 0xa2, 0x95, 0x51, 0xde, 0x9b, 0x4a,
-	0x43, 0x96, 0x86, 0x11, 0xab, 0xe9, 0xe2, 0x48, 0xa9, 0x68, 0x0e, 0xb4, 0x10, 0xf1, 0x6c, 0x4a,
-	0x27, 0x99, 0x66, 0x46, 0xaa, 0xb8, 0x2c, 0xeb, 0xbe, 0x8e, 0x54, 0xa4, 0x8a, 0x27, 0xcd, 0x5f,
-	0xf6, 0x2f, 0xce, 0x61, 0x2a, 0xa5, 0x9c, 0xa5, 0x50, 0xb5, 0x13, 0x4a, 0xda, 0x2a, 0xff, 0xaa,
-	0x86, 0xbc, 0x50, 0xa6, 0x46, 0x4b, 0x9e, 0xe5, 0xb0, 0x51, 0xe1, 0xc4, 0xfb, 0x83, 0x5e, 0x25,
-	0x5a, 0x9e, 0xc3, 0xbf, 0x04, 0xb4, 0x80, 0xd8, 0xb0, 0x08, 0x3a, 0x6e, 0xdf, 0x1d, 0x3c, 0x0b,
-	0xc8, 0x6a, 0xd3, 0x73, 0x6e, 0x36, 0xbd, 0x77, 0x91, 0x34, 0xff, 0x33, 0x4e, 0x84, 0x5a, 0x50,
-	0xdb, 0xa3, 0xfc, 0x7c, 0x48, 0x27, 0x33, 0x6a, 0xce, 0x12, 0x48, 0x49, 0x08, 0x62, 0xdc, 0x2e,
-	0x38, 0xa3, 0x0a, 0xe3, 0xfd, 0x46, 0x6d, 0x9e, 0xe9, 0xf8, 0x90, 0x5c, 0x3b, 0x8a, 0xdc, 0xca,
-	0x31, 0x07, 0xe0, 0x5f, 0xa8, 0x35, 0x85, 0x07, 0x8e, 0x9f, 0x1c, 0xc5, 0x7d, 0x39, 0x85, 0x03,
-	0xbf, 0xfe, 0x0f, 0x84, 0x42, 0xcd, 0x96, 0x76, 0x31, 0x5f, 0x50, 0x73, 0xbf, 0xf7, 0x4e, 0xbd,
-	0xef, 0x0e, 0x9e, 0x7f, 0x7c, 0x4b, 0xca, 0x60, 0xc8, 0x3e, 0x18, 0x12, 0x5a, 0x41, 0xd0, 0xcc,
-	0x3b, 0x5f, 0xde, 0xf6, 0xdc, 0x71, 0x55, 0xe4, 0x7f, 0x47, 0x2f, 0x7e, 0x4a, 0x31, 0x03, 0x63,
-	0x81, 0x9f, 0x51, 0x23, 0xd1, 0x52, 0x40, 0xa7, 0x61, 0x69, 0xa5, 0x27, 0x92, 0x07, 0xb6, 0xcf,
-	0x9e, 0x7c, 0x53, 0x32, 0x0e, 0xea, 0x39, 0x6d, 0x5c, 0xaa, 0x83, 0xaf, 0xab, 0x2d, 0x76, 0xd7,
-	0x5b, 0xec, 0xde, 0x6d, 0xb1, 0x7b, 0xb1, 0xc3, 0xce, 0x7a, 0x87, 0x9d, 0xeb, 0x1d, 0x76, 0xfe,
-	0xbe, 0x7f, 0x34, 0x66, 0x79, 0x6d, 0x73, 0x98, 0x44, 0xa0, 0xe9, 0x69, 0x71, 0x76, 0xc5, 0xac,
-	0xfc, 0x69, 0x61, 0xf8, 0xd3, 0x7d, 0x00, 0x00, 0x00, 0xff, 0xff, 0x24, 0x3d, 0x85, 0xe3, 0x94,
-	0x02, 0x00, 0x00,
+func (m *TicketParams) GetAlternativePrices() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AlternativePrices
+	}
+	return nil
 }
 
-func (m *DistributionParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// PrizeParams contain the parameters of the payout table used to split the
+// prize of each draw among its winners
+type PrizeParams struct {
+	// Tiers of the payout table, sorted from the first place to the last one
+	Tiers []PrizeTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers"`
+}
+
+func (m *PrizeParams) Reset()         { *m = PrizeParams{} }
+func (m *PrizeParams) String() string { return proto.CompactTextString(m) }
+func (*PrizeParams) ProtoMessage()    {}
+func (*PrizeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{3}
+}
+func (m *PrizeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrizeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrizeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PrizeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrizeParams.Merge(m, src)
+}
+func (m *PrizeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrizeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrizeParams.DiscardUnknown(m)
 }
 
-func (m *DistributionParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PrizeParams proto.InternalMessageInfo
+
+func (m *PrizeParams) GetTiers() []PrizeTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
 }
 
-func (m *DistributionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.FeePercentage.Size()
-		i -= size
-		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// PrizeTier represents a single tier of the payout table
+type PrizeTier struct {
+	// Number of winning tickets of the tier
+	Winners uint32 `protobuf:"varint,1,opt,name=winners,proto3" json:"winners,omitempty"`
+	// Percentage of the draw prize that is evenly split among the tier winners,
+	// represented as a value between 0.00 and 1.00.
+	Percentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=percentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percentage"`
+}
+
+func (m *PrizeTier) Reset()         { *m = PrizeTier{} }
+func (m *PrizeTier) String() string { return proto.CompactTextString(m) }
+func (*PrizeTier) ProtoMessage()    {}
+func (*PrizeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{4}
+}
+func (m *PrizeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrizeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrizeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BurnPercentage.Size()
-		i -= size
-		if _, err := m.BurnPercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+}
+func (m *PrizeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrizeTier.Merge(m, src)
+}
+func (m *PrizeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrizeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrizeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrizeTier proto.InternalMessageInfo
+
+func (m *PrizeTier) GetWinners() uint32 {
+	if m != nil {
+		return m.Winners
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.PrizePercentage.Size()
-		i -= size
-		if _, err := m.PrizePercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// GameParams contain the parameters of the game played inside a pool
+type GameParams struct {
+	GameType GameType `protobuf:"varint,1,opt,name=game_type,json=gameType,proto3,enum=cosmicbet.wta.v1beta1.GameType" json:"game_type,omitempty"`
+	// Amount of numbers that each lotto ticket picks, and that are drawn
+	Picks uint32 `protobuf:"varint,2,opt,name=picks,proto3" json:"picks,omitempty"`
+	// Highest number that can be picked, numbers start from 1
+	MaxNumber uint32 `protobuf:"varint,3,opt,name=max_number,json=maxNumber,proto3" json:"max_number,omitempty"`
+	// Tiers of the lotto payout table, based on the amount of matched numbers
+	MatchTiers []MatchTier `protobuf:"bytes,4,rep,name=match_tiers,json=matchTiers,proto3" json:"match_tiers"`
+}
+
+func (m *GameParams) Reset()         { *m = GameParams{} }
+func (m *GameParams) String() string { return proto.CompactTextString(m) }
+func (*GameParams) ProtoMessage()    {}
+func (*GameParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{5}
+}
+func (m *GameParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *GameParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameParams.Merge(m, src)
+}
+func (m *GameParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameParams.DiscardUnknown(m)
 }
 
-func (m *DrawParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GameParams proto.InternalMessageInfo
+
+func (m *GameParams) GetGameType() GameType {
+	if m != nil {
+		return m.GameType
 	}
-	return dAtA[:n], nil
+	return GameTypeRandom
 }
 
-func (m *DrawParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GameParams) GetPicks() uint32 {
+	if m != nil {
+		return m.Picks
+	}
+	return 0
 }
 
-func (m *DrawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err1 != nil {
-		return 0, err1
+func (m *GameParams) GetMaxNumber() uint32 {
+	if m != nil {
+		return m.MaxNumber
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TicketParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GameParams) GetMatchTiers() []MatchTier {
+	if m != nil {
+		return m.MatchTiers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TicketParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MatchTier represents a single tier of the lotto payout table
+type MatchTier struct {
+	// Amount of drawn numbers a ticket must match to win the tier
+	Matches uint32 `protobuf:"varint,1,opt,name=matches,proto3" json:"matches,omitempty"`
+	// Percentage of the draw prize that is evenly split among the tier winners,
+	// represented as a value between 0.00 and 1.00.
+	Percentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=percentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percentage"`
 }
 
-func (m *TicketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+func (m *MatchTier) Reset()         { *m = MatchTier{} }
+func (m *MatchTier) String() string { return proto.CompactTextString(m) }
+func (*MatchTier) ProtoMessage()    {}
+func (*MatchTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{6}
+}
+func (m *MatchTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MatchTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchTier.Merge(m, src)
+}
+func (m *MatchTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchTier proto.InternalMessageInfo
+
+func (m *MatchTier) GetMatches() uint32 {
+	if m != nil {
+		return m.Matches
+	}
+	return 0
+}
+
+// RolloverParams contain the parameters used when a draw cannot be settled
+// due to the lack of participants
+type RolloverParams struct {
+	// Max number of consecutive rollovers of a draw, after which its tickets are
+	// refunded. If 0, a draw can be rolled over indefinitely
+	MaxRollovers uint32 `protobuf:"varint,1,opt,name=max_rollovers,json=maxRollovers,proto3" json:"max_rollovers,omitempty"`
+	// Percentage of the wta treasury that is added to the prize of the next draw
+	// each time a draw is rolled over, represented as a value between 0.00 and
+	// 1.00. If 0, the prize is not seeded
+	TreasuryPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=treasury_percentage,json=treasuryPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"treasury_percentage"`
+}
+
+func (m *RolloverParams) Reset()         { *m = RolloverParams{} }
+func (m *RolloverParams) String() string { return proto.CompactTextString(m) }
+func (*RolloverParams) ProtoMessage()    {}
+func (*RolloverParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{7}
+}
+func (m *RolloverParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloverParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolloverParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RolloverParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloverParams.Merge(m, src)
+}
+func (m *RolloverParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloverParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloverParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloverParams proto.InternalMessageInfo
+
+func (m *RolloverParams) GetMaxRollovers() uint32 {
+	if m != nil {
+		return m.MaxRollovers
+	}
+	return 0
+}
+
+// PruningParams contain the parameters used when removing the tickets of the
+// past draws from the store, or moving the tickets of the rolled over draws
+type PruningParams struct {
+	// Max number of tickets of past draws that are removed from the store, as
+	// well as of tickets of rolled over draws that are moved to their new draw,
+	// at the end of each block
+	MaxTicketsPerBlock uint32 `protobuf:"varint,1,opt,name=max_tickets_per_block,json=maxTicketsPerBlock,proto3" json:"max_tickets_per_block,omitempty"`
+}
+
+func (m *PruningParams) Reset()         { *m = PruningParams{} }
+func (m *PruningParams) String() string { return proto.CompactTextString(m) }
+func (*PruningParams) ProtoMessage()    {}
+func (*PruningParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{8}
+}
+func (m *PruningParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruningParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruningParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruningParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruningParams.Merge(m, src)
+}
+func (m *PruningParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruningParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruningParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruningParams proto.InternalMessageInfo
+
+func (m *PruningParams) GetMaxTicketsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxTicketsPerBlock
+	}
+	return 0
+}
+
+// SalesParams contain the parameters used to halt the ticket sales of all the
+// pools at once
+type SalesParams struct {
+	// Tells whether the ticket sales of all the pools are halted
+	Halted bool `protobuf:"varint,1,opt,name=halted,proto3" json:"halted,omitempty"`
+	// Reason why the ticket sales have been halted. Must be empty when the sales
+	// are not halted
+	HaltReason string `protobuf:"bytes,2,opt,name=halt_reason,json=haltReason,proto3" json:"halt_reason,omitempty" yaml:"halt_reason"`
+}
+
+func (m *SalesParams) Reset()         { *m = SalesParams{} }
+func (m *SalesParams) String() string { return proto.CompactTextString(m) }
+func (*SalesParams) ProtoMessage()    {}
+func (*SalesParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{9}
+}
+func (m *SalesParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SalesParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SalesParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SalesParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SalesParams.Merge(m, src)
+}
+func (m *SalesParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SalesParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SalesParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SalesParams proto.InternalMessageInfo
+
+func (m *SalesParams) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *SalesParams) GetHaltReason() string {
+	if m != nil {
+		return m.HaltReason
+	}
+	return ""
+}
+
+// SpendingLimitParams contain the parameters used when the addresses change
+// their own spending limits
+type SpendingLimitParams struct {
+	// Cooling-off delay after which a raised spending limit replaces the current
+	// one. Lowered spending limits are applied immediately
+	IncreaseDelay time.Duration `protobuf:"bytes,1,opt,name=increase_delay,json=increaseDelay,proto3,stdduration" json:"increase_delay" yaml:"increase_delay"`
+}
+
+func (m *SpendingLimitParams) Reset()         { *m = SpendingLimitParams{} }
+func (m *SpendingLimitParams) String() string { return proto.CompactTextString(m) }
+func (*SpendingLimitParams) ProtoMessage()    {}
+func (*SpendingLimitParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{10}
+}
+func (m *SpendingLimitParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendingLimitParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendingLimitParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendingLimitParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendingLimitParams.Merge(m, src)
+}
+func (m *SpendingLimitParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendingLimitParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendingLimitParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendingLimitParams proto.InternalMessageInfo
+
+func (m *SpendingLimitParams) GetIncreaseDelay() time.Duration {
+	if m != nil {
+		return m.IncreaseDelay
+	}
+	return 0
+}
+
+// OracleParams contain the parameters of the price feed used to convert the
+// ticket prices expressed in the reference unit
+type OracleParams struct {
+	// Addresses allowed to post the prices of the denominations
+	Oracles []string `protobuf:"bytes,1,rep,name=oracles,proto3" json:"oracles,omitempty" yaml:"oracles"`
+	// Max age of the posted prices, after which they are no longer used to
+	// compute the median price of their denomination
+	MaxPriceAge time.Duration `protobuf:"bytes,2,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age" yaml:"max_price_age"`
+}
+
+func (m *OracleParams) Reset()         { *m = OracleParams{} }
+func (m *OracleParams) String() string { return proto.CompactTextString(m) }
+func (*OracleParams) ProtoMessage()    {}
+func (*OracleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce4ff2a375989179, []int{11}
+}
+func (m *OracleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleParams.Merge(m, src)
+}
+func (m *OracleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleParams proto.InternalMessageInfo
+
+func (m *OracleParams) GetOracles() []string {
+	if m != nil {
+		return m.Oracles
+	}
+	return nil
+}
+
+func (m *OracleParams) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmicbet.wta.v1beta1.FeeDestination", FeeDestination_name, FeeDestination_value)
+	proto.RegisterEnum("cosmicbet.wta.v1beta1.GameType", GameType_name, GameType_value)
+	proto.RegisterType((*DistributionParams)(nil), "cosmicbet.wta.v1beta1.DistributionParams")
+	proto.RegisterType((*DrawParams)(nil), "cosmicbet.wta.v1beta1.DrawParams")
+	proto.RegisterType((*TicketParams)(nil), "cosmicbet.wta.v1beta1.TicketParams")
+	proto.RegisterType((*PrizeParams)(nil), "cosmicbet.wta.v1beta1.PrizeParams")
+	proto.RegisterType((*PrizeTier)(nil), "cosmicbet.wta.v1beta1.PrizeTier")
+	proto.RegisterType((*GameParams)(nil), "cosmicbet.wta.v1beta1.GameParams")
+	proto.RegisterType((*MatchTier)(nil), "cosmicbet.wta.v1beta1.MatchTier")
+	proto.RegisterType((*RolloverParams)(nil), "cosmicbet.wta.v1beta1.RolloverParams")
+	proto.RegisterType((*PruningParams)(nil), "cosmicbet.wta.v1beta1.PruningParams")
+	proto.RegisterType((*SalesParams)(nil), "cosmicbet.wta.v1beta1.SalesParams")
+	proto.RegisterType((*SpendingLimitParams)(nil), "cosmicbet.wta.v1beta1.SpendingLimitParams")
+	proto.RegisterType((*OracleParams)(nil), "cosmicbet.wta.v1beta1.OracleParams")
+}
+
+func init() {
+	proto.RegisterFile("cosmicbet/wta/v1beta1/params.proto", fileDescriptor_ce4ff2a375989179)
+}
+
+var fileDescriptor_ce4ff2a375989179 = []byte{
+	// 1279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x49, 0x8f, 0x1b, 0xc5,
+	0x17, 0x77, 0xcf, 0x92, 0x78, 0x6a, 0xc6, 0x1e, 0xa7, 0x66, 0x89, 0xd3, 0x7f, 0xa5, 0xdb, 0xff,
+	0x46, 0x84, 0x51, 0x04, 0xb6, 0x12, 0xc4, 0x22, 0x84, 0x84, 0xbc, 0x25, 0x44, 0xf1, 0xa6, 0x76,
+	0x47, 0x68, 0x90, 0xa0, 0x55, 0x6e, 0x97, 0x3b, 0xcd, 0x74, 0x77, 0x99, 0xea, 0xf2, 0x8c, 0x27,
+	0x9f, 0x00, 0x0d, 0x97, 0x9c, 0x80, 0x8b, 0x4f, 0xdc, 0xf8, 0x24, 0xe1, 0x96, 0x23, 0xe2, 0xe0,
+	0xa0, 0xe4, 0x1b, 0xcc, 0x99, 0x03, 0xaa, 0xea, 0x65, 0x6c, 0x93, 0x19, 0x42, 0xc2, 0xc9, 0x5d,
+	0xaf, 0xde, 0xef, 0xf7, 0xf6, 0x57, 0x06, 0x9a, 0x45, 0x02, 0xcf, 0xb1, 0x7a, 0x98, 0x95, 0x8e,
+	0x18, 0x2a, 0x1d, 0xde, 0xea, 0x61, 0x86, 0x6e, 0x95, 0x86, 0x88, 0x22, 0x2f, 0x28, 0x0e, 0x29,
+	0x61, 0x04, 0xee, 0x24, 0x3a, 0xc5, 0x23, 0x86, 0x8a, 0x91, 0x8e, 0xac, 0xd8, 0x84, 0xd8, 0x2e,
+	0x2e, 0x09, 0xa5, 0xde, 0x68, 0x50, 0xea, 0x8f, 0x28, 0x62, 0x0e, 0xf1, 0x43, 0x98, 0xbc, 0x6d,
+	0x13, 0x9b, 0x88, 0xcf, 0x12, 0xff, 0x8a, 0xa4, 0x0a, 0x27, 0x23, 0x41, 0xa9, 0x87, 0x02, 0x9c,
+	0x98, 0xb3, 0x88, 0x13, 0xa1, 0xb4, 0xef, 0x97, 0x01, 0xac, 0x39, 0x01, 0xa3, 0x4e, 0x6f, 0xc4,
+	0xc9, 0x3a, 0xc2, 0x13, 0xb8, 0x0f, 0x72, 0x43, 0xea, 0x3c, 0xc2, 0xe6, 0x10, 0x53, 0x0b, 0xfb,
+	0x0c, 0xd9, 0x38, 0x2f, 0x15, 0xa4, 0xbd, 0xb5, 0x4a, 0xf1, 0xc9, 0x54, 0x4d, 0xfd, 0x3e, 0x55,
+	0x6f, 0xd8, 0x0e, 0x7b, 0x38, 0xea, 0x15, 0x2d, 0xe2, 0x95, 0x22, 0x1b, 0xe1, 0xcf, 0x7b, 0x41,
+	0xff, 0xa0, 0xc4, 0x8e, 0x87, 0x38, 0x28, 0xd6, 0xb0, 0xa5, 0x6f, 0x0a, 0x9e, 0x4e, 0x42, 0x03,
+	0xbf, 0x00, 0x9b, 0xbd, 0x11, 0xf5, 0x67, 0x99, 0x97, 0x5e, 0x8b, 0x39, 0xcb, 0x69, 0x66, 0x88,
+	0x1f, 0x80, 0xec, 0x00, 0xcf, 0x79, 0xbc, 0xfc, 0x5a, 0xbc, 0x99, 0x01, 0x9e, 0xf5, 0xf7, 0x1b,
+	0xb0, 0xc9, 0x69, 0xfb, 0x38, 0x60, 0x8e, 0x2f, 0x12, 0x9e, 0x5f, 0x29, 0x48, 0x7b, 0xd9, 0xdb,
+	0x6f, 0x17, 0x5f, 0x5a, 0xa8, 0xe2, 0x1d, 0x8c, 0x6b, 0x67, 0xca, 0x15, 0xf9, 0x74, 0xaa, 0xee,
+	0x1e, 0x23, 0xcf, 0xfd, 0x44, 0x5b, 0xe0, 0xd1, 0xf4, 0xec, 0x60, 0x4e, 0x57, 0xfb, 0x75, 0x09,
+	0x80, 0x1a, 0x45, 0x47, 0x51, 0x15, 0x3e, 0x03, 0xe9, 0xb8, 0xc8, 0xc2, 0xe6, 0xfa, 0xed, 0x6b,
+	0xc5, 0xb0, 0x0b, 0x8a, 0x71, 0x17, 0x14, 0x6b, 0x91, 0x42, 0x25, 0xcd, 0xc3, 0xfc, 0xe9, 0x99,
+	0x2a, 0xe9, 0x09, 0x08, 0x36, 0xc0, 0x26, 0xc5, 0x87, 0x18, 0xb9, 0x66, 0xc2, 0xb3, 0xfa, 0xea,
+	0x3c, 0xd9, 0x10, 0x1b, 0xdf, 0xc0, 0xaf, 0xc0, 0x46, 0x80, 0x5c, 0x1c, 0x98, 0xd6, 0x88, 0x91,
+	0xc1, 0x20, 0x7f, 0xe9, 0x9f, 0xa8, 0x54, 0x4e, 0x75, 0x3a, 0x55, 0xb7, 0xc2, 0xf0, 0x67, 0xc1,
+	0x9a, 0xb0, 0xb0, 0x2e, 0x44, 0x55, 0x21, 0x81, 0x4d, 0xb0, 0x85, 0x82, 0xc0, 0xb1, 0x7d, 0x93,
+	0x11, 0xd3, 0xc7, 0x63, 0x66, 0xf6, 0x29, 0x3a, 0xca, 0x5f, 0x2e, 0x48, 0x7b, 0xe9, 0x8a, 0x72,
+	0x3a, 0x55, 0xe5, 0x90, 0xe6, 0x25, 0x4a, 0x9a, 0x9e, 0x0b, 0xa5, 0x06, 0x69, 0xe1, 0x31, 0xe3,
+	0x29, 0xd4, 0xfe, 0x5c, 0x06, 0x1b, 0x86, 0x63, 0x1d, 0x60, 0x16, 0x65, 0xf3, 0x03, 0xb0, 0x3a,
+	0xa4, 0x8e, 0x85, 0x93, 0x14, 0x84, 0xd5, 0x2f, 0xf2, 0xd1, 0x48, 0x8a, 0x57, 0x25, 0x8e, 0x5f,
+	0x59, 0xe1, 0x7e, 0xeb, 0xa1, 0x36, 0xdc, 0x07, 0x57, 0x3d, 0x34, 0x36, 0x99, 0xa0, 0x0a, 0x78,
+	0x7b, 0x99, 0xa8, 0xdf, 0xa7, 0x38, 0x08, 0x44, 0x02, 0x32, 0x15, 0xed, 0x74, 0xaa, 0x2a, 0xa1,
+	0x6b, 0xe7, 0x28, 0x6a, 0xfa, 0xb6, 0x87, 0xc6, 0xa1, 0x33, 0x41, 0x07, 0xd3, 0x72, 0x28, 0xe6,
+	0x11, 0x2f, 0x22, 0xbc, 0xc0, 0x16, 0x11, 0x67, 0x66, 0x23, 0x7e, 0x89, 0x92, 0xa6, 0xe7, 0xe6,
+	0x28, 0x9b, 0x81, 0x0d, 0x7f, 0x94, 0x00, 0x44, 0x2e, 0xc3, 0x94, 0x77, 0xd3, 0x21, 0x36, 0x85,
+	0xff, 0x41, 0x3e, 0x5d, 0x58, 0xbe, 0x38, 0xdc, 0x66, 0x54, 0xa6, 0x6b, 0x51, 0x7e, 0xff, 0x46,
+	0xa1, 0xfd, 0xf2, 0x4c, 0xdd, 0x7b, 0x85, 0xe9, 0xe1, 0x6c, 0x81, 0x7e, 0x65, 0x86, 0xa0, 0x23,
+	0xf0, 0xf0, 0x5b, 0xde, 0x87, 0x03, 0x4c, 0xb1, 0x6f, 0x45, 0x9c, 0xf9, 0x35, 0x31, 0x9b, 0x9f,
+	0xff, 0xbb, 0xd9, 0x3c, 0x1b, 0xa5, 0x05, 0x3a, 0x4d, 0xcf, 0x26, 0x12, 0x61, 0x53, 0xbb, 0x0f,
+	0xd6, 0x3b, 0x62, 0xf3, 0x84, 0xc5, 0xff, 0x14, 0xac, 0x32, 0x07, 0xd3, 0x20, 0x2f, 0x89, 0x6c,
+	0x14, 0xce, 0x99, 0x5d, 0x01, 0x31, 0x1c, 0x4c, 0xe3, 0x1e, 0x10, 0x20, 0x6d, 0x04, 0xd6, 0x92,
+	0x1b, 0x98, 0x07, 0x97, 0x8f, 0x1c, 0xdf, 0x0f, 0xc9, 0xa4, 0xbd, 0x8c, 0x1e, 0x1f, 0x61, 0x0b,
+	0x80, 0x37, 0xde, 0x6a, 0x33, 0x0c, 0xda, 0x13, 0x09, 0x80, 0xbb, 0xc8, 0x3b, 0x8b, 0x61, 0xcd,
+	0x46, 0x1e, 0x36, 0xb9, 0xb2, 0x30, 0x9d, 0xbd, 0xad, 0x9e, 0x13, 0x07, 0x47, 0x19, 0xc7, 0x43,
+	0xac, 0xa7, 0xed, 0xe8, 0x0b, 0x6e, 0x83, 0xd5, 0xa1, 0x63, 0x1d, 0x04, 0xc2, 0xaf, 0x8c, 0x1e,
+	0x1e, 0xe0, 0x75, 0x00, 0x78, 0x77, 0xf9, 0x23, 0xaf, 0x87, 0xa9, 0x58, 0x98, 0x19, 0x7d, 0xcd,
+	0x43, 0xe3, 0x96, 0x10, 0xc0, 0xbb, 0x60, 0xdd, 0x43, 0xcc, 0x7a, 0x68, 0x86, 0xc9, 0x5b, 0xb9,
+	0x30, 0x79, 0x4d, 0xae, 0x39, 0x93, 0x3c, 0xe0, 0xc5, 0x02, 0x91, 0xc1, 0xe4, 0x9a, 0x67, 0x50,
+	0x5c, 0xe1, 0x24, 0x83, 0xd1, 0xf1, 0x3f, 0xcf, 0xe0, 0x0f, 0x12, 0xc8, 0xea, 0xc4, 0x75, 0xc9,
+	0x21, 0xa6, 0x51, 0x16, 0xdf, 0x02, 0x19, 0x1e, 0x31, 0x8d, 0xa4, 0xb1, 0x0b, 0x1b, 0x1e, 0x1a,
+	0xc7, 0x9a, 0x01, 0x34, 0xc1, 0x16, 0xa3, 0x18, 0x05, 0x23, 0x7a, 0xfc, 0xe6, 0x0f, 0x15, 0x8c,
+	0xa9, 0xce, 0x5e, 0x15, 0xad, 0x02, 0x32, 0x1d, 0x3a, 0xf2, 0x1d, 0xdf, 0x8e, 0xdc, 0xba, 0x05,
+	0x76, 0x16, 0xc7, 0xbc, 0xe7, 0x12, 0xeb, 0x20, 0x72, 0x0f, 0xce, 0x4d, 0x7b, 0x85, 0xdf, 0x68,
+	0x5f, 0x83, 0xf5, 0x2e, 0xdf, 0x9f, 0x11, 0xc3, 0x2e, 0xb8, 0xf4, 0x90, 0x8f, 0x5e, 0x5f, 0x40,
+	0xd2, 0x7a, 0x74, 0x82, 0x1f, 0x81, 0x75, 0xfe, 0x65, 0x72, 0x27, 0x88, 0x1f, 0xc5, 0xb0, 0x7b,
+	0x3a, 0x55, 0x61, 0x38, 0x4a, 0x33, 0x97, 0x9a, 0x0e, 0xf8, 0x49, 0x0f, 0x0f, 0x8f, 0xc0, 0x56,
+	0x77, 0x88, 0xfd, 0xbe, 0xe3, 0xdb, 0x0d, 0xc7, 0x73, 0xe2, 0x3d, 0x6a, 0x81, 0xac, 0xe3, 0x5b,
+	0x1c, 0xc0, 0x5f, 0x33, 0x17, 0x1d, 0x0b, 0x7b, 0x17, 0x3e, 0x04, 0xff, 0x8f, 0x36, 0xcc, 0x4e,
+	0x68, 0x71, 0x1e, 0x1e, 0x3e, 0x05, 0x99, 0x58, 0x58, 0x13, 0xb2, 0x89, 0x04, 0x36, 0xda, 0x14,
+	0x59, 0x6e, 0xdc, 0xfc, 0xef, 0x82, 0xcb, 0x44, 0x9c, 0xc3, 0x11, 0x5e, 0xab, 0xc0, 0xd3, 0xa9,
+	0x9a, 0x0d, 0xf9, 0xa2, 0x0b, 0x4d, 0x8f, 0x55, 0xa0, 0x19, 0x16, 0x59, 0xec, 0x06, 0x33, 0xae,
+	0xdc, 0x85, 0x2e, 0x16, 0x22, 0x17, 0xb7, 0xcf, 0x56, 0x6e, 0x82, 0x8e, 0x1e, 0x2b, 0x0f, 0x8d,
+	0xc5, 0x6e, 0x29, 0xdb, 0xf8, 0xe6, 0xe3, 0x25, 0x90, 0x9d, 0x7f, 0xe8, 0x61, 0x19, 0x5c, 0xbf,
+	0x53, 0xaf, 0x9b, 0xb5, 0x7a, 0xd7, 0xb8, 0xd7, 0x2a, 0x1b, 0xf7, 0xda, 0x2d, 0x93, 0x9f, 0xab,
+	0xed, 0x46, 0xa3, 0x5e, 0x35, 0xda, 0x7a, 0x2e, 0x25, 0x2b, 0x27, 0x93, 0x82, 0x3c, 0x0f, 0xbb,
+	0x83, 0x71, 0x95, 0xb8, 0x2e, 0xb6, 0x18, 0xa1, 0xb0, 0x0a, 0x94, 0x45, 0x8a, 0x6a, 0xbb, 0xd9,
+	0x7c, 0xd0, 0xba, 0x67, 0xec, 0x9b, 0x9d, 0x76, 0xbb, 0x91, 0x93, 0x64, 0xf5, 0x64, 0x52, 0xf8,
+	0xdf, 0x3c, 0x47, 0x95, 0x78, 0xde, 0xc8, 0x77, 0xd8, 0x71, 0x87, 0x10, 0x17, 0x7e, 0x0c, 0xf2,
+	0x8b, 0x24, 0x86, 0x5e, 0x2f, 0x77, 0x1f, 0xe8, 0xfb, 0xb9, 0x25, 0x59, 0x3e, 0x99, 0x14, 0x76,
+	0xe7, 0xe1, 0x46, 0xd4, 0x9e, 0xf0, 0x43, 0x70, 0x75, 0x11, 0xd9, 0x35, 0xca, 0xf7, 0xeb, 0x7a,
+	0x37, 0xb7, 0x2c, 0x5f, 0x3b, 0x99, 0x14, 0x76, 0xe6, 0x81, 0x5d, 0x86, 0x0e, 0x30, 0x0d, 0xe4,
+	0x95, 0xef, 0x7e, 0x56, 0x52, 0x37, 0x7b, 0x20, 0x1d, 0xaf, 0x1d, 0xb8, 0x07, 0x72, 0x77, 0xcb,
+	0xcd, 0xba, 0x69, 0xec, 0x77, 0xea, 0xa6, 0x5e, 0x6e, 0xd5, 0xda, 0xcd, 0x5c, 0x4a, 0x86, 0x27,
+	0x93, 0x42, 0x36, 0x59, 0x4d, 0xc8, 0xef, 0x13, 0x0f, 0xde, 0x00, 0x9b, 0x67, 0x9a, 0x8d, 0xb6,
+	0x61, 0xb4, 0x73, 0x92, 0x7c, 0xe5, 0x64, 0x52, 0xc8, 0xc4, 0x8a, 0x0d, 0xc2, 0x18, 0x09, 0x6d,
+	0x54, 0xca, 0x4f, 0x9e, 0x2b, 0xd2, 0xd3, 0xe7, 0x8a, 0xf4, 0xc7, 0x73, 0x45, 0x7a, 0xfc, 0x42,
+	0x49, 0x3d, 0x7d, 0xa1, 0xa4, 0x7e, 0x7b, 0xa1, 0xa4, 0xbe, 0x7c, 0x67, 0x61, 0x18, 0xc3, 0x3f,
+	0xd9, 0x2e, 0xee, 0xdb, 0x98, 0x96, 0xc6, 0xe2, 0xdf, 0xb6, 0x98, 0xc8, 0xde, 0x25, 0x51, 0xfb,
+	0xf7, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xff, 0x4e, 0x98, 0x64, 0x8b, 0x0b, 0x00, 0x00,
+}
+
+func (m *DistributionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeDestination))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BurnPercentage.Size()
+		i -= size
+		if _, err := m.BurnPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.PrizePercentage.Size()
+		i -= size
+		if _, err := m.PrizePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DrawParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrawParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AssignToNextDraw {
+		i--
+		if m.AssignToNextDraw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SalesCutoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SalesCutoff):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RevealDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RevealDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *TicketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TicketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TicketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.AlternativePrices) > 0 {
+		for iNdEx := len(m.AlternativePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AlternativePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxTicketsPerMsg != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTicketsPerMsg))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxTicketsPerAddress != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTicketsPerAddress))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+
+func (m *PrizeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrizeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrizeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrizeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrizeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrizeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Winners != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Winners))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MatchTiers) > 0 {
+		for iNdEx := len(m.MatchTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MatchTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxNumber != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Picks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Picks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GameType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GameType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MatchTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatchTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Matches != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Matches))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloverParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloverParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloverParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TreasuryPercentage.Size()
+		i -= size
+		if _, err := m.TreasuryPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxRollovers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRollovers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruningParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruningParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruningParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTicketsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTicketsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SalesParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SalesParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SalesParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HaltReason) > 0 {
+		i -= len(m.HaltReason)
+		copy(dAtA[i:], m.HaltReason)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.HaltReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendingLimitParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendingLimitParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendingLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.IncreaseDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.IncreaseDelay):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DistributionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PrizePercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BurnPercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.FeeDestination != 0 {
+		n += 1 + sovParams(uint64(m.FeeDestination))
+	}
+	return n
+}
+
+func (m *DrawParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RevealDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SalesCutoff)
+	n += 1 + l + sovParams(uint64(l))
+	if m.AssignToNextDraw {
+		n += 2
+	}
+	return n
+}
+
+func (m *TicketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxTicketsPerAddress != 0 {
+		n += 1 + sovParams(uint64(m.MaxTicketsPerAddress))
+	}
+	if m.MaxTicketsPerMsg != 0 {
+		n += 1 + sovParams(uint64(m.MaxTicketsPerMsg))
+	}
+	if len(m.AlternativePrices) > 0 {
+		for _, e := range m.AlternativePrices {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PrizeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrizeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Winners != 0 {
+		n += 1 + sovParams(uint64(m.Winners))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *GameParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameType != 0 {
+		n += 1 + sovParams(uint64(m.GameType))
+	}
+	if m.Picks != 0 {
+		n += 1 + sovParams(uint64(m.Picks))
+	}
+	if m.MaxNumber != 0 {
+		n += 1 + sovParams(uint64(m.MaxNumber))
+	}
+	if len(m.MatchTiers) > 0 {
+		for _, e := range m.MatchTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MatchTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Matches != 0 {
+		n += 1 + sovParams(uint64(m.Matches))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RolloverParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRollovers != 0 {
+		n += 1 + sovParams(uint64(m.MaxRollovers))
+	}
+	l = m.TreasuryPercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PruningParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTicketsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxTicketsPerBlock))
+	}
+	return n
+}
+
+func (m *SalesParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Halted {
+		n += 2
+	}
+	l = len(m.HaltReason)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *SpendingLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.IncreaseDelay)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *OracleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Oracles) > 0 {
+		for _, s := range m.Oracles {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DistributionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrizePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+			}
+			m.FeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeDestination |= FeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrawParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrawParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RevealDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalesCutoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SalesCutoff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignToNextDraw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AssignToNextDraw = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TicketParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TicketParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TicketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTicketsPerAddress", wireType)
+			}
+			m.MaxTicketsPerAddress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTicketsPerAddress |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTicketsPerMsg", wireType)
+			}
+			m.MaxTicketsPerMsg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTicketsPerMsg |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlternativePrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlternativePrices = append(m.AlternativePrices, types.Coin{})
+			if err := m.AlternativePrices[len(m.AlternativePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrizeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrizeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrizeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, PrizeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrizeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrizeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrizeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winners", wireType)
+			}
+			m.Winners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Winners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameType", wireType)
+			}
+			m.GameType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameType |= GameType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Picks", wireType)
+			}
+			m.Picks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Picks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumber", wireType)
+			}
+			m.MaxNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatchTiers = append(m.MatchTiers, MatchTier{})
+			if err := m.MatchTiers[len(m.MatchTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MatchTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatchTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatchTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
+			}
+			m.Matches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Matches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DistributionParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PrizePercentage.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.BurnPercentage.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.FeePercentage.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
-
-func (m *DrawParams) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
 
-func (m *TicketParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DistributionParams) Unmarshal(dAtA []byte) error {
+func (m *RolloverParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -401,15 +2616,34 @@ func (m *DistributionParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloverParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloverParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRollovers", wireType)
+			}
+			m.MaxRollovers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRollovers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrizePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryPercentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -437,15 +2671,65 @@ func (m *DistributionParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrizePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TreasuryPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnPercentage", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruningParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruningParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruningParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTicketsPerBlock", wireType)
+			}
+			m.MaxTicketsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -455,29 +2739,84 @@ func (m *DistributionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxTicketsPerBlock |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SalesParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SalesParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SalesParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -505,9 +2844,7 @@ func (m *DistributionParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HaltReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -530,7 +2867,7 @@ func (m *DistributionParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DrawParams) Unmarshal(dAtA []byte) error {
+func (m *SpendingLimitParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -553,15 +2890,15 @@ func (m *DrawParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DrawParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpendingLimitParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DrawParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpendingLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncreaseDelay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -588,7 +2925,7 @@ func (m *DrawParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.IncreaseDelay, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -613,7 +2950,7 @@ func (m *DrawParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TicketParams) Unmarshal(dAtA []byte) error {
+func (m *OracleParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -636,15 +2973,47 @@ func (m *TicketParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TicketParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TicketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 5:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -671,7 +3040,7 @@ func (m *TicketParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
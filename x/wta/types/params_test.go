@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ func TestValidateDistributionParams(t *testing.T) {
 				sdk.NewDecWithPrec(0, 2),
 				sdk.NewDecWithPrec(99, 2),
 				sdk.NewDecWithPrec(1, 2),
+				types.FeeDestinationFeeCollector,
 			),
 			shouldErr: true,
 		},
@@ -31,6 +33,7 @@ func TestValidateDistributionParams(t *testing.T) {
 				sdk.NewDecWithPrec(90, 2),
 				sdk.NewDecWithPrec(101, 2),
 				sdk.NewDecWithPrec(1, 2),
+				types.FeeDestinationFeeCollector,
 			),
 			shouldErr: true,
 		},
@@ -40,6 +43,7 @@ func TestValidateDistributionParams(t *testing.T) {
 				sdk.NewDecWithPrec(1, 2),
 				sdk.NewDecWithPrec(99, 2),
 				sdk.NewDecWithPrec(-1, 2),
+				types.FeeDestinationFeeCollector,
 			),
 			shouldErr: true,
 		},
@@ -49,6 +53,7 @@ func TestValidateDistributionParams(t *testing.T) {
 				sdk.NewDecWithPrec(90, 2),
 				sdk.NewDecWithPrec(5, 2),
 				sdk.NewDecWithPrec(6, 2),
+				types.FeeDestinationFeeCollector,
 			),
 			shouldErr: true,
 		},
@@ -58,9 +63,30 @@ func TestValidateDistributionParams(t *testing.T) {
 				sdk.NewDecWithPrec(90, 2),
 				sdk.NewDecWithPrec(5, 2),
 				sdk.NewDecWithPrec(4, 2),
+				types.FeeDestinationFeeCollector,
 			),
 			shouldErr: true,
 		},
+		{
+			name: "invalid fee destination",
+			params: types.NewDistributionParams(
+				sdk.NewDecWithPrec(90, 2),
+				sdk.NewDecWithPrec(5, 2),
+				sdk.NewDecWithPrec(5, 2),
+				types.FeeDestination(10),
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid params",
+			params: types.NewDistributionParams(
+				sdk.NewDecWithPrec(90, 2),
+				sdk.NewDecWithPrec(5, 2),
+				sdk.NewDecWithPrec(5, 2),
+				types.FeeDestinationStakers,
+			),
+			shouldErr: false,
+		},
 	}
 
 	for _, uc := range usecases {
@@ -85,14 +111,44 @@ func TestValidateDrawParams(t *testing.T) {
 
 		{
 			name:      "zero duration",
-			params:    types.NewDrawParams(time.Minute * 0),
+			params:    types.NewDrawParams(time.Minute*0, time.Minute, 0, false),
 			shouldErr: true,
 		},
 		{
 			name:      "invalid duration",
-			params:    types.NewDrawParams(time.Second * 30),
+			params:    types.NewDrawParams(time.Second*30, time.Minute, 0, false),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid reveal duration",
+			params:    types.NewDrawParams(time.Minute, -time.Second, 0, false),
+			shouldErr: true,
+		},
+		{
+			name:      "negative sales cutoff",
+			params:    types.NewDrawParams(time.Minute, 0, -time.Second, false),
+			shouldErr: true,
+		},
+		{
+			name:      "sales cutoff not shorter than the duration",
+			params:    types.NewDrawParams(time.Minute, 0, time.Minute, true),
 			shouldErr: true,
 		},
+		{
+			name:      "valid params with sales cutoff",
+			params:    types.NewDrawParams(time.Hour, time.Minute, time.Minute*5, true),
+			shouldErr: false,
+		},
+		{
+			name:      "valid params without reveal window",
+			params:    types.NewDrawParams(time.Minute, 0, 0, false),
+			shouldErr: false,
+		},
+		{
+			name:      "valid params",
+			params:    types.NewDrawParams(time.Hour, time.Minute, 0, false),
+			shouldErr: false,
+		},
 	}
 
 	for _, uc := range usecases {
@@ -118,13 +174,98 @@ func TestValidateTicketParams(t *testing.T) {
 			name: "invalid ticket price",
 			params: types.NewTicketParams(
 				sdk.Coin{Denom: "./", Amount: sdk.NewInt(100)},
+				nil,
+				sdk.ZeroDec(),
+				0,
+				0,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "invalid alternative price",
+			params: types.NewTicketParams(
+				sdk.NewInt64Coin("stake", 100),
+				sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(0)}},
+				sdk.ZeroDec(),
+				0,
+				0,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "alternative price using the main price denom",
+			params: types.NewTicketParams(
+				sdk.NewInt64Coin("stake", 100),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				sdk.ZeroDec(),
+				0,
+				0,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "negative reference price",
+			params: types.NewTicketParams(
+				sdk.NewInt64Coin("stake", 100),
+				nil,
+				sdk.NewDec(-1),
+				0,
+				0,
 			),
 			shouldErr: true,
 		},
+		{
+			name: "max tickets per message greater than max tickets per address",
+			params: types.NewTicketParams(
+				sdk.NewInt64Coin("stake", 100),
+				nil,
+				sdk.ZeroDec(),
+				10,
+				20,
+			),
+			shouldErr: true,
+		},
+		{
+			name: "valid params with limits",
+			params: types.NewTicketParams(
+				sdk.NewInt64Coin("stake", 100),
+				nil,
+				sdk.ZeroDec(),
+				100,
+				10,
+			),
+			shouldErr: false,
+		},
+		{
+			name: "valid params with alternative prices",
+			params: types.NewTicketParams(
+				sdk.NewInt64Coin("stake", 100),
+				sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("btc", 1)),
+				sdk.ZeroDec(),
+				0,
+				0,
+			),
+			shouldErr: false,
+		},
+		{
+			name: "valid params with reference price",
+			params: types.NewTicketParams(
+				sdk.NewInt64Coin("stake", 100),
+				sdk.NewCoins(sdk.NewInt64Coin("atom", 5)),
+				sdk.NewDec(1),
+				0,
+				0,
+			),
+			shouldErr: false,
+		},
 		{
 			name: "valid params",
 			params: types.NewTicketParams(
 				sdk.NewInt64Coin("stake", 100),
+				nil,
+				sdk.ZeroDec(),
+				0,
+				0,
 			),
 			shouldErr: false,
 		},
@@ -142,3 +283,450 @@ func TestValidateTicketParams(t *testing.T) {
 		})
 	}
 }
+
+func TestTicketParams_PriceOf(t *testing.T) {
+	params := types.NewTicketParams(
+		sdk.NewInt64Coin("stake", 100),
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 5)),
+		sdk.ZeroDec(),
+		0,
+		0,
+	)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 5)), params.Prices())
+
+	price, found := params.PriceOf("")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), price)
+
+	price, found = params.PriceOf("stake")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), price)
+
+	price, found = params.PriceOf("atom")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("atom", 5), price)
+
+	_, found = params.PriceOf("btc")
+	require.False(t, found)
+}
+
+func TestValidatePrizeParams(t *testing.T) {
+	usecases := []struct {
+		name      string
+		params    types.PrizeParams
+		shouldErr bool
+	}{
+		{
+			name:      "empty tiers",
+			params:    types.NewPrizeParams(nil),
+			shouldErr: true,
+		},
+		{
+			name: "tier without winners",
+			params: types.NewPrizeParams([]types.PrizeTier{
+				types.NewPrizeTier(0, sdk.NewDecWithPrec(100, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "invalid tier percentage",
+			params: types.NewPrizeParams([]types.PrizeTier{
+				types.NewPrizeTier(1, sdk.NewDecWithPrec(-10, 2)),
+				types.NewPrizeTier(1, sdk.NewDecWithPrec(110, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "too many winners",
+			params: types.NewPrizeParams([]types.PrizeTier{
+				types.NewPrizeTier(types.MaxPrizeWinners+1, sdk.NewDecWithPrec(100, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "percentages not summing to 100",
+			params: types.NewPrizeParams([]types.PrizeTier{
+				types.NewPrizeTier(1, sdk.NewDecWithPrec(70, 2)),
+				types.NewPrizeTier(2, sdk.NewDecWithPrec(20, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "valid params",
+			params: types.NewPrizeParams([]types.PrizeTier{
+				types.NewPrizeTier(1, sdk.NewDecWithPrec(70, 2)),
+				types.NewPrizeTier(2, sdk.NewDecWithPrec(20, 2)),
+				types.NewPrizeTier(10, sdk.NewDecWithPrec(10, 2)),
+			}),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.ValidatePrizeParams(uc.params)
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPrizeParams_SplitPrize(t *testing.T) {
+	params := types.NewPrizeParams([]types.PrizeTier{
+		types.NewPrizeTier(1, sdk.NewDecWithPrec(70, 2)),
+		types.NewPrizeTier(2, sdk.NewDecWithPrec(20, 2)),
+		types.NewPrizeTier(3, sdk.NewDecWithPrec(10, 2)),
+	})
+	require.Equal(t, uint32(6), params.TotalWinners())
+
+	tiers, amounts := params.SplitPrize(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	require.Equal(t, []uint32{0, 1, 1, 2, 2, 2}, tiers)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 700)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+	}, amounts)
+}
+
+func TestValidateRolloverParams(t *testing.T) {
+	usecases := []struct {
+		name      string
+		params    types.RolloverParams
+		shouldErr bool
+	}{
+		{
+			name:      "nil treasury percentage",
+			params:    types.NewRolloverParams(1, sdk.Dec{}),
+			shouldErr: true,
+		},
+		{
+			name:      "negative treasury percentage",
+			params:    types.NewRolloverParams(1, sdk.NewDecWithPrec(-1, 2)),
+			shouldErr: true,
+		},
+		{
+			name:      "treasury percentage greater than 100",
+			params:    types.NewRolloverParams(1, sdk.NewDecWithPrec(101, 2)),
+			shouldErr: true,
+		},
+		{
+			name:      "valid unlimited rollovers",
+			params:    types.NewRolloverParams(0, sdk.ZeroDec()),
+			shouldErr: false,
+		},
+		{
+			name:      "valid params",
+			params:    types.NewRolloverParams(3, sdk.NewDecWithPrec(5, 2)),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.ValidateRolloverParams(uc.params)
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePruningParams(t *testing.T) {
+	usecases := []struct {
+		name      string
+		params    types.PruningParams
+		shouldErr bool
+	}{
+		{
+			name:      "zero max tickets per block",
+			params:    types.NewPruningParams(0),
+			shouldErr: true,
+		},
+		{
+			name:      "valid params",
+			params:    types.NewPruningParams(100),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.ValidatePruningParams(uc.params)
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSalesParams(t *testing.T) {
+	usecases := []struct {
+		name      string
+		params    types.SalesParams
+		shouldErr bool
+	}{
+		{
+			name:      "halted without reason",
+			params:    types.NewSalesParams(true, " "),
+			shouldErr: true,
+		},
+		{
+			name:      "not halted with reason",
+			params:    types.NewSalesParams(false, "Wrong ticket price"),
+			shouldErr: true,
+		},
+		{
+			name:      "too long halt reason",
+			params:    types.NewSalesParams(true, strings.Repeat("a", types.MaxHaltReasonLength+1)),
+			shouldErr: true,
+		},
+		{
+			name:      "valid halted params",
+			params:    types.NewSalesParams(true, "Wrong ticket price"),
+			shouldErr: false,
+		},
+		{
+			name:      "default params",
+			params:    types.DefaultSalesParams(),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.ValidateSalesParams(uc.params)
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSpendingLimitParams(t *testing.T) {
+	usecases := []struct {
+		name      string
+		params    types.SpendingLimitParams
+		shouldErr bool
+	}{
+		{
+			name:      "negative increase delay",
+			params:    types.NewSpendingLimitParams(-time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "zero increase delay",
+			params:    types.NewSpendingLimitParams(0),
+			shouldErr: false,
+		},
+		{
+			name:      "default params",
+			params:    types.DefaultSpendingLimitParams(),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.ValidateSpendingLimitParams(uc.params)
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateOracleParams(t *testing.T) {
+	usecases := []struct {
+		name      string
+		params    types.OracleParams
+		shouldErr bool
+	}{
+		{
+			name:      "invalid oracle address",
+			params:    types.NewOracleParams([]string{"cosmos1"}, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated oracle",
+			params: types.NewOracleParams([]string{
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+			}, time.Hour),
+			shouldErr: true,
+		},
+		{
+			name:      "zero max price age",
+			params:    types.NewOracleParams(nil, 0),
+			shouldErr: true,
+		},
+		{
+			name: "valid params",
+			params: types.NewOracleParams([]string{
+				"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl",
+				"cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu",
+			}, time.Minute*30),
+			shouldErr: false,
+		},
+		{
+			name:      "default params",
+			params:    types.DefaultOracleParams(),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.ValidateOracleParams(uc.params)
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOracleParams_IsOracle(t *testing.T) {
+	params := types.NewOracleParams([]string{"cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"}, time.Hour)
+	require.True(t, params.IsOracle("cosmos14zfwkjm35j05ydm3s3qu4he39yjxe9575echwl"))
+	require.False(t, params.IsOracle("cosmos1wa6xzttjv4n82mny94hhwmn9wgknytfdt3jwuu"))
+}
+
+func TestRolloverParams_CanRollover(t *testing.T) {
+	unlimited := types.NewRolloverParams(0, sdk.ZeroDec())
+	require.True(t, unlimited.CanRollover(1))
+	require.True(t, unlimited.CanRollover(100))
+
+	limited := types.NewRolloverParams(2, sdk.ZeroDec())
+	require.True(t, limited.CanRollover(1))
+	require.True(t, limited.CanRollover(2))
+	require.False(t, limited.CanRollover(3))
+}
+
+func TestValidateGameParams(t *testing.T) {
+	usecases := []struct {
+		name      string
+		params    types.GameParams
+		shouldErr bool
+	}{
+		{
+			name:      "random game with lotto params",
+			params:    types.NewGameParams(types.GameTypeRandom, 6, 49, nil),
+			shouldErr: true,
+		},
+		{
+			name:      "invalid game type",
+			params:    types.NewGameParams(types.GameType(2), 0, 0, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid picks",
+			params: types.NewGameParams(types.GameTypeLotto, 0, 49, []types.MatchTier{
+				types.NewMatchTier(6, sdk.NewDecWithPrec(100, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "max number lower than picks",
+			params: types.NewGameParams(types.GameTypeLotto, 6, 5, []types.MatchTier{
+				types.NewMatchTier(6, sdk.NewDecWithPrec(100, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "max number too high",
+			params: types.NewGameParams(types.GameTypeLotto, 6, types.MaxLottoNumber+1, []types.MatchTier{
+				types.NewMatchTier(6, sdk.NewDecWithPrec(100, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name:      "empty match tiers",
+			params:    types.NewGameParams(types.GameTypeLotto, 6, 49, nil),
+			shouldErr: true,
+		},
+		{
+			name: "invalid tier matches",
+			params: types.NewGameParams(types.GameTypeLotto, 6, 49, []types.MatchTier{
+				types.NewMatchTier(7, sdk.NewDecWithPrec(100, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "duplicated tier matches",
+			params: types.NewGameParams(types.GameTypeLotto, 6, 49, []types.MatchTier{
+				types.NewMatchTier(6, sdk.NewDecWithPrec(50, 2)),
+				types.NewMatchTier(6, sdk.NewDecWithPrec(50, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name: "percentages not summing to 100",
+			params: types.NewGameParams(types.GameTypeLotto, 6, 49, []types.MatchTier{
+				types.NewMatchTier(6, sdk.NewDecWithPrec(50, 2)),
+				types.NewMatchTier(5, sdk.NewDecWithPrec(30, 2)),
+			}),
+			shouldErr: true,
+		},
+		{
+			name:      "valid random game",
+			params:    types.DefaultGameParams(),
+			shouldErr: false,
+		},
+		{
+			name: "valid lotto game",
+			params: types.NewGameParams(types.GameTypeLotto, 6, 49, []types.MatchTier{
+				types.NewMatchTier(6, sdk.NewDecWithPrec(70, 2)),
+				types.NewMatchTier(5, sdk.NewDecWithPrec(20, 2)),
+				types.NewMatchTier(4, sdk.NewDecWithPrec(10, 2)),
+			}),
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.ValidateGameParams(uc.params)
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGameParams_ValidatePick(t *testing.T) {
+	params := types.NewGameParams(types.GameTypeLotto, 3, 10, []types.MatchTier{
+		types.NewMatchTier(3, sdk.NewDecWithPrec(100, 2)),
+	})
+
+	require.Error(t, types.DefaultGameParams().ValidatePick([]uint32{1, 2, 3}))
+	require.Error(t, params.ValidatePick([]uint32{1, 2}))
+	require.Error(t, params.ValidatePick([]uint32{0, 1, 2}))
+	require.Error(t, params.ValidatePick([]uint32{1, 2, 11}))
+	require.Error(t, params.ValidatePick([]uint32{1, 2, 2}))
+	require.NoError(t, params.ValidatePick([]uint32{10, 1, 5}))
+}
@@ -0,0 +1,246 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// TicketMerkleLeaf returns the bytes representing the given ticket as a leaf of the tickets Merkle tree.
+// The picked numbers are appended only to the leaves of lotto tickets, so that the leaves of random tickets
+// do not change
+func TicketMerkleLeaf(ticket Ticket) []byte {
+	if len(ticket.Numbers) == 0 {
+		return []byte(fmt.Sprintf("%s/%s", ticket.Id, ticket.Owner))
+	}
+
+	numbers := make([]string, len(ticket.Numbers))
+	for i, number := range ticket.Numbers {
+		numbers[i] = strconv.FormatUint(uint64(number), 10)
+	}
+	return []byte(fmt.Sprintf("%s/%s/%s", ticket.Id, ticket.Owner, strings.Join(numbers, ",")))
+}
+
+// TicketMerkleLeafHash returns the hash of the given ticket as a leaf of the tickets Merkle tree
+func TicketMerkleLeafHash(ticket Ticket) []byte {
+	// The hash of a single-leaf tree is the leaf hash itself
+	return merkle.HashFromByteSlices([][]byte{TicketMerkleLeaf(ticket)})
+}
+
+// MerkleInnerHash returns the hash of the inner node of a Merkle tree having the given children
+func MerkleInnerHash(left, right []byte) []byte {
+	bz := make([]byte, 0, 1+len(left)+len(right))
+	bz = append(bz, 1)
+	bz = append(bz, left...)
+	bz = append(bz, right...)
+	return tmhash.Sum(bz)
+}
+
+// MerkleNodeGetter returns the hash of the complete subtree at the given level and index of a Merkle tree,
+// or nil if such subtree is not complete
+type MerkleNodeGetter func(level uint8, index uint32) []byte
+
+// MerkleNodeSetter stores the hash of the complete subtree at the given level and index of a Merkle tree
+type MerkleNodeSetter func(level uint8, index uint32, hash []byte)
+
+// SetMerkleLeaf sets the leaf at the given position of a Merkle tree built by appending leaves, and updates all the
+// complete subtrees containing it. Only complete subtrees are stored, so that leaves can be added one at a time
+func SetMerkleLeaf(position uint32, leafHash []byte, get MerkleNodeGetter, set MerkleNodeSetter) {
+	hash := leafHash
+	index := position
+	level := uint8(0)
+	set(level, index, hash)
+
+	for {
+		// The parent is complete only if the sibling is complete as well
+		sibling := get(level, index^1)
+		if sibling == nil {
+			return
+		}
+
+		if index%2 == 0 {
+			hash = MerkleInnerHash(hash, sibling)
+		} else {
+			hash = MerkleInnerHash(sibling, hash)
+		}
+
+		index /= 2
+		level++
+		set(level, index, hash)
+	}
+}
+
+// merkleSubtrees returns the levels and the indexes of the complete subtrees that compose, from left to right,
+// the Merkle tree having the given total number of leaves
+func merkleSubtrees(total uint32) (levels []uint8, indexes []uint32) {
+	offset := uint32(0)
+	for level := 31; level >= 0; level-- {
+		size := uint32(1) << uint(level)
+		if total&size != 0 {
+			levels = append(levels, uint8(level))
+			indexes = append(indexes, offset>>uint(level))
+			offset += size
+		}
+	}
+	return levels, indexes
+}
+
+// foldMerkleSubtrees returns the hash of the tree composed by the given complete subtrees, from left to right
+func foldMerkleSubtrees(hashes [][]byte) []byte {
+	root := hashes[len(hashes)-1]
+	for i := len(hashes) - 2; i >= 0; i-- {
+		root = MerkleInnerHash(hashes[i], root)
+	}
+	return root
+}
+
+// ComputeMerkleRootFromNodes returns the root of the Merkle tree having the given total number of leaves,
+// using the complete subtrees stored by SetMerkleLeaf. The result is the same as merkle.HashFromByteSlices
+func ComputeMerkleRootFromNodes(total uint32, get MerkleNodeGetter) []byte {
+	if total == 0 {
+		return merkle.HashFromByteSlices(nil)
+	}
+
+	levels, indexes := merkleSubtrees(total)
+	hashes := make([][]byte, len(levels))
+	for i := range levels {
+		hashes[i] = get(levels[i], indexes[i])
+	}
+	return foldMerkleSubtrees(hashes)
+}
+
+// ComputeMerkleAuntsFromNodes returns the aunts of the inclusion proof of the leaf at the given index of the Merkle
+// tree having the provided total number of leaves, using the complete subtrees stored by SetMerkleLeaf.
+// The result is the same as the aunts returned by merkle.ProofsFromByteSlices
+func ComputeMerkleAuntsFromNodes(total, index uint32, get MerkleNodeGetter) [][]byte {
+	levels, indexes := merkleSubtrees(total)
+	hashes := make([][]byte, len(levels))
+	for i := range levels {
+		hashes[i] = get(levels[i], indexes[i])
+	}
+
+	// Find the subtree containing the leaf
+	subtree := 0
+	for i := range levels {
+		if index>>levels[i] == indexes[i] {
+			subtree = i
+			break
+		}
+	}
+
+	// The aunts inside the subtree are the siblings of the path from the leaf to the subtree root
+	aunts := [][]byte{}
+	for level := uint8(0); level < levels[subtree]; level++ {
+		aunts = append(aunts, get(level, (index>>level)^1))
+	}
+
+	// Then, the tree composed by the subtrees on the right, followed by each subtree on the left
+	if subtree < len(hashes)-1 {
+		aunts = append(aunts, foldMerkleSubtrees(hashes[subtree+1:]))
+	}
+	for i := subtree - 1; i >= 0; i-- {
+		aunts = append(aunts, hashes[i])
+	}
+
+	return aunts
+}
+
+// ComputeWinningIndex returns the index of the winning ticket among the given total number of tickets,
+// using the provided seed
+func ComputeWinningIndex(seed []byte, total uint32) uint32 {
+	return uint32(NewRandFromSeed(seed).Intn(int(total)))
+}
+
+// ComputeWinningIndexes returns the indexes of count distinct winning tickets among the given total number of tickets,
+// using the provided seed. Indexes are extracted without replacement using a partial Fisher-Yates shuffle, so that
+// the first returned index is always the one returned by ComputeWinningIndex
+func ComputeWinningIndexes(seed []byte, total, count uint32) []uint32 {
+	if count > total {
+		count = total
+	}
+
+	r := NewRandFromSeed(seed)
+
+	// Only the swapped positions are tracked, all the others hold their own index
+	swapped := map[uint32]uint32{}
+	valueAt := func(i uint32) uint32 {
+		if value, found := swapped[i]; found {
+			return value
+		}
+		return i
+	}
+
+	indexes := make([]uint32, count)
+	for i := uint32(0); i < count; i++ {
+		j := i + uint32(r.Intn(int(total-i)))
+		indexes[i] = valueAt(j)
+		swapped[j] = valueAt(i)
+	}
+
+	return indexes
+}
+
+// VerifyTicketInclusion checks that the given ticket is included inside the tickets Merkle root at the provided index
+func VerifyTicketInclusion(root []byte, ticket Ticket, index, total uint32, aunts [][]byte) error {
+	if index >= total {
+		return fmt.Errorf("invalid ticket index: %d >= %d", index, total)
+	}
+
+	leaf := TicketMerkleLeaf(ticket)
+	proof := merkle.Proof{
+		Total:    int64(total),
+		Index:    int64(index),
+		LeafHash: TicketMerkleLeafHash(ticket),
+		Aunts:    aunts,
+	}
+
+	return proof.Verify(root, leaf)
+}
+
+// VerifyDrawProof replays the winner selection of a draw using the given seed, checking that it results
+// in the provided winning index, and that the winning ticket is included inside the tickets root at such index
+func VerifyDrawProof(seed, root []byte, winningIndex, total uint32, winningTicket Ticket, proof [][]byte) error {
+	if len(seed) == 0 {
+		return fmt.Errorf("invalid draw seed")
+	}
+
+	if total == 0 {
+		return fmt.Errorf("invalid total tickets: %d", total)
+	}
+
+	index := ComputeWinningIndex(seed, total)
+	if index != winningIndex {
+		return fmt.Errorf("winning index mismatch: expected %d but got %d", index, winningIndex)
+	}
+
+	return VerifyTicketInclusion(root, winningTicket, winningIndex, total, proof)
+}
+
+// VerifyDrawWinners replays the selection of all the winners of a draw using the given seed, checking that it results
+// in the indexes of the provided winners, and that each winning ticket is included inside the tickets root at its index
+func VerifyDrawWinners(seed, root []byte, total uint32, winners []DrawWinner) error {
+	if len(seed) == 0 {
+		return fmt.Errorf("invalid draw seed")
+	}
+
+	if uint32(len(winners)) > total {
+		return fmt.Errorf("invalid winners count: %d > %d", len(winners), total)
+	}
+
+	indexes := ComputeWinningIndexes(seed, total, uint32(len(winners)))
+	for i, winner := range winners {
+		if indexes[i] != winner.Index {
+			return fmt.Errorf("winner %d index mismatch: expected %d but got %d", i, indexes[i], winner.Index)
+		}
+
+		err := VerifyTicketInclusion(root, winner.Ticket, winner.Index, total, winner.Proof)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmicbet/ledger/x/wta/types"
+)
+
+// computeTicketsMerkleProofs returns the Merkle root of the given tickets, taken in the given order,
+// along with the inclusion proof of each one of them
+func computeTicketsMerkleProofs(tickets []types.Ticket) (root []byte, proofs []*merkle.Proof) {
+	leaves := make([][]byte, len(tickets))
+	for i, ticket := range tickets {
+		leaves[i] = types.TicketMerkleLeaf(ticket)
+	}
+	return merkle.ProofsFromByteSlices(leaves)
+}
+
+func TestTicketMerkleLeaf(t *testing.T) {
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	require.Equal(t, []byte("1/owner-1"), types.TicketMerkleLeaf(types.NewTicket("1", 1, timestamp, "owner-1", "", sdk.NewInt64Coin("stake", 10), nil)))
+	require.Equal(t, []byte("1/owner-1/1,5,10"), types.TicketMerkleLeaf(types.NewTicket("1", 1, timestamp, "owner-1", "", sdk.NewInt64Coin("stake", 10), []uint32{1, 5, 10})))
+}
+
+func TestComputeMerkleFromNodes(t *testing.T) {
+	for total := uint32(1); total <= 33; total++ {
+		tickets := make([]types.Ticket, total)
+		for i := range tickets {
+			tickets[i] = types.NewTicket(fmt.Sprintf("%d", i), 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner", "", sdk.NewInt64Coin("stake", 10), nil)
+		}
+		root, proofs := computeTicketsMerkleProofs(tickets)
+
+		nodes := map[string][]byte{}
+		get := func(level uint8, index uint32) []byte { return nodes[fmt.Sprintf("%d/%d", level, index)] }
+		set := func(level uint8, index uint32, hash []byte) { nodes[fmt.Sprintf("%d/%d", level, index)] = hash }
+
+		// Set the first leaf using a different ticket before the real one, so that updating it is tested as well
+		types.SetMerkleLeaf(0, types.TicketMerkleLeafHash(types.NewTicket("wrong", 1, time.Time{}, "owner", "", sdk.NewInt64Coin("stake", 10), nil)), get, set)
+		for i, ticket := range tickets {
+			types.SetMerkleLeaf(uint32(i), types.TicketMerkleLeafHash(ticket), get, set)
+		}
+
+		require.Equal(t, root, types.ComputeMerkleRootFromNodes(total, get), "total %d", total)
+		for i := range tickets {
+			require.Equal(t, proofs[i].Aunts, types.ComputeMerkleAuntsFromNodes(total, uint32(i), get), "total %d index %d", total, i)
+		}
+	}
+}
+
+func TestComputeWinningIndex(t *testing.T) {
+	seed := []byte("seed")
+	require.Equal(t, types.ComputeWinningIndex(seed, 10), types.ComputeWinningIndex(seed, 10))
+	require.Less(t, types.ComputeWinningIndex(seed, 10), uint32(10))
+	require.Equal(t, uint32(types.NewRandFromSeed(seed).Intn(10)), types.ComputeWinningIndex(seed, 10))
+}
+
+func TestVerifyTicketInclusion(t *testing.T) {
+	tickets := []types.Ticket{
+		types.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("4", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-4", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("5", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-5", "", sdk.NewInt64Coin("stake", 10), nil),
+	}
+	root, proofs := computeTicketsMerkleProofs(tickets)
+
+	usecases := []struct {
+		name      string
+		ticket    types.Ticket
+		index     uint32
+		total     uint32
+		aunts     [][]byte
+		shouldErr bool
+	}{
+		{
+			name:      "index out of range",
+			ticket:    tickets[2],
+			index:     5,
+			total:     5,
+			aunts:     proofs[2].Aunts,
+			shouldErr: true,
+		},
+		{
+			name:      "wrong index",
+			ticket:    tickets[2],
+			index:     1,
+			total:     5,
+			aunts:     proofs[2].Aunts,
+			shouldErr: true,
+		},
+		{
+			name:      "wrong owner",
+			ticket:    types.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+			index:     2,
+			total:     5,
+			aunts:     proofs[2].Aunts,
+			shouldErr: true,
+		},
+		{
+			name:      "wrong proof",
+			ticket:    tickets[2],
+			index:     2,
+			total:     5,
+			aunts:     proofs[3].Aunts,
+			shouldErr: true,
+		},
+		{
+			name:      "valid proof",
+			ticket:    tickets[2],
+			index:     2,
+			total:     5,
+			aunts:     proofs[2].Aunts,
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.VerifyTicketInclusion(root, uc.ticket, uc.index, uc.total, uc.aunts)
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyDrawProof(t *testing.T) {
+	tickets := []types.Ticket{
+		types.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+	}
+	seed := []byte("seed")
+	root, proofs := computeTicketsMerkleProofs(tickets)
+	index := types.ComputeWinningIndex(seed, 3)
+	otherIndex := (index + 1) % 3
+
+	usecases := []struct {
+		name      string
+		seed      []byte
+		index     uint32
+		total     uint32
+		ticket    types.Ticket
+		proof     [][]byte
+		shouldErr bool
+	}{
+		{
+			name:      "empty seed",
+			seed:      nil,
+			index:     index,
+			total:     3,
+			ticket:    tickets[index],
+			proof:     proofs[index].Aunts,
+			shouldErr: true,
+		},
+		{
+			name:      "zero total tickets",
+			seed:      seed,
+			index:     index,
+			total:     0,
+			ticket:    tickets[index],
+			proof:     proofs[index].Aunts,
+			shouldErr: true,
+		},
+		{
+			name:      "winning index not matching the seed",
+			seed:      seed,
+			index:     otherIndex,
+			total:     3,
+			ticket:    tickets[otherIndex],
+			proof:     proofs[otherIndex].Aunts,
+			shouldErr: true,
+		},
+		{
+			name:      "winning ticket not matching the index",
+			seed:      seed,
+			index:     index,
+			total:     3,
+			ticket:    tickets[otherIndex],
+			proof:     proofs[index].Aunts,
+			shouldErr: true,
+		},
+		{
+			name:      "valid proof",
+			seed:      seed,
+			index:     index,
+			total:     3,
+			ticket:    tickets[index],
+			proof:     proofs[index].Aunts,
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.VerifyDrawProof(uc.seed, root, uc.index, uc.total, uc.ticket, uc.proof)
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestComputeWinningIndexes(t *testing.T) {
+	seed := []byte("seed")
+
+	indexes := types.ComputeWinningIndexes(seed, 10, 10)
+	require.Len(t, indexes, 10)
+	require.Equal(t, types.ComputeWinningIndex(seed, 10), indexes[0])
+
+	// All the indexes should be distinct and lower than the total
+	found := map[uint32]bool{}
+	for _, index := range indexes {
+		require.Less(t, index, uint32(10))
+		require.False(t, found[index])
+		found[index] = true
+	}
+
+	// A smaller count should return the first indexes only
+	require.Equal(t, indexes[:3], types.ComputeWinningIndexes(seed, 10, 3))
+
+	// The count should be capped to the total
+	require.Len(t, types.ComputeWinningIndexes(seed, 2, 5), 2)
+}
+
+func TestVerifyDrawWinners(t *testing.T) {
+	tickets := []types.Ticket{
+		types.NewTicket("1", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-1", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("2", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-2", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("3", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-3", "", sdk.NewInt64Coin("stake", 10), nil),
+		types.NewTicket("4", 1, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), "owner-4", "", sdk.NewInt64Coin("stake", 10), nil),
+	}
+	seed := []byte("seed")
+	root, proofs := computeTicketsMerkleProofs(tickets)
+	indexes := types.ComputeWinningIndexes(seed, 4, 2)
+
+	newWinner := func(tier uint32, index uint32) types.DrawWinner {
+		return types.NewDrawWinner(tier, tickets[index], index, proofs[index].Aunts, nil, 0)
+	}
+
+	usecases := []struct {
+		name      string
+		seed      []byte
+		total     uint32
+		winners   []types.DrawWinner
+		shouldErr bool
+	}{
+		{
+			name:      "empty seed",
+			seed:      nil,
+			total:     4,
+			winners:   []types.DrawWinner{newWinner(0, indexes[0]), newWinner(1, indexes[1])},
+			shouldErr: true,
+		},
+		{
+			name:      "more winners than tickets",
+			seed:      seed,
+			total:     1,
+			winners:   []types.DrawWinner{newWinner(0, indexes[0]), newWinner(1, indexes[1])},
+			shouldErr: true,
+		},
+		{
+			name:      "winners in the wrong order",
+			seed:      seed,
+			total:     4,
+			winners:   []types.DrawWinner{newWinner(0, indexes[1]), newWinner(1, indexes[0])},
+			shouldErr: true,
+		},
+		{
+			name:  "winning ticket not matching the index",
+			seed:  seed,
+			total: 4,
+			winners: []types.DrawWinner{
+				newWinner(0, indexes[0]),
+				types.NewDrawWinner(1, tickets[indexes[0]], indexes[1], proofs[indexes[1]].Aunts, nil, 0),
+			},
+			shouldErr: true,
+		},
+		{
+			name:      "valid winners",
+			seed:      seed,
+			total:     4,
+			winners:   []types.DrawWinner{newWinner(0, indexes[0]), newWinner(1, indexes[1])},
+			shouldErr: false,
+		},
+	}
+
+	for _, uc := range usecases {
+		uc := uc
+		t.Run(uc.name, func(t *testing.T) {
+			err := types.VerifyDrawWinners(uc.seed, root, uc.total, uc.winners)
+
+			if uc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,10 +4,26 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
-// NewTicketsRequest returns a new QueryTicketsRequest with the provided pagination data
-func NewTicketsRequest(pagination *query.PageRequest) *QueryTicketsRequest {
+// NewTicketsRequest returns a new QueryTicketsRequest for the given pool with the provided pagination data
+func NewTicketsRequest(poolID uint64, pagination *query.PageRequest) *QueryTicketsRequest {
 	return &QueryTicketsRequest{
 		Pagination: pagination,
+		PoolId:     poolID,
+	}
+}
+
+// NewTicketsByOwnerRequest returns a new QueryTicketsByOwnerRequest for the given owner with the provided pagination data
+func NewTicketsByOwnerRequest(owner string, pagination *query.PageRequest) *QueryTicketsByOwnerRequest {
+	return &QueryTicketsByOwnerRequest{
+		Owner:      owner,
+		Pagination: pagination,
+	}
+}
+
+// NewNextDrawRequest returns a new QueryNextDrawRequest for the given pool
+func NewNextDrawRequest(poolID uint64) *QueryNextDrawRequest {
+	return &QueryNextDrawRequest{
+		PoolId: poolID,
 	}
 }
 
@@ -17,3 +33,68 @@ func NewPastDrawsRequest(pagination *query.PageRequest) *QueryPastDrawsRequest {
 		Pagination: pagination,
 	}
 }
+
+// NewDrawRequest returns a new QueryDrawRequest for the draw having the given id
+func NewDrawRequest(drawID uint64) *QueryDrawRequest {
+	return &QueryDrawRequest{
+		DrawId: drawID,
+	}
+}
+
+// NewDrawProofRequest returns a new QueryDrawProofRequest for the draw having the given id
+func NewDrawProofRequest(drawID uint64) *QueryDrawProofRequest {
+	return &QueryDrawProofRequest{
+		DrawId: drawID,
+	}
+}
+
+// NewDrawWinnersRequest returns a new QueryDrawWinnersRequest for the draw having the given id.
+// If matches is not 0, only the winners having matched such amount of numbers are returned
+func NewDrawWinnersRequest(drawID uint64, matches uint32) *QueryDrawWinnersRequest {
+	return &QueryDrawWinnersRequest{
+		DrawId:  drawID,
+		Matches: matches,
+	}
+}
+
+// Verify replays the winner selection using the proof data, returning an error if the result does not match
+func (res *QueryDrawProofResponse) Verify() error {
+	if len(res.WinningNumbers) != 0 {
+		return VerifyLottoWinners(res.Seed, res.TicketsRoot, res.TotalTickets, res.MaxNumber, res.WinningNumbers, res.Winners)
+	}
+
+	err := VerifyDrawProof(res.Seed, res.TicketsRoot, res.WinningIndex, res.TotalTickets, res.WinningTicket, res.Proof)
+	if err != nil {
+		return err
+	}
+
+	return VerifyDrawWinners(res.Seed, res.TicketsRoot, res.TotalTickets, res.Winners)
+}
+
+// NewExclusionRequest returns a new QueryExclusionRequest for the given address
+func NewExclusionRequest(address string) *QueryExclusionRequest {
+	return &QueryExclusionRequest{
+		Address: address,
+	}
+}
+
+// NewSpendingLimitRequest returns a new QuerySpendingLimitRequest for the given address
+func NewSpendingLimitRequest(address string) *QuerySpendingLimitRequest {
+	return &QuerySpendingLimitRequest{
+		Address: address,
+	}
+}
+
+// NewDrawFeesRequest returns a new QueryDrawFeesRequest for the given draw id
+func NewDrawFeesRequest(drawID uint64) *QueryDrawFeesRequest {
+	return &QueryDrawFeesRequest{
+		DrawId: drawID,
+	}
+}
+
+// NewPriceRequest returns a new QueryPriceRequest for the given denom
+func NewPriceRequest(denom string) *QueryPriceRequest {
+	return &QueryPriceRequest{
+		Denom: denom,
+	}
+}
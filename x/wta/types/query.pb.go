@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -34,6 +36,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryTicketsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id represents the id of the pool to be queried
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
 func (m *QueryTicketsRequest) Reset()         { *m = QueryTicketsRequest{} }
@@ -76,6 +80,13 @@ func (m *QueryTicketsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryTicketsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 // QueryTicketsResponse is the response type for the Query/Tickets RPC method
 type QueryTicketsResponse struct {
 	Tickets    []Ticket            `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets"`
@@ -129,15 +140,127 @@ func (m *QueryTicketsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTicketsByOwnerRequest is the request type for the Query/TicketsByOwner
+// RPC method.
+type QueryTicketsByOwnerRequest struct {
+	// owner represents the address of the owner of the tickets to be queried
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTicketsByOwnerRequest) Reset()         { *m = QueryTicketsByOwnerRequest{} }
+func (m *QueryTicketsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTicketsByOwnerRequest) ProtoMessage()    {}
+func (*QueryTicketsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{2}
+}
+func (m *QueryTicketsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTicketsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTicketsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTicketsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTicketsByOwnerRequest.Merge(m, src)
+}
+func (m *QueryTicketsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTicketsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTicketsByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTicketsByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryTicketsByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryTicketsByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTicketsByOwnerResponse is the response type for the Query/TicketsByOwner
+// RPC method
+type QueryTicketsByOwnerResponse struct {
+	Tickets    []Ticket            `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTicketsByOwnerResponse) Reset()         { *m = QueryTicketsByOwnerResponse{} }
+func (m *QueryTicketsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTicketsByOwnerResponse) ProtoMessage()    {}
+func (*QueryTicketsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{3}
+}
+func (m *QueryTicketsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTicketsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTicketsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTicketsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTicketsByOwnerResponse.Merge(m, src)
+}
+func (m *QueryTicketsByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTicketsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTicketsByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTicketsByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryTicketsByOwnerResponse) GetTickets() []Ticket {
+	if m != nil {
+		return m.Tickets
+	}
+	return nil
+}
+
+func (m *QueryTicketsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDrawRequest is the request type for the Query/Draw RPC method.
 type QueryNextDrawRequest struct {
+	// pool_id represents the id of the pool to be queried
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
 func (m *QueryNextDrawRequest) Reset()         { *m = QueryNextDrawRequest{} }
 func (m *QueryNextDrawRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextDrawRequest) ProtoMessage()    {}
 func (*QueryNextDrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ba61453303e825, []int{2}
+	return fileDescriptor_48ba61453303e825, []int{4}
 }
 func (m *QueryNextDrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,16 +289,25 @@ func (m *QueryNextDrawRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryNextDrawRequest proto.InternalMessageInfo
 
+func (m *QueryNextDrawRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 // QueryDrawResponse is the response type for the Query/Draw RPC method
 type QueryNextDrawResponse struct {
 	Draw Draw `protobuf:"bytes,1,opt,name=draw,proto3" json:"draw"`
+	// sales_paused tells whether the ticket sales of the pool are paused
+	SalesPaused bool `protobuf:"varint,2,opt,name=sales_paused,json=salesPaused,proto3" json:"sales_paused,omitempty"`
 }
 
 func (m *QueryNextDrawResponse) Reset()         { *m = QueryNextDrawResponse{} }
 func (m *QueryNextDrawResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextDrawResponse) ProtoMessage()    {}
 func (*QueryNextDrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ba61453303e825, []int{3}
+	return fileDescriptor_48ba61453303e825, []int{5}
 }
 func (m *QueryNextDrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,6 +343,13 @@ func (m *QueryNextDrawResponse) GetDraw() Draw {
 	return Draw{}
 }
 
+func (m *QueryNextDrawResponse) GetSalesPaused() bool {
+	if m != nil {
+		return m.SalesPaused
+	}
+	return false
+}
+
 // QueryPastDrawsRequest is the request type for the Query/PastDraws RPC method.
 type QueryPastDrawsRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -221,7 +360,7 @@ func (m *QueryPastDrawsRequest) Reset()         { *m = QueryPastDrawsRequest{} }
 func (m *QueryPastDrawsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPastDrawsRequest) ProtoMessage()    {}
 func (*QueryPastDrawsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ba61453303e825, []int{4}
+	return fileDescriptor_48ba61453303e825, []int{6}
 }
 func (m *QueryPastDrawsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +407,7 @@ func (m *QueryPastDrawsResponse) Reset()         { *m = QueryPastDrawsResponse{}
 func (m *QueryPastDrawsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPastDrawsResponse) ProtoMessage()    {}
 func (*QueryPastDrawsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ba61453303e825, []int{5}
+	return fileDescriptor_48ba61453303e825, []int{7}
 }
 func (m *QueryPastDrawsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,22 +450,24 @@ func (m *QueryPastDrawsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryDrawRequest is the request type for the Query/Draw RPC method.
+type QueryDrawRequest struct {
+	// draw_id represents the id of the draw to be queried
+	DrawId uint64 `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ba61453303e825, []int{6}
+func (m *QueryDrawRequest) Reset()         { *m = QueryDrawRequest{} }
+func (m *QueryDrawRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDrawRequest) ProtoMessage()    {}
+func (*QueryDrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{8}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDrawRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -336,40 +477,44 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryDrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDrawRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryDrawRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryDrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDrawRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDrawRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method
-type QueryParamsResponse struct {
-	// Represents the parameters related to the prize distribution
-	DistributionParams DistributionParams `protobuf:"bytes,1,opt,name=distribution_params,json=distributionParams,proto3" json:"distribution_params"`
-	// Represents the parameters related to each draw
-	DrawParams DrawParams `protobuf:"bytes,2,opt,name=draw_params,json=drawParams,proto3" json:"draw_params"`
-	// Represents the parameters related to each ticket
-	TicketParams TicketParams `protobuf:"bytes,3,opt,name=ticket_params,json=ticketParams,proto3" json:"ticket_params"`
+func (m *QueryDrawRequest) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48ba61453303e825, []int{7}
+// QueryDrawResponse is the response type for the Query/Draw RPC method
+type QueryDrawResponse struct {
+	Draw Draw `protobuf:"bytes,1,opt,name=draw,proto3" json:"draw"`
+	// Ticket that has won the draw, if it has already been drawn
+	WinningTicket *Ticket `protobuf:"bytes,2,opt,name=winning_ticket,json=winningTicket,proto3" json:"winning_ticket,omitempty"`
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryDrawResponse) Reset()         { *m = QueryDrawResponse{} }
+func (m *QueryDrawResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDrawResponse) ProtoMessage()    {}
+func (*QueryDrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{9}
+}
+func (m *QueryDrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDrawResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -379,590 +524,2945 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryDrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDrawResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryDrawResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryDrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDrawResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDrawResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetDistributionParams() DistributionParams {
+func (m *QueryDrawResponse) GetDraw() Draw {
 	if m != nil {
-		return m.DistributionParams
+		return m.Draw
 	}
-	return DistributionParams{}
+	return Draw{}
 }
 
-func (m *QueryParamsResponse) GetDrawParams() DrawParams {
+func (m *QueryDrawResponse) GetWinningTicket() *Ticket {
 	if m != nil {
-		return m.DrawParams
+		return m.WinningTicket
 	}
-	return DrawParams{}
+	return nil
 }
 
-func (m *QueryParamsResponse) GetTicketParams() TicketParams {
-	if m != nil {
-		return m.TicketParams
-	}
-	return TicketParams{}
+// QueryDrawProofRequest is the request type for the Query/DrawProof RPC method.
+type QueryDrawProofRequest struct {
+	// draw_id represents the id of the past draw to be queried
+	DrawId uint64 `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*QueryTicketsRequest)(nil), "cosmicbet.wta.v1beta1.QueryTicketsRequest")
-	proto.RegisterType((*QueryTicketsResponse)(nil), "cosmicbet.wta.v1beta1.QueryTicketsResponse")
-	proto.RegisterType((*QueryNextDrawRequest)(nil), "cosmicbet.wta.v1beta1.QueryNextDrawRequest")
-	proto.RegisterType((*QueryNextDrawResponse)(nil), "cosmicbet.wta.v1beta1.QueryNextDrawResponse")
-	proto.RegisterType((*QueryPastDrawsRequest)(nil), "cosmicbet.wta.v1beta1.QueryPastDrawsRequest")
-	proto.RegisterType((*QueryPastDrawsResponse)(nil), "cosmicbet.wta.v1beta1.QueryPastDrawsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmicbet.wta.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmicbet.wta.v1beta1.QueryParamsResponse")
+func (m *QueryDrawProofRequest) Reset()         { *m = QueryDrawProofRequest{} }
+func (m *QueryDrawProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDrawProofRequest) ProtoMessage()    {}
+func (*QueryDrawProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{10}
+}
+func (m *QueryDrawProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDrawProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDrawProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDrawProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDrawProofRequest.Merge(m, src)
+}
+func (m *QueryDrawProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDrawProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDrawProofRequest.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("cosmicbet/wta/v1beta1/query.proto", fileDescriptor_48ba61453303e825) }
+var xxx_messageInfo_QueryDrawProofRequest proto.InternalMessageInfo
 
-var fileDescriptor_48ba61453303e825 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0xfd, 0x09, 0x57, 0x58, 0xae, 0x3f, 0x54, 0x19, 0xea, 0xa6, 0xae, 0x68, 0x9b,
-	0x40, 0x6d, 0x35, 0x88, 0x91, 0x81, 0x2a, 0x40, 0xa7, 0xa8, 0x44, 0x4c, 0x48, 0xa8, 0x9c, 0xe3,
-	0x93, 0xb1, 0x48, 0x7c, 0xae, 0xef, 0x42, 0xd2, 0x95, 0x81, 0x81, 0x05, 0x50, 0x77, 0x66, 0xfe,
-	0x11, 0xa4, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0xf8, 0x43, 0x90, 0xef, 0x9e, 0x43, 0x12, 0x62,
-	0x93, 0xa1, 0x5b, 0x75, 0xfe, 0xbe, 0xef, 0xfb, 0xdc, 0xb7, 0xef, 0x5d, 0xf0, 0x56, 0x83, 0x8b,
-	0x56, 0xd0, 0x70, 0x99, 0x74, 0x3a, 0x92, 0x3a, 0x6f, 0x0f, 0x5c, 0x26, 0xe9, 0x81, 0x73, 0xda,
-	0x66, 0xf1, 0x99, 0x1d, 0xc5, 0x5c, 0x72, 0xb2, 0x3a, 0x90, 0xd8, 0x1d, 0x49, 0x6d, 0x90, 0x18,
-	0x2b, 0x3e, 0xf7, 0xb9, 0x52, 0x38, 0xc9, 0x5f, 0x5a, 0x6c, 0xdc, 0xf6, 0x39, 0xf7, 0x9b, 0xcc,
-	0xa1, 0x51, 0xe0, 0xd0, 0x30, 0xe4, 0x92, 0xca, 0x80, 0x87, 0x02, 0xbe, 0x96, 0x13, 0x2b, 0x2e,
-	0x1c, 0x97, 0x0a, 0xa6, 0x7b, 0x0c, 0x3a, 0x46, 0xd4, 0x0f, 0x42, 0x25, 0x06, 0xad, 0x35, 0x99,
-	0xac, 0xc5, 0x3d, 0xd6, 0x14, 0xf9, 0x9a, 0x88, 0xc6, 0xb4, 0x05, 0x1a, 0xeb, 0x25, 0x5e, 0x7e,
-	0x96, 0x74, 0x7a, 0x1e, 0x34, 0xde, 0x30, 0x29, 0xea, 0xec, 0xb4, 0xcd, 0x84, 0x24, 0x4f, 0x30,
-	0xfe, 0xdb, 0x72, 0x1d, 0x15, 0xd1, 0xde, 0x52, 0x65, 0xc7, 0xd6, 0x7c, 0x76, 0xc2, 0x67, 0xeb,
-	0x0c, 0xc0, 0xd3, 0x3e, 0xa6, 0x3e, 0x83, 0xda, 0xfa, 0x50, 0xa5, 0xf5, 0x05, 0xe1, 0x95, 0x51,
-	0x7f, 0x11, 0xf1, 0x50, 0x30, 0xf2, 0x10, 0x2f, 0x4a, 0x7d, 0xb4, 0x8e, 0x8a, 0xb3, 0x7b, 0x4b,
-	0x95, 0x0d, 0x7b, 0x62, 0x90, 0xb6, 0x2e, 0x3c, 0x9c, 0xbb, 0xf8, 0xb9, 0x59, 0xa8, 0xa7, 0x35,
-	0xe4, 0xe9, 0x08, 0xdf, 0x8c, 0xe2, 0xdb, 0xfd, 0x2f, 0x9f, 0xee, 0x3d, 0x02, 0xb8, 0x06, 0x7c,
-	0x35, 0xd6, 0x95, 0xd5, 0x98, 0x76, 0xe0, 0x12, 0x56, 0x0d, 0xaf, 0x8e, 0x9d, 0x03, 0xf8, 0x03,
-	0x3c, 0xe7, 0xc5, 0xb4, 0x03, 0x99, 0xdc, 0xca, 0xa0, 0x4e, 0x4a, 0x80, 0x59, 0xc9, 0xad, 0x13,
-	0xf0, 0x3b, 0xa6, 0x42, 0xf9, 0x5d, 0x79, 0xd2, 0x5f, 0x11, 0x5e, 0x1b, 0xef, 0x00, 0xc8, 0x8f,
-	0xf1, 0x7c, 0xc2, 0x90, 0x26, 0x5d, 0xca, 0x60, 0x3e, 0x0a, 0x84, 0xe4, 0x71, 0xd0, 0xa0, 0xcd,
-	0xa4, 0xbc, 0x4a, 0x25, 0x85, 0x1b, 0xe8, 0xea, 0xab, 0xcb, 0x7c, 0x05, 0x13, 0x20, 0x4d, 0x06,
-	0x31, 0x4d, 0xfc, 0xf3, 0x0c, 0x8c, 0x62, 0x7a, 0x0c, 0xf4, 0xaf, 0xf0, 0xb2, 0x17, 0x08, 0x19,
-	0x07, 0x6e, 0x3b, 0xa9, 0x3e, 0xd1, 0xe3, 0x0b, 0x49, 0x65, 0xdd, 0xa5, 0x3a, 0x54, 0xa1, 0xfd,
-	0xe0, 0x2e, 0xc4, 0xfb, 0xe7, 0x0b, 0x39, 0xc2, 0x4b, 0xc9, 0x0d, 0x53, 0x67, 0x7d, 0xb3, 0xad,
-	0x9c, 0xff, 0xec, 0x88, 0x23, 0xf6, 0x06, 0x27, 0xa4, 0x86, 0x6f, 0xea, 0x09, 0x4d, 0xbd, 0x66,
-	0x95, 0xd7, 0x76, 0xee, 0x6c, 0x8f, 0xb8, 0xdd, 0x90, 0x43, 0x67, 0x95, 0x6f, 0x73, 0x78, 0x5e,
-	0x65, 0x42, 0x3e, 0x20, 0xbc, 0x08, 0x3b, 0x44, 0xca, 0x19, 0x76, 0x13, 0x16, 0xd9, 0xb8, 0x3b,
-	0x95, 0x56, 0x47, 0x6d, 0xed, 0xbc, 0xfb, 0xfe, 0xfb, 0x7c, 0xa6, 0x48, 0x4c, 0x67, 0xf2, 0xcb,
-	0x91, 0x6e, 0xdf, 0x47, 0x84, 0xaf, 0xa5, 0x8b, 0x41, 0x72, 0x3b, 0x8c, 0xad, 0x95, 0x71, 0x6f,
-	0x3a, 0x31, 0xf0, 0xec, 0x29, 0x1e, 0x8b, 0x14, 0x33, 0x78, 0x42, 0xd6, 0x95, 0xfb, 0x49, 0xfc,
-	0xe4, 0x1c, 0xe1, 0xeb, 0x83, 0xc1, 0x27, 0xb9, 0x5d, 0xc6, 0x37, 0xd0, 0xd8, 0x9f, 0x52, 0x0d,
-	0x50, 0x25, 0x05, 0xb5, 0x4d, 0xb6, 0x9c, 0xac, 0xe7, 0x55, 0x68, 0x28, 0x41, 0xde, 0x23, 0xbc,
-	0x00, 0x93, 0x51, 0xca, 0x6f, 0x32, 0xb4, 0x08, 0x46, 0x79, 0x1a, 0x29, 0xc0, 0xdc, 0x51, 0x30,
-	0x9b, 0x64, 0xc3, 0xc9, 0x7b, 0xeb, 0x0f, 0x1f, 0x5d, 0xf4, 0x4c, 0x74, 0xd9, 0x33, 0xd1, 0xaf,
-	0x9e, 0x89, 0x3e, 0xf5, 0xcd, 0xc2, 0x65, 0xdf, 0x2c, 0xfc, 0xe8, 0x9b, 0x85, 0x17, 0xbb, 0x7e,
-	0x20, 0x5f, 0xb7, 0x5d, 0xbb, 0xc1, 0x5b, 0x43, 0x16, 0x4d, 0xe6, 0xf9, 0x2c, 0x76, 0xba, 0xca,
-	0x4b, 0x9e, 0x45, 0x4c, 0xb8, 0x0b, 0xea, 0xf7, 0xe2, 0xfe, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xfb, 0x63, 0x9d, 0x75, 0x13, 0x07, 0x00, 0x00,
+func (m *QueryDrawProofRequest) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// QueryDrawProofResponse is the response type for the Query/DrawProof RPC
+// method
+type QueryDrawProofResponse struct {
+	// Seed used to extract the winning index
+	Seed []byte `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Merkle root of the draw tickets, in the order they were added to the draw
+	TicketsRoot []byte `protobuf:"bytes,2,opt,name=tickets_root,json=ticketsRoot,proto3" json:"tickets_root,omitempty"`
+	// Index of the winning ticket inside the ordered draw tickets list
+	WinningIndex uint32 `protobuf:"varint,3,opt,name=winning_index,json=winningIndex,proto3" json:"winning_index,omitempty"`
+	// Total number of tickets that took part to the draw
+	TotalTickets uint32 `protobuf:"varint,4,opt,name=total_tickets,json=totalTickets,proto3" json:"total_tickets,omitempty"`
+	// Ticket that has won the draw
+	WinningTicket Ticket `protobuf:"bytes,5,opt,name=winning_ticket,json=winningTicket,proto3" json:"winning_ticket"`
+	// Merkle proof of the winning ticket inclusion inside the tickets root
+	Proof [][]byte `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+	// All the winners of the draw, along with their inclusion proofs
+	Winners []DrawWinner `protobuf:"bytes,7,rep,name=winners,proto3" json:"winners"`
+	// Numbers drawn using the seed, only set for the draws of lotto pools
+	WinningNumbers []uint32 `protobuf:"varint,8,rep,packed,name=winning_numbers,json=winningNumbers,proto3" json:"winning_numbers,omitempty"`
+	// Highest number that could be drawn, only set for the draws of lotto pools
+	MaxNumber uint32 `protobuf:"varint,9,opt,name=max_number,json=maxNumber,proto3" json:"max_number,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryDrawProofResponse) Reset()         { *m = QueryDrawProofResponse{} }
+func (m *QueryDrawProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDrawProofResponse) ProtoMessage()    {}
+func (*QueryDrawProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{11}
+}
+func (m *QueryDrawProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDrawProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDrawProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDrawProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDrawProofResponse.Merge(m, src)
+}
+func (m *QueryDrawProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDrawProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDrawProofResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Tickets queries all the stored tickets for the next draw
-	Tickets(ctx context.Context, in *QueryTicketsRequest, opts ...grpc.CallOption) (*QueryTicketsResponse, error)
-	// NextDraw queries the next planned drawn
-	NextDraw(ctx context.Context, in *QueryNextDrawRequest, opts ...grpc.CallOption) (*QueryNextDrawResponse, error)
-	// PastDraws queries the past draws that have already been drawn
-	PastDraws(ctx context.Context, in *QueryPastDrawsRequest, opts ...grpc.CallOption) (*QueryPastDrawsResponse, error)
-	// Params queries the wta parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryDrawProofResponse proto.InternalMessageInfo
+
+func (m *QueryDrawProofResponse) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDrawProofResponse) GetTicketsRoot() []byte {
+	if m != nil {
+		return m.TicketsRoot
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDrawProofResponse) GetWinningIndex() uint32 {
+	if m != nil {
+		return m.WinningIndex
+	}
+	return 0
 }
 
-func (c *queryClient) Tickets(ctx context.Context, in *QueryTicketsRequest, opts ...grpc.CallOption) (*QueryTicketsResponse, error) {
-	out := new(QueryTicketsResponse)
-	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/Tickets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDrawProofResponse) GetTotalTickets() uint32 {
+	if m != nil {
+		return m.TotalTickets
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) NextDraw(ctx context.Context, in *QueryNextDrawRequest, opts ...grpc.CallOption) (*QueryNextDrawResponse, error) {
-	out := new(QueryNextDrawResponse)
-	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/NextDraw", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDrawProofResponse) GetWinningTicket() Ticket {
+	if m != nil {
+		return m.WinningTicket
 	}
-	return out, nil
+	return Ticket{}
 }
 
-func (c *queryClient) PastDraws(ctx context.Context, in *QueryPastDrawsRequest, opts ...grpc.CallOption) (*QueryPastDrawsResponse, error) {
-	out := new(QueryPastDrawsResponse)
-	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/PastDraws", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDrawProofResponse) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDrawProofResponse) GetWinners() []DrawWinner {
+	if m != nil {
+		return m.Winners
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Tickets queries all the stored tickets for the next draw
-	Tickets(context.Context, *QueryTicketsRequest) (*QueryTicketsResponse, error)
-	// NextDraw queries the next planned drawn
-	NextDraw(context.Context, *QueryNextDrawRequest) (*QueryNextDrawResponse, error)
-	// PastDraws queries the past draws that have already been drawn
-	PastDraws(context.Context, *QueryPastDrawsRequest) (*QueryPastDrawsResponse, error)
-	// Params queries the wta parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryDrawProofResponse) GetWinningNumbers() []uint32 {
+	if m != nil {
+		return m.WinningNumbers
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryDrawProofResponse) GetMaxNumber() uint32 {
+	if m != nil {
+		return m.MaxNumber
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Tickets(ctx context.Context, req *QueryTicketsRequest) (*QueryTicketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tickets not implemented")
+// QueryDrawWinnersRequest is the request type for the Query/DrawWinners RPC
+// method.
+type QueryDrawWinnersRequest struct {
+	// draw_id represents the id of the past draw to be queried
+	DrawId uint64 `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	// matches represents the amount of matched numbers of the winners to be
+	// returned. If 0, all the winners are returned
+	Matches uint32 `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
 }
-func (*UnimplementedQueryServer) NextDraw(ctx context.Context, req *QueryNextDrawRequest) (*QueryNextDrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NextDraw not implemented")
+
+func (m *QueryDrawWinnersRequest) Reset()         { *m = QueryDrawWinnersRequest{} }
+func (m *QueryDrawWinnersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDrawWinnersRequest) ProtoMessage()    {}
+func (*QueryDrawWinnersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{12}
 }
-func (*UnimplementedQueryServer) PastDraws(ctx context.Context, req *QueryPastDrawsRequest) (*QueryPastDrawsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PastDraws not implemented")
+func (m *QueryDrawWinnersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDrawWinnersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDrawWinnersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDrawWinnersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDrawWinnersRequest.Merge(m, src)
+}
+func (m *QueryDrawWinnersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDrawWinnersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDrawWinnersRequest.DiscardUnknown(m)
 }
 
-func _Query_Tickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTicketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tickets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmicbet.wta.v1beta1.Query/Tickets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tickets(ctx, req.(*QueryTicketsRequest))
+var xxx_messageInfo_QueryDrawWinnersRequest proto.InternalMessageInfo
+
+func (m *QueryDrawWinnersRequest) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_NextDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextDrawRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NextDraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmicbet.wta.v1beta1.Query/NextDraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NextDraw(ctx, req.(*QueryNextDrawRequest))
+func (m *QueryDrawWinnersRequest) GetMatches() uint32 {
+	if m != nil {
+		return m.Matches
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PastDraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPastDrawsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PastDraws(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmicbet.wta.v1beta1.Query/PastDraws",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PastDraws(ctx, req.(*QueryPastDrawsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDrawWinnersResponse is the response type for the Query/DrawWinners RPC
+// method
+type QueryDrawWinnersResponse struct {
+	Winners []DrawWinner `protobuf:"bytes,1,rep,name=winners,proto3" json:"winners"`
+	// Numbers drawn using the seed, only set for the draws of lotto pools
+	WinningNumbers []uint32 `protobuf:"varint,2,rep,packed,name=winning_numbers,json=winningNumbers,proto3" json:"winning_numbers,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmicbet.wta.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryDrawWinnersResponse) Reset()         { *m = QueryDrawWinnersResponse{} }
+func (m *QueryDrawWinnersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDrawWinnersResponse) ProtoMessage()    {}
+func (*QueryDrawWinnersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{13}
+}
+func (m *QueryDrawWinnersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDrawWinnersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDrawWinnersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDrawWinnersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDrawWinnersResponse.Merge(m, src)
+}
+func (m *QueryDrawWinnersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDrawWinnersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDrawWinnersResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmicbet.wta.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Tickets",
-			Handler:    _Query_Tickets_Handler,
-		},
-		{
-			MethodName: "NextDraw",
-			Handler:    _Query_NextDraw_Handler,
-		},
-		{
-			MethodName: "PastDraws",
-			Handler:    _Query_PastDraws_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmicbet/wta/v1beta1/query.proto",
+var xxx_messageInfo_QueryDrawWinnersResponse proto.InternalMessageInfo
+
+func (m *QueryDrawWinnersResponse) GetWinners() []DrawWinner {
+	if m != nil {
+		return m.Winners
+	}
+	return nil
 }
 
-func (m *QueryTicketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDrawWinnersResponse) GetWinningNumbers() []uint32 {
+	if m != nil {
+		return m.WinningNumbers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTicketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDrawFeesRequest is the request type for the Query/DrawFees RPC method.
+type QueryDrawFeesRequest struct {
+	// draw_id represents the id of the draw to be queried
+	DrawId uint64 `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
 }
 
-func (m *QueryTicketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDrawFeesRequest) Reset()         { *m = QueryDrawFeesRequest{} }
+func (m *QueryDrawFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDrawFeesRequest) ProtoMessage()    {}
+func (*QueryDrawFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{14}
+}
+func (m *QueryDrawFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDrawFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDrawFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDrawFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDrawFeesRequest.Merge(m, src)
+}
+func (m *QueryDrawFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDrawFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDrawFeesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTicketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDrawFeesRequest proto.InternalMessageInfo
+
+func (m *QueryDrawFeesRequest) GetDrawId() uint64 {
+	if m != nil {
+		return m.DrawId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTicketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDrawFeesResponse is the response type for the Query/DrawFees RPC method
+type QueryDrawFeesResponse struct {
+	Fees []DrawFees `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees"`
 }
 
-func (m *QueryTicketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tickets) > 0 {
-		for iNdEx := len(m.Tickets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tickets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDrawFeesResponse) Reset()         { *m = QueryDrawFeesResponse{} }
+func (m *QueryDrawFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDrawFeesResponse) ProtoMessage()    {}
+func (*QueryDrawFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{15}
+}
+func (m *QueryDrawFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDrawFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDrawFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDrawFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDrawFeesResponse.Merge(m, src)
+}
+func (m *QueryDrawFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDrawFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDrawFeesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNextDrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDrawFeesResponse proto.InternalMessageInfo
+
+func (m *QueryDrawFeesResponse) GetFees() []DrawFees {
+	if m != nil {
+		return m.Fees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNextDrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPoolsRequest is the request type for the Query/Pools RPC method.
+type QueryPoolsRequest struct {
 }
 
-func (m *QueryNextDrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPoolsRequest) Reset()         { *m = QueryPoolsRequest{} }
+func (m *QueryPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsRequest) ProtoMessage()    {}
+func (*QueryPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{16}
 }
-
-func (m *QueryNextDrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsRequest.Merge(m, src)
+}
+func (m *QueryPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNextDrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPoolsRequest proto.InternalMessageInfo
+
+// QueryPoolsResponse is the response type for the Query/Pools RPC method
+type QueryPoolsResponse struct {
+	Pools []Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
 }
 
-func (m *QueryNextDrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Draw.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPoolsResponse) Reset()         { *m = QueryPoolsResponse{} }
+func (m *QueryPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsResponse) ProtoMessage()    {}
+func (*QueryPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{17}
+}
+func (m *QueryPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsResponse.Merge(m, src)
+}
+func (m *QueryPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPastDrawsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsResponse) GetPools() []Pool {
+	if m != nil {
+		return m.Pools
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPastDrawsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPruningQueueRequest is the request type for the Query/PruningQueue RPC
+// method.
+type QueryPruningQueueRequest struct {
 }
 
-func (m *QueryPastDrawsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPruningQueueRequest) Reset()         { *m = QueryPruningQueueRequest{} }
+func (m *QueryPruningQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPruningQueueRequest) ProtoMessage()    {}
+func (*QueryPruningQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{18}
+}
+func (m *QueryPruningQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPruningQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPruningQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPastDrawsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPruningQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPruningQueueRequest.Merge(m, src)
+}
+func (m *QueryPruningQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPruningQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPruningQueueRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPastDrawsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPruningQueueRequest proto.InternalMessageInfo
+
+// QueryPruningQueueResponse is the response type for the Query/PruningQueue
+// RPC method
+type QueryPruningQueueResponse struct {
+	Draws []PruningDraw `protobuf:"bytes,1,rep,name=draws,proto3" json:"draws"`
 }
 
-func (m *QueryPastDrawsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Draws) > 0 {
-		for iNdEx := len(m.Draws) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Draws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPruningQueueResponse) Reset()         { *m = QueryPruningQueueResponse{} }
+func (m *QueryPruningQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPruningQueueResponse) ProtoMessage()    {}
+func (*QueryPruningQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{19}
+}
+func (m *QueryPruningQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPruningQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPruningQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPruningQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPruningQueueResponse.Merge(m, src)
+}
+func (m *QueryPruningQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPruningQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPruningQueueResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPruningQueueResponse proto.InternalMessageInfo
+
+func (m *QueryPruningQueueResponse) GetDraws() []PruningDraw {
+	if m != nil {
+		return m.Draws
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExclusionRequest is the request type for the Query/Exclusion RPC method.
+type QueryExclusionRequest struct {
+	// address represents the address whose exclusion should be queried
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryExclusionRequest) Reset()         { *m = QueryExclusionRequest{} }
+func (m *QueryExclusionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExclusionRequest) ProtoMessage()    {}
+func (*QueryExclusionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{20}
+}
+func (m *QueryExclusionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExclusionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExclusionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExclusionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExclusionRequest.Merge(m, src)
+}
+func (m *QueryExclusionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExclusionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExclusionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExclusionRequest proto.InternalMessageInfo
+
+func (m *QueryExclusionRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExclusionResponse is the response type for the Query/Exclusion RPC
+// method
+type QueryExclusionResponse struct {
+	Exclusion Exclusion `protobuf:"bytes,1,opt,name=exclusion,proto3" json:"exclusion"`
+	// excluded tells whether the exclusion is still active
+	Excluded bool `protobuf:"varint,2,opt,name=excluded,proto3" json:"excluded,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TicketParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.DrawParams.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryExclusionResponse) Reset()         { *m = QueryExclusionResponse{} }
+func (m *QueryExclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExclusionResponse) ProtoMessage()    {}
+func (*QueryExclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{21}
+}
+func (m *QueryExclusionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExclusionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.DistributionParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryExclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExclusionResponse.Merge(m, src)
+}
+func (m *QueryExclusionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExclusionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExclusionResponse proto.InternalMessageInfo
+
+func (m *QueryExclusionResponse) GetExclusion() Exclusion {
+	if m != nil {
+		return m.Exclusion
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return Exclusion{}
+}
+
+func (m *QueryExclusionResponse) GetExcluded() bool {
+	if m != nil {
+		return m.Excluded
+	}
+	return false
+}
+
+// QuerySpendingLimitRequest is the request type for the Query/SpendingLimit
+// RPC method.
+type QuerySpendingLimitRequest struct {
+	// address represents the address whose spending limit should be queried
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySpendingLimitRequest) Reset()         { *m = QuerySpendingLimitRequest{} }
+func (m *QuerySpendingLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendingLimitRequest) ProtoMessage()    {}
+func (*QuerySpendingLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{22}
+}
+func (m *QuerySpendingLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendingLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendingLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendingLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendingLimitRequest.Merge(m, src)
+}
+func (m *QuerySpendingLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendingLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendingLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendingLimitRequest proto.InternalMessageInfo
+
+func (m *QuerySpendingLimitRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QuerySpendingLimitResponse is the response type for the Query/SpendingLimit
+// RPC method
+type QuerySpendingLimitResponse struct {
+	SpendingLimit SpendingLimit `protobuf:"bytes,1,opt,name=spending_limit,json=spendingLimit,proto3" json:"spending_limit"`
+	// spent represents the amount spent within the current window
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *QuerySpendingLimitResponse) Reset()         { *m = QuerySpendingLimitResponse{} }
+func (m *QuerySpendingLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendingLimitResponse) ProtoMessage()    {}
+func (*QuerySpendingLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{23}
+}
+func (m *QuerySpendingLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendingLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendingLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendingLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendingLimitResponse.Merge(m, src)
+}
+func (m *QuerySpendingLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendingLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendingLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendingLimitResponse proto.InternalMessageInfo
+
+func (m *QuerySpendingLimitResponse) GetSpendingLimit() SpendingLimit {
+	if m != nil {
+		return m.SpendingLimit
+	}
+	return SpendingLimit{}
+}
+
+func (m *QuerySpendingLimitResponse) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// QueryPriceRequest is the request type for the Query/Price RPC method.
+type QueryPriceRequest struct {
+	// denom represents the denomination whose price should be queried
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryPriceRequest) Reset()         { *m = QueryPriceRequest{} }
+func (m *QueryPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceRequest) ProtoMessage()    {}
+func (*QueryPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{24}
+}
+func (m *QueryPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceRequest.Merge(m, src)
+}
+func (m *QueryPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceRequest proto.InternalMessageInfo
+
+func (m *QueryPriceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryPriceResponse is the response type for the Query/Price RPC method
+type QueryPriceResponse struct {
+	Price Price `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	// stale tells whether the price is older than the max price age
+	Stale bool `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
+	// posts represents the prices posted by each oracle
+	Posts []PricePost `protobuf:"bytes,3,rep,name=posts,proto3" json:"posts"`
+}
+
+func (m *QueryPriceResponse) Reset()         { *m = QueryPriceResponse{} }
+func (m *QueryPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceResponse) ProtoMessage()    {}
+func (*QueryPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{25}
+}
+func (m *QueryPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceResponse.Merge(m, src)
+}
+func (m *QueryPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceResponse proto.InternalMessageInfo
+
+func (m *QueryPriceResponse) GetPrice() Price {
+	if m != nil {
+		return m.Price
+	}
+	return Price{}
+}
+
+func (m *QueryPriceResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *QueryPriceResponse) GetPosts() []PricePost {
+	if m != nil {
+		return m.Posts
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{26}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	// Represents the parameters related to the prize distribution
+	DistributionParams DistributionParams `protobuf:"bytes,1,opt,name=distribution_params,json=distributionParams,proto3" json:"distribution_params"`
+	// Represents the parameters related to each draw
+	DrawParams DrawParams `protobuf:"bytes,2,opt,name=draw_params,json=drawParams,proto3" json:"draw_params"`
+	// Represents the parameters related to each ticket
+	TicketParams TicketParams `protobuf:"bytes,3,opt,name=ticket_params,json=ticketParams,proto3" json:"ticket_params"`
+	// Represents the parameters related to the prize payout table
+	PrizeParams PrizeParams `protobuf:"bytes,4,opt,name=prize_params,json=prizeParams,proto3" json:"prize_params"`
+	// Represents the parameters related to the draws rollovers
+	RolloverParams RolloverParams `protobuf:"bytes,5,opt,name=rollover_params,json=rolloverParams,proto3" json:"rollover_params"`
+	// Represents the parameters related to the removal of past draws tickets
+	PruningParams PruningParams `protobuf:"bytes,6,opt,name=pruning_params,json=pruningParams,proto3" json:"pruning_params"`
+	// Represents the parameters related to the halt of the ticket sales,
+	// including the reason why they have been halted
+	SalesParams SalesParams `protobuf:"bytes,7,opt,name=sales_params,json=salesParams,proto3" json:"sales_params"`
+	// Represents the parameters related to the spending limits of the addresses
+	SpendingLimitParams SpendingLimitParams `protobuf:"bytes,8,opt,name=spending_limit_params,json=spendingLimitParams,proto3" json:"spending_limit_params"`
+	// Represents the parameters related to the price feed
+	OracleParams OracleParams `protobuf:"bytes,9,opt,name=oracle_params,json=oracleParams,proto3" json:"oracle_params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ba61453303e825, []int{27}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetDistributionParams() DistributionParams {
+	if m != nil {
+		return m.DistributionParams
+	}
+	return DistributionParams{}
+}
+
+func (m *QueryParamsResponse) GetDrawParams() DrawParams {
+	if m != nil {
+		return m.DrawParams
+	}
+	return DrawParams{}
+}
+
+func (m *QueryParamsResponse) GetTicketParams() TicketParams {
+	if m != nil {
+		return m.TicketParams
+	}
+	return TicketParams{}
+}
+
+func (m *QueryParamsResponse) GetPrizeParams() PrizeParams {
+	if m != nil {
+		return m.PrizeParams
+	}
+	return PrizeParams{}
+}
+
+func (m *QueryParamsResponse) GetRolloverParams() RolloverParams {
+	if m != nil {
+		return m.RolloverParams
+	}
+	return RolloverParams{}
+}
+
+func (m *QueryParamsResponse) GetPruningParams() PruningParams {
+	if m != nil {
+		return m.PruningParams
+	}
+	return PruningParams{}
+}
+
+func (m *QueryParamsResponse) GetSalesParams() SalesParams {
+	if m != nil {
+		return m.SalesParams
+	}
+	return SalesParams{}
+}
+
+func (m *QueryParamsResponse) GetSpendingLimitParams() SpendingLimitParams {
+	if m != nil {
+		return m.SpendingLimitParams
+	}
+	return SpendingLimitParams{}
+}
+
+func (m *QueryParamsResponse) GetOracleParams() OracleParams {
+	if m != nil {
+		return m.OracleParams
+	}
+	return OracleParams{}
+}
+
+func init() {
+	proto.RegisterType((*QueryTicketsRequest)(nil), "cosmicbet.wta.v1beta1.QueryTicketsRequest")
+	proto.RegisterType((*QueryTicketsResponse)(nil), "cosmicbet.wta.v1beta1.QueryTicketsResponse")
+	proto.RegisterType((*QueryTicketsByOwnerRequest)(nil), "cosmicbet.wta.v1beta1.QueryTicketsByOwnerRequest")
+	proto.RegisterType((*QueryTicketsByOwnerResponse)(nil), "cosmicbet.wta.v1beta1.QueryTicketsByOwnerResponse")
+	proto.RegisterType((*QueryNextDrawRequest)(nil), "cosmicbet.wta.v1beta1.QueryNextDrawRequest")
+	proto.RegisterType((*QueryNextDrawResponse)(nil), "cosmicbet.wta.v1beta1.QueryNextDrawResponse")
+	proto.RegisterType((*QueryPastDrawsRequest)(nil), "cosmicbet.wta.v1beta1.QueryPastDrawsRequest")
+	proto.RegisterType((*QueryPastDrawsResponse)(nil), "cosmicbet.wta.v1beta1.QueryPastDrawsResponse")
+	proto.RegisterType((*QueryDrawRequest)(nil), "cosmicbet.wta.v1beta1.QueryDrawRequest")
+	proto.RegisterType((*QueryDrawResponse)(nil), "cosmicbet.wta.v1beta1.QueryDrawResponse")
+	proto.RegisterType((*QueryDrawProofRequest)(nil), "cosmicbet.wta.v1beta1.QueryDrawProofRequest")
+	proto.RegisterType((*QueryDrawProofResponse)(nil), "cosmicbet.wta.v1beta1.QueryDrawProofResponse")
+	proto.RegisterType((*QueryDrawWinnersRequest)(nil), "cosmicbet.wta.v1beta1.QueryDrawWinnersRequest")
+	proto.RegisterType((*QueryDrawWinnersResponse)(nil), "cosmicbet.wta.v1beta1.QueryDrawWinnersResponse")
+	proto.RegisterType((*QueryDrawFeesRequest)(nil), "cosmicbet.wta.v1beta1.QueryDrawFeesRequest")
+	proto.RegisterType((*QueryDrawFeesResponse)(nil), "cosmicbet.wta.v1beta1.QueryDrawFeesResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "cosmicbet.wta.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "cosmicbet.wta.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryPruningQueueRequest)(nil), "cosmicbet.wta.v1beta1.QueryPruningQueueRequest")
+	proto.RegisterType((*QueryPruningQueueResponse)(nil), "cosmicbet.wta.v1beta1.QueryPruningQueueResponse")
+	proto.RegisterType((*QueryExclusionRequest)(nil), "cosmicbet.wta.v1beta1.QueryExclusionRequest")
+	proto.RegisterType((*QueryExclusionResponse)(nil), "cosmicbet.wta.v1beta1.QueryExclusionResponse")
+	proto.RegisterType((*QuerySpendingLimitRequest)(nil), "cosmicbet.wta.v1beta1.QuerySpendingLimitRequest")
+	proto.RegisterType((*QuerySpendingLimitResponse)(nil), "cosmicbet.wta.v1beta1.QuerySpendingLimitResponse")
+	proto.RegisterType((*QueryPriceRequest)(nil), "cosmicbet.wta.v1beta1.QueryPriceRequest")
+	proto.RegisterType((*QueryPriceResponse)(nil), "cosmicbet.wta.v1beta1.QueryPriceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmicbet.wta.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmicbet.wta.v1beta1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("cosmicbet/wta/v1beta1/query.proto", fileDescriptor_48ba61453303e825) }
+
+var fileDescriptor_48ba61453303e825 = []byte{
+	// 1741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5f, 0x6f, 0x13, 0xcb,
+	0x15, 0xcf, 0xc6, 0x4e, 0x1c, 0x8f, 0x9d, 0x40, 0x27, 0x09, 0x98, 0x05, 0x1c, 0x67, 0x81, 0xc4,
+	0x81, 0xd8, 0x9b, 0x84, 0xd2, 0x52, 0xa9, 0xad, 0x44, 0x1a, 0x28, 0xb4, 0x14, 0xc2, 0x82, 0x54,
+	0xa9, 0x7d, 0x70, 0x37, 0xde, 0xc1, 0xac, 0xb0, 0x77, 0x9c, 0x9d, 0x35, 0x09, 0x41, 0x79, 0xe1,
+	0x01, 0xa9, 0xf4, 0xa1, 0x48, 0xbc, 0x55, 0x2a, 0x95, 0x2a, 0xf5, 0x8f, 0xfa, 0xd0, 0xcf, 0xc1,
+	0x23, 0x6a, 0x5f, 0xee, 0xd3, 0xbd, 0x57, 0x70, 0x1f, 0xee, 0xc7, 0xb8, 0x9a, 0x99, 0x33, 0xce,
+	0xae, 0xe3, 0xdd, 0x2c, 0xf7, 0xf2, 0x70, 0x9f, 0x92, 0x39, 0xfb, 0x3b, 0xe7, 0xfc, 0xce, 0x9f,
+	0x99, 0x39, 0x63, 0x34, 0xdf, 0xa4, 0xac, 0xe3, 0x36, 0xb7, 0x48, 0x60, 0xee, 0x04, 0xb6, 0xf9,
+	0x64, 0x75, 0x8b, 0x04, 0xf6, 0xaa, 0xb9, 0xdd, 0x23, 0xfe, 0xd3, 0x7a, 0xd7, 0xa7, 0x01, 0xc5,
+	0xb3, 0x7d, 0x48, 0x7d, 0x27, 0xb0, 0xeb, 0x00, 0xd1, 0x67, 0x5a, 0xb4, 0x45, 0x05, 0xc2, 0xe4,
+	0xff, 0x49, 0xb0, 0x7e, 0xa6, 0x45, 0x69, 0xab, 0x4d, 0x4c, 0xbb, 0xeb, 0x9a, 0xb6, 0xe7, 0xd1,
+	0xc0, 0x0e, 0x5c, 0xea, 0x31, 0xf8, 0x7a, 0x91, 0x9b, 0xa2, 0xcc, 0xdc, 0xb2, 0x19, 0x91, 0x3e,
+	0xfa, 0x1e, 0xbb, 0x76, 0xcb, 0xf5, 0x04, 0x18, 0xb0, 0xe5, 0x30, 0x56, 0xa1, 0x9a, 0xd4, 0x55,
+	0xdf, 0x8d, 0xe1, 0xcc, 0x3b, 0xd4, 0x21, 0x6d, 0x96, 0x8c, 0xe9, 0xda, 0xbe, 0xdd, 0x01, 0x8c,
+	0xf1, 0x04, 0x4d, 0xdf, 0xe3, 0x4c, 0x1e, 0xb8, 0xcd, 0xc7, 0x24, 0x60, 0x16, 0xd9, 0xee, 0x11,
+	0x16, 0xe0, 0x1b, 0x08, 0x1d, 0x50, 0x2a, 0x69, 0x15, 0xad, 0x5a, 0x58, 0x5b, 0xa8, 0x4b, 0x4e,
+	0x75, 0xce, 0xa9, 0x2e, 0x73, 0x04, 0x36, 0xeb, 0x9b, 0x76, 0x8b, 0x80, 0xae, 0x15, 0xd2, 0xc4,
+	0x27, 0x51, 0xae, 0x4b, 0x69, 0xbb, 0xe1, 0x3a, 0xa5, 0xd1, 0x8a, 0x56, 0xcd, 0x5a, 0xe3, 0x7c,
+	0x79, 0xcb, 0x31, 0xde, 0x68, 0x68, 0x26, 0xea, 0x98, 0x75, 0xa9, 0xc7, 0x08, 0xfe, 0x19, 0xca,
+	0x05, 0x52, 0x54, 0xd2, 0x2a, 0x99, 0x6a, 0x61, 0xed, 0x6c, 0x7d, 0x68, 0x05, 0xea, 0x52, 0x71,
+	0x3d, 0xfb, 0xf6, 0xf3, 0xb9, 0x11, 0x4b, 0xe9, 0xe0, 0x5f, 0x46, 0x88, 0x8f, 0x0a, 0xe2, 0x8b,
+	0x47, 0x12, 0x97, 0xbe, 0xc3, 0xcc, 0x8d, 0x3d, 0xa4, 0x87, 0xf9, 0xad, 0x3f, 0xbd, 0xbb, 0xe3,
+	0x11, 0x5f, 0xe5, 0x67, 0x06, 0x8d, 0x51, 0xbe, 0x16, 0xa9, 0xc9, 0x5b, 0x72, 0x81, 0x6f, 0x0c,
+	0x71, 0xfe, 0x2d, 0xb2, 0x66, 0xfc, 0x43, 0x43, 0xa7, 0x87, 0x3a, 0xff, 0x9e, 0xe5, 0xc8, 0x84,
+	0x1a, 0xde, 0x21, 0xbb, 0xc1, 0x86, 0x6f, 0xef, 0xa8, 0xec, 0x84, 0xaa, 0xae, 0x45, 0xaa, 0xbe,
+	0x8d, 0x66, 0x07, 0x14, 0x20, 0xa2, 0x2b, 0x28, 0xeb, 0xf8, 0xf6, 0x0e, 0x74, 0xda, 0xe9, 0x98,
+	0x70, 0xb8, 0x0a, 0x04, 0x23, 0xe0, 0x78, 0x1e, 0x15, 0x99, 0xdd, 0x26, 0xac, 0xd1, 0xb5, 0x7b,
+	0x8c, 0xc8, 0x1e, 0x9b, 0xb0, 0x0a, 0x42, 0xb6, 0x29, 0x44, 0x46, 0x03, 0x5c, 0x6e, 0xda, 0x4c,
+	0xb8, 0xfc, 0xd4, 0x2d, 0x6e, 0xfc, 0x5b, 0x43, 0x27, 0x06, 0x3d, 0x40, 0x54, 0xd7, 0xd1, 0x18,
+	0xa7, 0xa9, 0xaa, 0xb4, 0x14, 0x13, 0xd6, 0x4d, 0x97, 0x05, 0xd4, 0x77, 0x9b, 0x76, 0x9b, 0xab,
+	0x6f, 0xd8, 0x81, 0x0d, 0x41, 0x4a, 0xed, 0x4f, 0x57, 0xaf, 0x4b, 0xe8, 0xb8, 0x60, 0x3a, 0x50,
+	0x2b, 0xee, 0x25, 0x54, 0x2b, 0xbe, 0xbc, 0xe5, 0x18, 0xaf, 0x34, 0xf4, 0x83, 0x10, 0xfa, 0xbb,
+	0x15, 0x6a, 0x03, 0x4d, 0xed, 0xb8, 0x9e, 0xe7, 0x7a, 0xad, 0x86, 0xec, 0x42, 0x08, 0x23, 0xb9,
+	0x71, 0xad, 0x49, 0x50, 0x92, 0x4b, 0x63, 0x05, 0x6a, 0xc9, 0xcd, 0x6f, 0xfa, 0x94, 0x3e, 0x3c,
+	0x32, 0x88, 0x17, 0x19, 0x74, 0x62, 0x50, 0x05, 0x22, 0xc1, 0x28, 0xcb, 0x08, 0x91, 0x0a, 0x45,
+	0x4b, 0xfc, 0xcf, 0xfb, 0x09, 0x36, 0x49, 0xc3, 0xa7, 0x54, 0x92, 0x2c, 0x5a, 0x05, 0x90, 0x59,
+	0x94, 0x06, 0xf8, 0x1c, 0x52, 0xa4, 0x1a, 0xae, 0xe7, 0x90, 0xdd, 0x52, 0xa6, 0xa2, 0x55, 0x27,
+	0xad, 0x22, 0x08, 0x6f, 0x71, 0x19, 0x07, 0x05, 0x34, 0xb0, 0xdb, 0x0d, 0xb5, 0x4d, 0xb3, 0x12,
+	0x24, 0x84, 0xb0, 0xa9, 0xf1, 0xaf, 0x0e, 0xe5, 0x64, 0x2c, 0x45, 0x4e, 0x20, 0xad, 0xd1, 0xcc,
+	0xf0, 0xf3, 0xa8, 0xcb, 0xa3, 0x2b, 0x8d, 0x57, 0x32, 0xd5, 0xa2, 0x25, 0x17, 0xf8, 0x1a, 0xca,
+	0x71, 0x18, 0xf1, 0x59, 0x29, 0x27, 0x3a, 0x70, 0x3e, 0xa1, 0x5e, 0xbf, 0x15, 0x48, 0x75, 0x56,
+	0x80, 0x1e, 0x5e, 0x44, 0xc7, 0x14, 0x49, 0xaf, 0xd7, 0xd9, 0xe2, 0xa6, 0x26, 0x2a, 0x99, 0xea,
+	0xa4, 0xa5, 0xb8, 0xdf, 0x91, 0x52, 0x7c, 0x16, 0xa1, 0x8e, 0xbd, 0x0b, 0xa0, 0x52, 0x5e, 0xc4,
+	0x9b, 0xef, 0xd8, 0xbb, 0xf2, 0xbb, 0x71, 0x1b, 0x9d, 0xec, 0xd7, 0x41, 0x7a, 0x62, 0x47, 0x15,
+	0x0f, 0x97, 0x50, 0xae, 0x63, 0x07, 0xcd, 0x47, 0x84, 0x89, 0x42, 0x4c, 0x5a, 0x6a, 0x69, 0xbc,
+	0xd0, 0x50, 0xe9, 0xb0, 0x39, 0x28, 0x6c, 0x28, 0x6a, 0xed, 0xd3, 0x45, 0x3d, 0x3a, 0x2c, 0xea,
+	0xfe, 0x09, 0xc8, 0x4d, 0xdd, 0x20, 0xe4, 0xc8, 0x98, 0x0c, 0x0b, 0xcd, 0x0e, 0x28, 0x00, 0xeb,
+	0x9f, 0xa0, 0xec, 0x43, 0x42, 0x14, 0xe5, 0xb9, 0x04, 0xca, 0x5c, 0x4d, 0x6d, 0x2e, 0xae, 0x62,
+	0x4c, 0xc3, 0x46, 0xdd, 0xa4, 0xb4, 0xad, 0x18, 0x18, 0xbf, 0x41, 0x38, 0x2c, 0x04, 0x2f, 0x3f,
+	0x46, 0x63, 0xfc, 0x28, 0x56, 0x6e, 0xe2, 0xf6, 0x2f, 0x57, 0x52, 0x67, 0x90, 0xc0, 0x1b, 0x3a,
+	0x24, 0x7c, 0xd3, 0xef, 0xf1, 0xf8, 0xef, 0xf5, 0x48, 0x4f, 0x9d, 0x86, 0xc6, 0xef, 0xd1, 0xa9,
+	0x21, 0xdf, 0xc0, 0xe3, 0xcf, 0xa3, 0x67, 0xa0, 0x11, 0xe7, 0x51, 0xea, 0x86, 0x0e, 0x0e, 0xa9,
+	0x66, 0xac, 0x42, 0xc2, 0xae, 0xef, 0x36, 0xdb, 0x3d, 0xe6, 0x52, 0x4f, 0xa5, 0xb8, 0x84, 0x72,
+	0xb6, 0xe3, 0xf8, 0x84, 0x31, 0xb8, 0x84, 0xd5, 0xd2, 0xd8, 0x43, 0x27, 0x06, 0x55, 0x80, 0xcc,
+	0x06, 0xca, 0x13, 0x25, 0x84, 0x23, 0xac, 0x12, 0x43, 0xa8, 0xaf, 0x0c, 0x74, 0x0e, 0x14, 0xb1,
+	0x8e, 0x26, 0xc4, 0xc2, 0xe9, 0xdf, 0x38, 0xfd, 0xb5, 0x71, 0x05, 0x72, 0x71, 0xbf, 0x4b, 0x3c,
+	0xc7, 0xf5, 0x5a, 0xb7, 0xdd, 0x8e, 0x1b, 0x1c, 0x4d, 0xf9, 0x7f, 0x1a, 0xd2, 0x87, 0xe9, 0x01,
+	0xef, 0x7b, 0x68, 0x8a, 0xc1, 0x87, 0x46, 0x9b, 0x7f, 0x01, 0xf2, 0xe7, 0x63, 0xc8, 0x47, 0xac,
+	0xa8, 0x13, 0x83, 0x85, 0x85, 0xd8, 0x46, 0x63, 0x5c, 0x10, 0x88, 0xc6, 0x2e, 0xac, 0x9d, 0x8a,
+	0xdc, 0x27, 0xca, 0xce, 0x2f, 0xa8, 0xeb, 0xad, 0xaf, 0x70, 0xf5, 0xff, 0x7c, 0x31, 0x57, 0x6d,
+	0xb9, 0xc1, 0xa3, 0xde, 0x56, 0xbd, 0x49, 0x3b, 0xa6, 0x04, 0xc3, 0x9f, 0x1a, 0x73, 0x1e, 0x9b,
+	0xc1, 0xd3, 0x2e, 0x61, 0x42, 0x81, 0x59, 0xd2, 0xb2, 0xb1, 0xa4, 0xfa, 0xd2, 0x77, 0x9b, 0x24,
+	0x34, 0x39, 0x39, 0xc4, 0xa3, 0x1d, 0x35, 0x39, 0x89, 0x85, 0xf1, 0x77, 0x0d, 0xe1, 0x30, 0x16,
+	0xe2, 0xbe, 0xca, 0x8f, 0x35, 0xb7, 0x49, 0x20, 0xdc, 0x33, 0xb1, 0xcd, 0xe3, 0x36, 0x49, 0xbf,
+	0x5f, 0xf9, 0x82, 0xbb, 0x61, 0x81, 0xdd, 0x26, 0x50, 0x20, 0xb9, 0xc0, 0x3f, 0xe5, 0xed, 0xcf,
+	0x02, 0x56, 0xca, 0x54, 0x32, 0x09, 0xb5, 0x17, 0xf6, 0x36, 0x29, 0x0b, 0x0e, 0xf6, 0x00, 0x0b,
+	0x98, 0x31, 0xa3, 0x38, 0x8a, 0x01, 0x5a, 0x75, 0xff, 0xcb, 0x71, 0x34, 0x1d, 0x11, 0x03, 0xf7,
+	0x3f, 0xa0, 0x69, 0xc7, 0x65, 0x81, 0xef, 0x6e, 0xf5, 0xf8, 0xe5, 0xdb, 0x90, 0x63, 0x37, 0x44,
+	0x12, 0x37, 0x0a, 0x6c, 0x84, 0x34, 0xa4, 0x3d, 0xa0, 0x80, 0x9d, 0x43, 0x5f, 0xf0, 0x4d, 0x54,
+	0x10, 0x87, 0x0c, 0x58, 0x96, 0x37, 0x6a, 0xd2, 0x61, 0x17, 0xb1, 0x88, 0x9c, 0xbe, 0x04, 0xdf,
+	0x41, 0x93, 0xf2, 0x0a, 0x52, 0xb6, 0x32, 0xc2, 0xd6, 0xb9, 0xc4, 0x9b, 0x28, 0x62, 0xad, 0x18,
+	0x84, 0x64, 0xf8, 0xd7, 0xa8, 0xd8, 0xf5, 0xdd, 0x3d, 0xa2, 0xcc, 0x65, 0x2b, 0x5a, 0xe2, 0xde,
+	0x77, 0xf7, 0x48, 0xc4, 0x5a, 0xa1, 0x7b, 0x20, 0xc2, 0x0f, 0xd0, 0x31, 0x9f, 0xb6, 0xdb, 0xf4,
+	0x09, 0xf1, 0x95, 0x3d, 0x79, 0x51, 0x5e, 0x88, 0xb1, 0x67, 0x01, 0x3a, 0x62, 0x72, 0xca, 0x8f,
+	0x48, 0xf9, 0x96, 0xea, 0xca, 0x33, 0x47, 0x19, 0x1d, 0x4f, 0xdc, 0x52, 0x70, 0x40, 0x45, 0x6c,
+	0x4e, 0x76, 0xc3, 0x42, 0x1e, 0xb5, 0x9a, 0x46, 0x85, 0xc1, 0x5c, 0x62, 0xd4, 0xf7, 0xe5, 0x90,
+	0x1a, 0x8e, 0x9a, 0x1d, 0x88, 0xb0, 0x83, 0x66, 0xa3, 0x5b, 0x5e, 0x59, 0x9d, 0x10, 0x56, 0x2f,
+	0xa6, 0xd9, 0xf9, 0x11, 0xeb, 0xd3, 0xec, 0xf0, 0x27, 0x5e, 0x78, 0xea, 0xdb, 0xcd, 0x76, 0xbf,
+	0x52, 0xf9, 0xc4, 0xc2, 0xdf, 0x15, 0xd8, 0x68, 0xe1, 0x69, 0x48, 0xb6, 0xf6, 0xf5, 0x71, 0x34,
+	0x26, 0x36, 0x03, 0x7e, 0xa9, 0xa1, 0x9c, 0x9a, 0x74, 0xe2, 0xc8, 0x0e, 0x79, 0x79, 0xea, 0x97,
+	0x52, 0x61, 0xe5, 0x1e, 0x33, 0x16, 0x9e, 0xff, 0xff, 0xab, 0xd7, 0xa3, 0x15, 0x5c, 0x36, 0x87,
+	0x3f, 0x75, 0xd5, 0x8b, 0xe7, 0x5f, 0x1a, 0x9a, 0x8a, 0xbe, 0xa5, 0xf0, 0x6a, 0x0a, 0x3f, 0xd1,
+	0x47, 0x9f, 0xbe, 0xf6, 0x31, 0x2a, 0xc0, 0xb0, 0x2e, 0x18, 0x56, 0xf1, 0x42, 0x32, 0x43, 0xf3,
+	0x99, 0x78, 0x41, 0xee, 0xe3, 0x3f, 0x6b, 0x68, 0x42, 0xbd, 0x8e, 0x70, 0x62, 0x2e, 0x06, 0x1e,
+	0x5d, 0xfa, 0x72, 0x3a, 0x30, 0xf0, 0xaa, 0x0a, 0x5e, 0x06, 0xae, 0xc4, 0xf0, 0xf2, 0xc8, 0x6e,
+	0x50, 0x13, 0xa3, 0xfb, 0x6b, 0x0d, 0xe5, 0xfb, 0x4f, 0x1b, 0x9c, 0xe8, 0x65, 0xf0, 0x8d, 0xa5,
+	0xd7, 0x52, 0xa2, 0x81, 0xd4, 0x92, 0x20, 0x75, 0x0e, 0xcf, 0x9b, 0x71, 0xbf, 0x5c, 0x30, 0x49,
+	0x8a, 0xe1, 0x3f, 0x6a, 0x28, 0x2b, 0x72, 0xb4, 0x98, 0xe4, 0x22, 0x9c, 0x9f, 0xea, 0xd1, 0xc0,
+	0x94, 0x35, 0x13, 0x0c, 0xcc, 0x67, 0x30, 0xe0, 0xed, 0xe3, 0x37, 0x1a, 0xca, 0xf7, 0xdf, 0x17,
+	0xc9, 0x19, 0x1a, 0x7c, 0xb9, 0xe8, 0xb5, 0x94, 0x68, 0xa0, 0xf6, 0x43, 0x41, 0xad, 0x8e, 0x97,
+	0xd3, 0x51, 0x33, 0xe5, 0x3b, 0xe0, 0x9f, 0x1a, 0x2a, 0x84, 0x26, 0x65, 0x5c, 0x3f, 0xca, 0x69,
+	0x74, 0x42, 0xd7, 0xcd, 0xd4, 0x78, 0xa0, 0xf9, 0x23, 0x41, 0x73, 0x05, 0xd7, 0x53, 0xd2, 0x54,
+	0x73, 0xf7, 0x5f, 0x34, 0x34, 0xa1, 0x46, 0xdc, 0xe4, 0xee, 0x1f, 0x18, 0xb8, 0xf5, 0xe5, 0x74,
+	0x60, 0xe0, 0x77, 0x59, 0xf0, 0xab, 0xe1, 0x4b, 0x29, 0xf9, 0xf1, 0x31, 0x1b, 0x3f, 0xd7, 0xd0,
+	0x98, 0x98, 0xa6, 0x71, 0x62, 0x2b, 0x85, 0xa7, 0x70, 0x7d, 0x29, 0x05, 0x12, 0x38, 0x9d, 0x17,
+	0x9c, 0xca, 0xf8, 0x4c, 0x5c, 0xf3, 0x0b, 0xd7, 0x7f, 0xd3, 0x50, 0x31, 0x3c, 0x67, 0xe3, 0xc4,
+	0xda, 0x0c, 0x99, 0xd6, 0xf5, 0x95, 0xf4, 0x0a, 0xc0, 0x6c, 0x59, 0x30, 0x5b, 0xc0, 0xe7, 0xe3,
+	0x98, 0x49, 0xa5, 0xda, 0xb6, 0x20, 0xf4, 0x57, 0x0d, 0xe5, 0xfb, 0xc3, 0x73, 0xf2, 0x6e, 0x18,
+	0x9c, 0xe9, 0xf5, 0x5a, 0x4a, 0x74, 0xca, 0x32, 0xf6, 0x47, 0x76, 0x66, 0x3e, 0x83, 0x49, 0x7b,
+	0x1f, 0xff, 0x57, 0x43, 0x93, 0x91, 0x5b, 0x12, 0x27, 0x66, 0x64, 0xd8, 0x20, 0xaf, 0xaf, 0x7e,
+	0x84, 0x06, 0x70, 0xbd, 0x2a, 0xb8, 0xae, 0xe1, 0x95, 0x18, 0xae, 0xea, 0x76, 0xae, 0x89, 0xcb,
+	0x3e, 0x4c, 0xf8, 0x4f, 0xbc, 0xef, 0xc4, 0x50, 0x9b, 0xdc, 0x77, 0xa1, 0x29, 0x5b, 0x5f, 0x4a,
+	0x81, 0x04, 0x62, 0x35, 0x41, 0x6c, 0x11, 0x5f, 0x88, 0xad, 0xae, 0xdb, 0x24, 0x7c, 0x33, 0xf0,
+	0x41, 0x7d, 0x1f, 0xbf, 0xd0, 0xd0, 0x38, 0x0c, 0x0f, 0xc9, 0x4e, 0xc2, 0x43, 0xb2, 0x7e, 0x31,
+	0x0d, 0x14, 0x08, 0x5d, 0x10, 0x84, 0xe6, 0xf0, 0x59, 0x33, 0xe9, 0xf7, 0xeb, 0xf5, 0x6b, 0x6f,
+	0xdf, 0x97, 0xb5, 0x77, 0xef, 0xcb, 0xda, 0x97, 0xef, 0xcb, 0xda, 0xab, 0x0f, 0xe5, 0x91, 0x77,
+	0x1f, 0xca, 0x23, 0x9f, 0x7d, 0x28, 0x8f, 0xfc, 0x6e, 0x71, 0xe0, 0xa1, 0x22, 0x4d, 0xb4, 0x89,
+	0xd3, 0x22, 0xbe, 0xb9, 0x2b, 0x6c, 0x89, 0xd7, 0xca, 0xd6, 0xb8, 0xf8, 0x0d, 0xfc, 0xf2, 0x37,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x1c, 0x93, 0x2c, 0x07, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Tickets queries all the stored tickets for the next draw of a pool
+	Tickets(ctx context.Context, in *QueryTicketsRequest, opts ...grpc.CallOption) (*QueryTicketsResponse, error)
+	// TicketsByOwner queries all the stored tickets owned by an address
+	TicketsByOwner(ctx context.Context, in *QueryTicketsByOwnerRequest, opts ...grpc.CallOption) (*QueryTicketsByOwnerResponse, error)
+	// NextDraw queries the next planned drawn of a pool
+	NextDraw(ctx context.Context, in *QueryNextDrawRequest, opts ...grpc.CallOption) (*QueryNextDrawResponse, error)
+	// PastDraws queries the past draws that have already been drawn
+	PastDraws(ctx context.Context, in *QueryPastDrawsRequest, opts ...grpc.CallOption) (*QueryPastDrawsResponse, error)
+	// Draw queries the draw having the given id
+	Draw(ctx context.Context, in *QueryDrawRequest, opts ...grpc.CallOption) (*QueryDrawResponse, error)
+	// DrawProof queries the data needed to verify the winner of a past draw
+	DrawProof(ctx context.Context, in *QueryDrawProofRequest, opts ...grpc.CallOption) (*QueryDrawProofResponse, error)
+	// DrawWinners queries the winners of a past draw, optionally filtering them
+	// by the amount of matched numbers
+	DrawWinners(ctx context.Context, in *QueryDrawWinnersRequest, opts ...grpc.CallOption) (*QueryDrawWinnersResponse, error)
+	// DrawFees queries the fees of a draw that have been sent to each destination
+	DrawFees(ctx context.Context, in *QueryDrawFeesRequest, opts ...grpc.CallOption) (*QueryDrawFeesResponse, error)
+	// Pools queries all the existing pools
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// PruningQueue queries the past draws whose tickets are still being removed
+	PruningQueue(ctx context.Context, in *QueryPruningQueueRequest, opts ...grpc.CallOption) (*QueryPruningQueueResponse, error)
+	// Exclusion queries the self exclusion of an address
+	Exclusion(ctx context.Context, in *QueryExclusionRequest, opts ...grpc.CallOption) (*QueryExclusionResponse, error)
+	// SpendingLimit queries the spending limit of an address, along with the
+	// amount it has spent within the current window
+	SpendingLimit(ctx context.Context, in *QuerySpendingLimitRequest, opts ...grpc.CallOption) (*QuerySpendingLimitResponse, error)
+	// Price queries the median price of a denomination, along with the prices
+	// posted by each oracle
+	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
+	// Params queries the wta parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Tickets(ctx context.Context, in *QueryTicketsRequest, opts ...grpc.CallOption) (*QueryTicketsResponse, error) {
+	out := new(QueryTicketsResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/Tickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TicketsByOwner(ctx context.Context, in *QueryTicketsByOwnerRequest, opts ...grpc.CallOption) (*QueryTicketsByOwnerResponse, error) {
+	out := new(QueryTicketsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/TicketsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextDraw(ctx context.Context, in *QueryNextDrawRequest, opts ...grpc.CallOption) (*QueryNextDrawResponse, error) {
+	out := new(QueryNextDrawResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/NextDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PastDraws(ctx context.Context, in *QueryPastDrawsRequest, opts ...grpc.CallOption) (*QueryPastDrawsResponse, error) {
+	out := new(QueryPastDrawsResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/PastDraws", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Draw(ctx context.Context, in *QueryDrawRequest, opts ...grpc.CallOption) (*QueryDrawResponse, error) {
+	out := new(QueryDrawResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/Draw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DrawProof(ctx context.Context, in *QueryDrawProofRequest, opts ...grpc.CallOption) (*QueryDrawProofResponse, error) {
+	out := new(QueryDrawProofResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/DrawProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DrawWinners(ctx context.Context, in *QueryDrawWinnersRequest, opts ...grpc.CallOption) (*QueryDrawWinnersResponse, error) {
+	out := new(QueryDrawWinnersResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/DrawWinners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DrawFees(ctx context.Context, in *QueryDrawFeesRequest, opts ...grpc.CallOption) (*QueryDrawFeesResponse, error) {
+	out := new(QueryDrawFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/DrawFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PruningQueue(ctx context.Context, in *QueryPruningQueueRequest, opts ...grpc.CallOption) (*QueryPruningQueueResponse, error) {
+	out := new(QueryPruningQueueResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/PruningQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Exclusion(ctx context.Context, in *QueryExclusionRequest, opts ...grpc.CallOption) (*QueryExclusionResponse, error) {
+	out := new(QueryExclusionResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/Exclusion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendingLimit(ctx context.Context, in *QuerySpendingLimitRequest, opts ...grpc.CallOption) (*QuerySpendingLimitResponse, error) {
+	out := new(QuerySpendingLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/SpendingLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error) {
+	out := new(QueryPriceResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/Price", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmicbet.wta.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Tickets queries all the stored tickets for the next draw of a pool
+	Tickets(context.Context, *QueryTicketsRequest) (*QueryTicketsResponse, error)
+	// TicketsByOwner queries all the stored tickets owned by an address
+	TicketsByOwner(context.Context, *QueryTicketsByOwnerRequest) (*QueryTicketsByOwnerResponse, error)
+	// NextDraw queries the next planned drawn of a pool
+	NextDraw(context.Context, *QueryNextDrawRequest) (*QueryNextDrawResponse, error)
+	// PastDraws queries the past draws that have already been drawn
+	PastDraws(context.Context, *QueryPastDrawsRequest) (*QueryPastDrawsResponse, error)
+	// Draw queries the draw having the given id
+	Draw(context.Context, *QueryDrawRequest) (*QueryDrawResponse, error)
+	// DrawProof queries the data needed to verify the winner of a past draw
+	DrawProof(context.Context, *QueryDrawProofRequest) (*QueryDrawProofResponse, error)
+	// DrawWinners queries the winners of a past draw, optionally filtering them
+	// by the amount of matched numbers
+	DrawWinners(context.Context, *QueryDrawWinnersRequest) (*QueryDrawWinnersResponse, error)
+	// DrawFees queries the fees of a draw that have been sent to each destination
+	DrawFees(context.Context, *QueryDrawFeesRequest) (*QueryDrawFeesResponse, error)
+	// Pools queries all the existing pools
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// PruningQueue queries the past draws whose tickets are still being removed
+	PruningQueue(context.Context, *QueryPruningQueueRequest) (*QueryPruningQueueResponse, error)
+	// Exclusion queries the self exclusion of an address
+	Exclusion(context.Context, *QueryExclusionRequest) (*QueryExclusionResponse, error)
+	// SpendingLimit queries the spending limit of an address, along with the
+	// amount it has spent within the current window
+	SpendingLimit(context.Context, *QuerySpendingLimitRequest) (*QuerySpendingLimitResponse, error)
+	// Price queries the median price of a denomination, along with the prices
+	// posted by each oracle
+	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
+	// Params queries the wta parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Tickets(ctx context.Context, req *QueryTicketsRequest) (*QueryTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tickets not implemented")
+}
+func (*UnimplementedQueryServer) TicketsByOwner(ctx context.Context, req *QueryTicketsByOwnerRequest) (*QueryTicketsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TicketsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) NextDraw(ctx context.Context, req *QueryNextDrawRequest) (*QueryNextDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextDraw not implemented")
+}
+func (*UnimplementedQueryServer) PastDraws(ctx context.Context, req *QueryPastDrawsRequest) (*QueryPastDrawsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PastDraws not implemented")
+}
+func (*UnimplementedQueryServer) Draw(ctx context.Context, req *QueryDrawRequest) (*QueryDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Draw not implemented")
+}
+func (*UnimplementedQueryServer) DrawProof(ctx context.Context, req *QueryDrawProofRequest) (*QueryDrawProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawProof not implemented")
+}
+func (*UnimplementedQueryServer) DrawWinners(ctx context.Context, req *QueryDrawWinnersRequest) (*QueryDrawWinnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawWinners not implemented")
+}
+func (*UnimplementedQueryServer) DrawFees(ctx context.Context, req *QueryDrawFeesRequest) (*QueryDrawFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawFees not implemented")
+}
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) PruningQueue(ctx context.Context, req *QueryPruningQueueRequest) (*QueryPruningQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruningQueue not implemented")
+}
+func (*UnimplementedQueryServer) Exclusion(ctx context.Context, req *QueryExclusionRequest) (*QueryExclusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exclusion not implemented")
+}
+func (*UnimplementedQueryServer) SpendingLimit(ctx context.Context, req *QuerySpendingLimitRequest) (*QuerySpendingLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendingLimit not implemented")
+}
+func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Price not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Tickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/Tickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tickets(ctx, req.(*QueryTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TicketsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTicketsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TicketsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/TicketsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TicketsByOwner(ctx, req.(*QueryTicketsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/NextDraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextDraw(ctx, req.(*QueryNextDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PastDraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPastDrawsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PastDraws(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/PastDraws",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PastDraws(ctx, req.(*QueryPastDrawsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Draw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Draw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/Draw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Draw(ctx, req.(*QueryDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DrawProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDrawProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DrawProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/DrawProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DrawProof(ctx, req.(*QueryDrawProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DrawWinners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDrawWinnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DrawWinners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/DrawWinners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DrawWinners(ctx, req.(*QueryDrawWinnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DrawFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDrawFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DrawFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/DrawFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DrawFees(ctx, req.(*QueryDrawFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PruningQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPruningQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PruningQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/PruningQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PruningQueue(ctx, req.(*QueryPruningQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Exclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Exclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/Exclusion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Exclusion(ctx, req.(*QueryExclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendingLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendingLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendingLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/SpendingLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendingLimit(ctx, req.(*QuerySpendingLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Price_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Price(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/Price",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Price(ctx, req.(*QueryPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmicbet.wta.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmicbet.wta.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Tickets",
+			Handler:    _Query_Tickets_Handler,
+		},
+		{
+			MethodName: "TicketsByOwner",
+			Handler:    _Query_TicketsByOwner_Handler,
+		},
+		{
+			MethodName: "NextDraw",
+			Handler:    _Query_NextDraw_Handler,
+		},
+		{
+			MethodName: "PastDraws",
+			Handler:    _Query_PastDraws_Handler,
+		},
+		{
+			MethodName: "Draw",
+			Handler:    _Query_Draw_Handler,
+		},
+		{
+			MethodName: "DrawProof",
+			Handler:    _Query_DrawProof_Handler,
+		},
+		{
+			MethodName: "DrawWinners",
+			Handler:    _Query_DrawWinners_Handler,
+		},
+		{
+			MethodName: "DrawFees",
+			Handler:    _Query_DrawFees_Handler,
+		},
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "PruningQueue",
+			Handler:    _Query_PruningQueue_Handler,
+		},
+		{
+			MethodName: "Exclusion",
+			Handler:    _Query_Exclusion_Handler,
+		},
+		{
+			MethodName: "SpendingLimit",
+			Handler:    _Query_SpendingLimit_Handler,
+		},
+		{
+			MethodName: "Price",
+			Handler:    _Query_Price_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmicbet/wta/v1beta1/query.proto",
+}
+
+func (m *QueryTicketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTicketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTicketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTicketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTicketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTicketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tickets) > 0 {
+		for iNdEx := len(m.Tickets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tickets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTicketsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTicketsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTicketsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTicketsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTicketsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTicketsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tickets) > 0 {
+		for iNdEx := len(m.Tickets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tickets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextDrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextDrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextDrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextDrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextDrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextDrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SalesPaused {
+		i--
+		if m.SalesPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Draw.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPastDrawsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPastDrawsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPastDrawsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPastDrawsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPastDrawsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPastDrawsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Draws) > 0 {
+		for iNdEx := len(m.Draws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Draws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WinningTicket != nil {
+		{
+			size, err := m.WinningTicket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Draw.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDrawProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDrawProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDrawProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDrawProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDrawProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDrawProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxNumber))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.WinningNumbers) > 0 {
+		dAtA11 := make([]byte, len(m.WinningNumbers)*10)
+		var j10 int
+		for _, num := range m.WinningNumbers {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Winners) > 0 {
+		for iNdEx := len(m.Winners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Winners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.WinningTicket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TotalTickets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalTickets))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WinningIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WinningIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TicketsRoot) > 0 {
+		i -= len(m.TicketsRoot)
+		copy(dAtA[i:], m.TicketsRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TicketsRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDrawWinnersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDrawWinnersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDrawWinnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Matches != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Matches))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DrawId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDrawWinnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDrawWinnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDrawWinnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WinningNumbers) > 0 {
+		dAtA14 := make([]byte, len(m.WinningNumbers)*10)
+		var j13 int
+		for _, num := range m.WinningNumbers {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintQuery(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Winners) > 0 {
+		for iNdEx := len(m.Winners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Winners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDrawFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDrawFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDrawFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DrawId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDrawFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDrawFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDrawFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPruningQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPruningQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPruningQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPruningQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPruningQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPruningQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Draws) > 0 {
+		for iNdEx := len(m.Draws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Draws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExclusionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExclusionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExclusionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExclusionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExclusionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExclusionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Excluded {
+		i--
+		if m.Excluded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Exclusion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendingLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendingLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendingLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendingLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendingLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendingLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SpendingLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OracleParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.SpendingLimitParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.SalesParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.PruningParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.RolloverParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.PrizeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TicketParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DrawParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DistributionParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
@@ -973,124 +3473,2502 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 		v >>= 7
 		offset++
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTicketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTicketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickets) > 0 {
+		for _, e := range m.Tickets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTicketsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTicketsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickets) > 0 {
+		for _, e := range m.Tickets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextDrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryNextDrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Draw.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SalesPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPastDrawsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPastDrawsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Draws) > 0 {
+		for _, e := range m.Draws {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		n += 1 + sovQuery(uint64(m.DrawId))
+	}
+	return n
+}
+
+func (m *QueryDrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Draw.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WinningTicket != nil {
+		l = m.WinningTicket.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDrawProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		n += 1 + sovQuery(uint64(m.DrawId))
+	}
+	return n
+}
+
+func (m *QueryDrawProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TicketsRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WinningIndex != 0 {
+		n += 1 + sovQuery(uint64(m.WinningIndex))
+	}
+	if m.TotalTickets != 0 {
+		n += 1 + sovQuery(uint64(m.TotalTickets))
+	}
+	l = m.WinningTicket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Winners) > 0 {
+		for _, e := range m.Winners {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WinningNumbers) > 0 {
+		l = 0
+		for _, e := range m.WinningNumbers {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.MaxNumber != 0 {
+		n += 1 + sovQuery(uint64(m.MaxNumber))
+	}
+	return n
+}
+
+func (m *QueryDrawWinnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		n += 1 + sovQuery(uint64(m.DrawId))
+	}
+	if m.Matches != 0 {
+		n += 1 + sovQuery(uint64(m.Matches))
+	}
+	return n
+}
+
+func (m *QueryDrawWinnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Winners) > 0 {
+		for _, e := range m.Winners {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WinningNumbers) > 0 {
+		l = 0
+		for _, e := range m.WinningNumbers {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryDrawFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrawId != 0 {
+		n += 1 + sovQuery(uint64(m.DrawId))
+	}
+	return n
+}
+
+func (m *QueryDrawFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPruningQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPruningQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Draws) > 0 {
+		for _, e := range m.Draws {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExclusionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExclusionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Exclusion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Excluded {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySpendingLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendingLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpendingLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Stale {
+		n += 2
+	}
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DistributionParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DrawParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TicketParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PrizeParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RolloverParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PruningParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SalesParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpendingLimitParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OracleParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTicketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTicketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTicketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTicketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTicketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTicketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickets = append(m.Tickets, Ticket{})
+			if err := m.Tickets[len(m.Tickets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTicketsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTicketsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTicketsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTicketsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTicketsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTicketsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickets = append(m.Tickets, Ticket{})
+			if err := m.Tickets[len(m.Tickets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextDrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextDrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextDrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextDrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextDrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextDrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Draw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalesPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SalesPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPastDrawsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPastDrawsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPastDrawsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPastDrawsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPastDrawsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPastDrawsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draws", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Draws = append(m.Draws, HistoricalDrawData{})
+			if err := m.Draws[len(m.Draws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
+			}
+			m.DrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Draw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningTicket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WinningTicket == nil {
+				m.WinningTicket = &Ticket{}
+			}
+			if err := m.WinningTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDrawProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDrawProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDrawProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
+			}
+			m.DrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDrawProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDrawProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDrawProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TicketsRoot = append(m.TicketsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.TicketsRoot == nil {
+				m.TicketsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningIndex", wireType)
+			}
+			m.WinningIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinningIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTickets", wireType)
+			}
+			m.TotalTickets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTickets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningTicket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WinningTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winners = append(m.Winners, DrawWinner{})
+			if err := m.Winners[len(m.Winners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WinningNumbers = append(m.WinningNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WinningNumbers) == 0 {
+					m.WinningNumbers = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WinningNumbers = append(m.WinningNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningNumbers", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumber", wireType)
+			}
+			m.MaxNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDrawWinnersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDrawWinnersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDrawWinnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
+			}
+			m.DrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
+			}
+			m.Matches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Matches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDrawWinnersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDrawWinnersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDrawWinnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winners = append(m.Winners, DrawWinner{})
+			if err := m.Winners[len(m.Winners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WinningNumbers = append(m.WinningNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WinningNumbers) == 0 {
+					m.WinningNumbers = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WinningNumbers = append(m.WinningNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningNumbers", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDrawFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDrawFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDrawFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawId", wireType)
+			}
+			m.DrawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryTicketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDrawFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDrawFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDrawFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, DrawFees{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryTicketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Tickets) > 0 {
-		for _, e := range m.Tickets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryNextDrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryNextDrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Draw.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryPastDrawsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryPastDrawsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Draws) > 0 {
-		for _, e := range m.Draws {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPruningQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPruningQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPruningQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.DistributionParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.DrawParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TicketParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTicketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPruningQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1113,15 +5991,15 @@ func (m *QueryTicketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTicketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPruningQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTicketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPruningQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Draws", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1148,10 +6026,8 @@ func (m *QueryTicketsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Draws = append(m.Draws, PruningDraw{})
+			if err := m.Draws[len(m.Draws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1176,7 +6052,7 @@ func (m *QueryTicketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTicketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExclusionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1199,17 +6075,17 @@ func (m *QueryTicketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTicketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExclusionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTicketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExclusionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tickets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1219,29 +6095,77 @@ func (m *QueryTicketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tickets = append(m.Tickets, Ticket{})
-			if err := m.Tickets[len(m.Tickets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExclusionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExclusionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExclusionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclusion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1268,13 +6192,30 @@ func (m *QueryTicketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Exclusion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Excluded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Excluded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1296,7 +6237,7 @@ func (m *QueryTicketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextDrawRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendingLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1319,12 +6260,44 @@ func (m *QueryNextDrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextDrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendingLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextDrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendingLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1346,7 +6319,7 @@ func (m *QueryNextDrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextDrawResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendingLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,15 +6342,15 @@ func (m *QueryNextDrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextDrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendingLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextDrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendingLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Draw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1404,7 +6377,41 @@ func (m *QueryNextDrawResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Draw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpendingLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1429,7 +6436,7 @@ func (m *QueryNextDrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPastDrawsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1452,17 +6459,17 @@ func (m *QueryPastDrawsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPastDrawsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPastDrawsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1472,27 +6479,23 @@ func (m *QueryPastDrawsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1515,7 +6518,7 @@ func (m *QueryPastDrawsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPastDrawsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1538,15 +6541,15 @@ func (m *QueryPastDrawsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPastDrawsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPastDrawsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Draws", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1573,14 +6576,33 @@ func (m *QueryPastDrawsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Draws = append(m.Draws, HistoricalDrawData{})
-			if err := m.Draws[len(m.Draws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1607,10 +6629,8 @@ func (m *QueryPastDrawsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Posts = append(m.Posts, PricePost{})
+			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1813,6 +6833,204 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrizeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloverParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RolloverParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PruningParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalesParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SalesParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingLimitParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendingLimitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"math/rand"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,14 +15,14 @@ func NewRandFromSeed(seed []byte) *rand.Rand {
 	return rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(hash[8:]))))
 }
 
-// newSeedFromCtx returns a new seed based on the given context
-func newSeedFromCtx(ctx sdk.Context) []byte {
+// NewSeedFromCtx returns a new seed based on the given context
+func NewSeedFromCtx(ctx sdk.Context) []byte {
 	return append(ctx.BlockHeader().LastCommitHash, ctx.TxBytes()...)
 }
 
 // NewRandFromCtx returns a new rand.Rand based on the given context
 func NewRandFromCtx(ctx sdk.Context) *rand.Rand {
-	return NewRandFromSeed(newSeedFromCtx(ctx))
+	return NewRandFromSeed(NewSeedFromCtx(ctx))
 }
 
 // NewRandFromCtxAndIndex returns a new rand.Rand based on the given context and index
@@ -29,6 +30,44 @@ func NewRandFromCtxAndIndex(ctx sdk.Context, i int) *rand.Rand {
 	var index = make([]byte, 8)
 	binary.BigEndian.PutUint64(index, uint64(i))
 
-	seed := append(newSeedFromCtx(ctx), index...)
+	seed := append(NewSeedFromCtx(ctx), index...)
 	return NewRandFromSeed(seed)
 }
+
+// NewRandFromCtxDrawAndIndex returns a new rand.Rand based on the given context, draw id and index.
+// Different draws, or different indexes inside the same draw, give different rands even within the same transaction
+func NewRandFromCtxDrawAndIndex(ctx sdk.Context, drawID uint64, i uint64) *rand.Rand {
+	var suffix = make([]byte, 16)
+	binary.BigEndian.PutUint64(suffix[:8], drawID)
+	binary.BigEndian.PutUint64(suffix[8:], i)
+
+	seed := append(NewSeedFromCtx(ctx), suffix...)
+	return NewRandFromSeed(seed)
+}
+
+// NewSeedFromEntropy combines the revealed entropy of the given commitments into a single seed.
+// Each value is prefixed with its length, so that different splits of the same bytes give different seeds.
+// Commitments that have not been revealed are skipped. If no entropy has been revealed, nil is returned.
+func NewSeedFromEntropy(commitments []EntropyCommitment) []byte {
+	sorted := make([]EntropyCommitment, 0, len(commitments))
+	for _, c := range commitments {
+		if c.IsRevealed() {
+			sorted = append(sorted, c)
+		}
+	}
+
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Validator < sorted[j].Validator
+	})
+
+	hash := sha256.New()
+	for _, c := range sorted {
+		hash.Write(sdk.Uint64ToBigEndian(uint64(len(c.Entropy))))
+		hash.Write(c.Entropy)
+	}
+	return hash.Sum(nil)
+}
@@ -50,3 +50,56 @@ func TestNewRandFromCtxAndIndex(t *testing.T) {
 		require.Equal(t, r1.Intn(1000000), r2.Intn(1000000))
 	}
 }
+
+func TestNewRandFromCtxDrawAndIndex(t *testing.T) {
+	ctx := sdk.NewContext(
+		nil,
+		tmproto.Header{LastCommitHash: []byte("last_commit_hash")},
+		false,
+		nil,
+	).WithTxBytes([]byte("tx_bytes"))
+
+	// Same ctx, draw and index generates same rands
+	r1 := types.NewRandFromCtxDrawAndIndex(ctx, 1, 10)
+	r2 := types.NewRandFromCtxDrawAndIndex(ctx, 1, 10)
+	for i := 0; i < 100000; i++ {
+		require.Equal(t, r1.Int63(), r2.Int63())
+	}
+
+	// Different draws or indexes generate different rands
+	require.NotEqual(t, types.NewRandFromCtxDrawAndIndex(ctx, 1, 10).Int63(), types.NewRandFromCtxDrawAndIndex(ctx, 2, 10).Int63())
+	require.NotEqual(t, types.NewRandFromCtxDrawAndIndex(ctx, 1, 10).Int63(), types.NewRandFromCtxDrawAndIndex(ctx, 1, 11).Int63())
+}
+
+func TestNewSeedFromEntropy(t *testing.T) {
+	// Unrevealed commitments produce no seed
+	require.Nil(t, types.NewSeedFromEntropy([]types.EntropyCommitment{
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-1", []byte("commitment-1"), nil),
+	}))
+
+	// The seed does not depend on the commitments order, and skips unrevealed ones
+	seed1 := types.NewSeedFromEntropy([]types.EntropyCommitment{
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-1", []byte("commitment-1"), []byte("entropy-1")),
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-2", []byte("commitment-2"), []byte("entropy-2")),
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-3", []byte("commitment-3"), nil),
+	})
+	seed2 := types.NewSeedFromEntropy([]types.EntropyCommitment{
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-2", []byte("commitment-2"), []byte("entropy-2")),
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-1", []byte("commitment-1"), []byte("entropy-1")),
+	})
+	require.Equal(t, seed1, seed2)
+
+	// Different entropy generates a different seed
+	seed3 := types.NewSeedFromEntropy([]types.EntropyCommitment{
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-1", []byte("commitment-1"), []byte("entropy-1")),
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-2", []byte("commitment-2"), []byte("entropy-3")),
+	})
+	require.NotEqual(t, seed1, seed3)
+
+	// Different splits of the same bytes generate different seeds
+	seed4 := types.NewSeedFromEntropy([]types.EntropyCommitment{
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-1", []byte("commitment-1"), []byte("entropy-1e")),
+		types.NewEntropyCommitment(types.DefaultPoolID, "validator-2", []byte("commitment-2"), []byte("ntropy-2")),
+	})
+	require.NotEqual(t, seed1, seed4)
+}